@@ -0,0 +1,264 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/adapters/humago"
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/handlers/v1/account"
+	"github.com/carson-networks/budget-server/internal/handlers/v1/category"
+	"github.com/carson-networks/budget-server/internal/handlers/v1/transaction"
+	"github.com/carson-networks/budget-server/internal/handlers/v1/webhook"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage"
+	storageaccount "github.com/carson-networks/budget-server/internal/storage/account"
+	storagecategory "github.com/carson-networks/budget-server/internal/storage/category"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+	storagetransaction "github.com/carson-networks/budget-server/internal/storage/transaction"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+	storagewebhook "github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+// ledgerRows stands in for the database. Like storage, it only finds rows
+// in the request's ledger; each storage package's TestLedgerIsolation checks
+// that its queries are scoped that way.
+type ledgerRows struct {
+	ledgerOf     map[uuid.UUID]uuid.UUID
+	accounts     map[uuid.UUID]*storageaccount.Account
+	categories   map[uuid.UUID]*storagecategory.Category
+	transactions map[uuid.UUID]*storagetransaction.Transaction
+	webhooks     map[uuid.UUID]*storagewebhook.Webhook
+	// writes lists the IDs of rows that were changed.
+	writes []uuid.UUID
+}
+
+func findInLedger[T any](ctx context.Context, rows *ledgerRows, byID map[uuid.UUID]T, id uuid.UUID) (T, error) {
+	var zero T
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return zero, err
+	}
+	row, ok := byID[id]
+	if !ok || rows.ledgerOf[id] != ledgerID {
+		return zero, sql.ErrNoRows
+	}
+	return row, nil
+}
+
+var errNotUsed = errors.New("not used by these tests")
+
+type accountRows struct {
+	storage.IAccountWriter
+	*ledgerRows
+}
+
+func (r accountRows) FindByID(ctx context.Context, id uuid.UUID) (*storageaccount.Account, error) {
+	return findInLedger(ctx, r.ledgerRows, r.accounts, id)
+}
+
+func (r accountRows) FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*storageaccount.Account, error) {
+	return findInLedger(ctx, r.ledgerRows, r.accounts, id)
+}
+
+type transactionRows struct {
+	storage.ITransactionWriter
+	*ledgerRows
+}
+
+func (r transactionRows) FindByID(ctx context.Context, id uuid.UUID) (*storagetransaction.Transaction, error) {
+	return findInLedger(ctx, r.ledgerRows, r.transactions, id)
+}
+
+type categoryRows struct {
+	storage.ICategoryWriter
+	*ledgerRows
+}
+
+func (r categoryRows) GetByID(ctx context.Context, id uuid.UUID) (*storagecategory.Category, error) {
+	return findInLedger(ctx, r.ledgerRows, r.categories, id)
+}
+
+func (r categoryRows) List(context.Context, *storagecategory.CategoryFilter) (*storagecategory.CategoryListResult, error) {
+	return nil, errNotUsed
+}
+
+type webhookRows struct {
+	storage.IWebhookWriter
+	*ledgerRows
+}
+
+func (r webhookRows) GetByID(ctx context.Context, id uuid.UUID) (*storagewebhook.Webhook, error) {
+	return findInLedger(ctx, r.ledgerRows, r.webhooks, id)
+}
+
+func (r webhookRows) Update(ctx context.Context, id uuid.UUID, _ *storagewebhook.WebhookUpdate) error {
+	if _, err := r.GetByID(ctx, id); err != nil {
+		return err
+	}
+	r.writes = append(r.writes, id)
+	return nil
+}
+
+func (r webhookRows) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.GetByID(ctx, id); err != nil {
+		return err
+	}
+	r.writes = append(r.writes, id)
+	return nil
+}
+
+func (r webhookRows) List(context.Context) ([]*storagewebhook.Webhook, error) {
+	return nil, errNotUsed
+}
+
+func (r webhookRows) ListDeliveries(context.Context, uuid.UUID, int) ([]*storagewebhook.Delivery, error) {
+	return nil, errNotUsed
+}
+
+type noopTx struct{}
+
+func (noopTx) Commit(context.Context) error   { return nil }
+func (noopTx) Rollback(context.Context) error { return nil }
+
+// rowsStorage gives the operator writers over rows.
+type rowsStorage struct {
+	rows *ledgerRows
+}
+
+func (s rowsStorage) Write(context.Context, sql.IsolationLevel) (*storage.Writer, error) {
+	w := storage.NewWriterForTestWithTx(noopTx{})
+	w.Account = accountRows{ledgerRows: s.rows}
+	w.Transaction = transactionRows{ledgerRows: s.rows}
+	w.Category = categoryRows{ledgerRows: s.rows}
+	w.Webhook = webhookRows{ledgerRows: s.rows}
+	w.Audit.(*storage.MockIAuditWriter).EXPECT().Insert(mock.Anything, mock.Anything).Return(nil).Maybe()
+	return w, nil
+}
+
+// TestLedgerIsolation sends requests through the auth and ledger middleware,
+// the handlers and the operator as a member of two ledgers, and checks that
+// rows of one ledger can't be read or changed from the other.
+func TestLedgerIsolation(t *testing.T) {
+	ledgerA := uuid.Must(uuid.NewV4())
+	ledgerB := uuid.Must(uuid.NewV4())
+	accountA := uuid.Must(uuid.NewV4())
+	accountB := uuid.Must(uuid.NewV4())
+	categoryA := uuid.Must(uuid.NewV4())
+	categoryB := uuid.Must(uuid.NewV4())
+	transactionA := uuid.Must(uuid.NewV4())
+	webhookA := uuid.Must(uuid.NewV4())
+	newRows := func() *ledgerRows {
+		return &ledgerRows{
+			ledgerOf: map[uuid.UUID]uuid.UUID{
+				accountA: ledgerA, accountB: ledgerB,
+				categoryA: ledgerA, categoryB: ledgerB,
+				transactionA: ledgerA, webhookA: ledgerA,
+			},
+			accounts: map[uuid.UUID]*storageaccount.Account{
+				accountA: {ID: accountA, Name: "Checking"},
+				accountB: {ID: accountB, Name: "Savings"},
+			},
+			categories: map[uuid.UUID]*storagecategory.Category{
+				categoryA: {ID: categoryA, Name: "Food", CategoryType: storagecategory.CatergoryType_Expense},
+				categoryB: {ID: categoryB, Name: "Rent", CategoryType: storagecategory.CatergoryType_Expense},
+			},
+			transactions: map[uuid.UUID]*storagetransaction.Transaction{
+				transactionA: {ID: transactionA, AccountID: accountA, CategoryID: categoryA, Amount: decimal.NewFromInt(-5)},
+			},
+			webhooks: map[uuid.UUID]*storagewebhook.Webhook{
+				webhookA: {ID: webhookA, URL: "https://example.com/hook", EventTypes: []string{}},
+			},
+		}
+	}
+
+	secret, hash, err := auth.NewToken()
+	require.NoError(t, err)
+	sam := &user.User{ID: uuid.Must(uuid.NewV4()), Username: "sam"}
+	tokens := &fakeTokenStore{
+		tokens: map[string]*user.Token{string(hash): {ID: uuid.Must(uuid.NewV4()), UserID: sam.ID, Scope: user.ScopeWrite}},
+		user:   sam,
+	}
+	memberships := fakeMemberships{ledgerA: ledger.RoleEditor, ledgerB: ledger.RoleEditor}
+
+	newServer := func(t *testing.T, rows *ledgerRows) http.Handler {
+		op := operator.NewOperatorDelegator(rowsStorage{rows: rows}, 1, 0)
+		op.Start()
+		t.Cleanup(func() { _ = op.Stop(context.Background()) })
+
+		mux := http.NewServeMux()
+		api := humago.New(mux, huma.DefaultConfig("Budget API", "1.0.0"))
+		account.NewGetAccountHandler(accountRows{ledgerRows: rows}).Register(api)
+		transaction.NewGetTransactionHandler(transactionRows{ledgerRows: rows}).Register(api)
+		transaction.NewCreateTransactionHandler(op).Register(api)
+		category.NewGetCategoryHandler(categoryRows{ledgerRows: rows}).Register(api)
+		webhook.NewGetWebhookHandler(webhookRows{ledgerRows: rows}).Register(api)
+		webhook.NewUpdateWebhookHandler(op).Register(api)
+		webhook.NewDeleteWebhookHandler(op).Register(api)
+		logger := logrus.New()
+		return authMiddleware(auth.NewAuthenticator(tokens), logger)(ledgerMiddleware(memberships, logger)(mux))
+	}
+
+	createTransaction := func(accountID, categoryID uuid.UUID) string {
+		return `{"accountID":"` + accountID.String() + `","categoryID":"` + categoryID.String() +
+			`","amount":"-10","transactionName":"Lunch","transactionDate":"2026-03-01T12:00:00Z"}`
+	}
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		ledgerID   uuid.UUID
+		wantStatus int
+		wantWrite  bool
+	}{
+		{"own account", http.MethodGet, "/v1/accounts/" + accountA.String(), "", ledgerA, http.StatusOK, false},
+		{"other ledger's account", http.MethodGet, "/v1/accounts/" + accountA.String(), "", ledgerB, http.StatusNotFound, false},
+		{"own transaction", http.MethodGet, "/v1/transaction/" + transactionA.String(), "", ledgerA, http.StatusOK, false},
+		{"other ledger's transaction", http.MethodGet, "/v1/transaction/" + transactionA.String(), "", ledgerB, http.StatusNotFound, false},
+		{"own category", http.MethodGet, "/v1/categories/" + categoryA.String(), "", ledgerA, http.StatusOK, false},
+		{"other ledger's category", http.MethodGet, "/v1/categories/" + categoryA.String(), "", ledgerB, http.StatusNotFound, false},
+		{"own webhook", http.MethodGet, "/v1/webhooks/" + webhookA.String(), "", ledgerA, http.StatusOK, false},
+		{"other ledger's webhook", http.MethodGet, "/v1/webhooks/" + webhookA.String(), "", ledgerB, http.StatusNotFound, false},
+		{"update own webhook", http.MethodPatch, "/v1/webhooks/" + webhookA.String(), `{"isDisabled":true}`, ledgerA, http.StatusOK, true},
+		{"update other ledger's webhook", http.MethodPatch, "/v1/webhooks/" + webhookA.String(), `{"isDisabled":true}`, ledgerB, http.StatusNotFound, false},
+		{"delete other ledger's webhook", http.MethodDelete, "/v1/webhooks/" + webhookA.String(), "", ledgerB, http.StatusNotFound, false},
+		{"transaction on other ledger's account", http.MethodPost, "/v1/transaction", createTransaction(accountA, categoryB), ledgerB, http.StatusNotFound, false},
+		{"transaction in other ledger's category", http.MethodPost, "/v1/transaction", createTransaction(accountB, categoryA), ledgerB, http.StatusNotFound, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows := newRows()
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer "+secret)
+			req.Header.Set(ledgerIDHeader, tt.ledgerID.String())
+			if tt.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			rec := httptest.NewRecorder()
+
+			newServer(t, rows).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantWrite {
+				assert.NotEmpty(t, rows.writes)
+			} else {
+				assert.Empty(t, rows.writes)
+			}
+		})
+	}
+}
@@ -1,37 +1,301 @@
 package api
 
 import (
+	"context"
+	"crypto/tls"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/danielgtaylor/huma/v2/adapters/humago"
+	"github.com/gofrs/uuid/v5"
 	"github.com/sirupsen/logrus"
 
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/events"
 	"github.com/carson-networks/budget-server/internal/handlers/v1/account"
+	"github.com/carson-networks/budget-server/internal/handlers/v1/audit"
+	"github.com/carson-networks/budget-server/internal/handlers/v1/batch"
 	"github.com/carson-networks/budget-server/internal/handlers/v1/category"
+	"github.com/carson-networks/budget-server/internal/handlers/v1/ledger"
 	"github.com/carson-networks/budget-server/internal/handlers/v1/status"
+	"github.com/carson-networks/budget-server/internal/handlers/v1/stream"
 	"github.com/carson-networks/budget-server/internal/handlers/v1/transaction"
+	"github.com/carson-networks/budget-server/internal/handlers/v1/user"
+	"github.com/carson-networks/budget-server/internal/handlers/v1/webhook"
 	"github.com/carson-networks/budget-server/internal/logging"
+	"github.com/carson-networks/budget-server/internal/metrics"
 	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/pagination"
+	"github.com/carson-networks/budget-server/internal/requestctx"
 	"github.com/carson-networks/budget-server/internal/storage"
+	storageledger "github.com/carson-networks/budget-server/internal/storage/ledger"
+	storageuser "github.com/carson-networks/budget-server/internal/storage/user"
+	"github.com/carson-networks/budget-server/internal/webhooks"
 )
 
-func corsMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS, PATCH")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With")
+// CORSPolicy decides which browser origins may call the API.
+type CORSPolicy struct {
+	// AllowedOrigins lists exact origins, or "*" for any origin.
+	AllowedOrigins []string
+	// AllowCredentials lets browsers send cookies and Authorization headers
+	// cross-origin. It is never combined with a wildcard origin.
+	AllowCredentials bool
+}
 
-		if r.Method == http.MethodOptions {
-			w.WriteHeader(http.StatusNoContent)
-			return
+// allowOrigin returns the Access-Control-Allow-Origin value for origin, or
+// "" when the origin is not allowed.
+func (p CORSPolicy) allowOrigin(origin string) string {
+	for _, allowed := range p.AllowedOrigins {
+		if allowed == "*" && !p.AllowCredentials {
+			return "*"
 		}
+		if origin != "" && strings.EqualFold(allowed, origin) {
+			return origin
+		}
+	}
+	return ""
+}
 
-		next.ServeHTTP(w, r)
+func corsMiddleware(policy CORSPolicy) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("Vary", "Origin")
+			if allowed := policy.allowOrigin(r.Header.Get("Origin")); allowed != "" {
+				w.Header().Set("Access-Control-Allow-Origin", allowed)
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS, PATCH")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With, Idempotency-Key, Last-Event-ID, X-Request-ID, X-Ledger-ID")
+				w.Header().Set("Access-Control-Expose-Headers", "Location, X-Request-ID")
+				if policy.AllowCredentials {
+					w.Header().Set("Access-Control-Allow-Credentials", "true")
+				}
+			}
+
+			if r.Method == http.MethodOptions {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+const (
+	requestIDHeader = "X-Request-ID"
+	// maxRequestIDLength bounds caller-supplied request IDs; longer ones are
+	// replaced.
+	maxRequestIDLength = 128
+)
+
+// requestMiddleware gives every request an ID, taken from X-Request-ID when
+// the caller sent one, and echoes it in the response.
+func requestMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(requestIDHeader)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = uuid.Must(uuid.NewV4()).String()
+		}
+		w.Header().Set(requestIDHeader, requestID)
+
+		ctx := requestctx.WithRequestID(r.Context(), requestID)
+		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// openEndpoints are the /v1 endpoints that may be called without a token:
+// logging in, and creating the first user, which CreateUserHandler refuses
+// once there is one.
+var openEndpoints = map[string]bool{
+	http.MethodPost + " /v1/auth/login": true,
+	http.MethodPost + " /v1/users":      true,
+}
+
+const (
+	// streamPath is the event stream, which browsers open with EventSource.
+	// EventSource can't send headers, so a stream token and the ledger may
+	// be passed in its query instead.
+	streamPath = "/v1/events/stream"
+	// streamTokenParam and streamLedgerParam are the query parameters of
+	// streamPath that stand in for the Authorization and X-Ledger-ID
+	// headers.
+	streamTokenParam  = "token"
+	streamLedgerParam = "ledgerID"
+)
+
+// isStream reports whether r opens the event stream.
+func isStream(r *http.Request) bool {
+	return r.Method == http.MethodGet && r.URL.Path == streamPath
+}
+
+// authMiddleware requires a bearer token on every /v1 request other than
+// openEndpoints, and a write-scoped token on anything but GET and HEAD. The
+// event stream also takes a stream token in its query; stream tokens are
+// accepted nowhere else. The authenticated user is recorded against the
+// request's writes in the audit log. /status, the health probes, /metrics
+// and the API docs stay open.
+func authMiddleware(authenticator *auth.Authenticator, logger *logrus.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.URL.Path, "/v1/") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			authorization := r.Header.Get("Authorization")
+			if authorization == "" && openEndpoints[r.Method+" "+r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+			fromQuery := false
+			if authorization == "" && isStream(r) && r.URL.Query().Has(streamTokenParam) {
+				authorization = "Bearer " + r.URL.Query().Get(streamTokenParam)
+				fromQuery = true
+			}
+
+			principal, err := authenticator.Authenticate(r.Context(), authorization)
+			if err == nil && fromQuery != (principal.Kind == storageuser.TokenKindStream) {
+				err = auth.ErrUnauthenticated
+			}
+			if errors.Is(err, auth.ErrUnauthenticated) {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				writeError(w, huma.NewError(http.StatusUnauthorized, err.Error()))
+				return
+			}
+			if err != nil {
+				logger.WithError(err).Error("authMiddleware.Authenticate")
+				writeError(w, huma.NewError(http.StatusInternalServerError, "failed to authenticate"))
+				return
+			}
+			if r.Method != http.MethodGet && r.Method != http.MethodHead && !principal.CanWrite() {
+				writeError(w, huma.NewError(http.StatusForbidden, "token has read-only scope"))
+				return
+			}
+
+			ctx := auth.WithPrincipal(r.Context(), principal)
+			ctx = requestctx.WithActor(ctx, principal.Username)
+			ctx = requestctx.WithActorID(ctx, principal.UserID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+const ledgerIDHeader = "X-Ledger-ID"
+
+// ledgerMemberships looks up a user's role in a ledger.
+type ledgerMemberships interface {
+	GetMembership(ctx context.Context, ledgerID uuid.UUID, userID uuid.UUID) (*storageledger.Membership, error)
+}
+
+// ledgerPathPrefix starts the routes that work on the ledger named in their
+// path rather than by X-Ledger-ID, such as its members.
+const ledgerPathPrefix = "/v1/ledgers/"
+
+// ledgerScoped reports whether path works on the ledger named by
+// X-Ledger-ID. Signing in, managing users and tokens, and listing and
+// creating ledgers don't.
+func ledgerScoped(path string) bool {
+	if !strings.HasPrefix(path, "/v1/") || strings.HasPrefix(path, "/v1/auth/") {
+		return false
+	}
+	return path != "/v1/users" && path != "/v1/ledgers" && !strings.HasPrefix(path, ledgerPathPrefix)
+}
+
+// pathLedger returns the ledger ID in a /v1/ledgers/{id}/... path.
+func pathLedger(path string) (string, bool) {
+	rest, ok := strings.CutPrefix(path, ledgerPathPrefix)
+	if !ok {
+		return "", false
+	}
+	id, _, ok := strings.Cut(rest, "/")
+	return id, ok
+}
+
+// ledgerMiddleware runs ledger-scoped requests in the ledger named by
+// X-Ledger-ID, the event stream's ledgerID query parameter, or the path of
+// a /v1/ledgers/{id}/... route. The authenticated user must be a member of
+// it, and their role there goes in context for the operator to check
+// actions against. Viewers are also turned away from anything but GET and
+// HEAD on X-Ledger-ID routes, which covers writes that don't go through the
+// operator; every write under /v1/ledgers/{id}/ is an action, so the
+// operator alone decides those. Storage refuses ledger-owned queries made
+// without a ledger. Ledgers the user isn't a member of are reported as not
+// found so their IDs can't be probed.
+func ledgerMiddleware(memberships ledgerMemberships, logger *logrus.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			pathID, inPath := pathLedger(r.URL.Path)
+			if !inPath && !ledgerScoped(r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			principal := auth.FromContext(r.Context())
+			if principal == nil {
+				writeError(w, huma.NewError(http.StatusUnauthorized, "authentication required"))
+				return
+			}
+			var ledgerID uuid.UUID
+			if inPath {
+				id, err := uuid.FromString(pathID)
+				if err != nil {
+					writeError(w, huma.NewError(http.StatusBadRequest, "invalid ledger id"))
+					return
+				}
+				ledgerID = id
+			} else {
+				header := r.Header.Get(ledgerIDHeader)
+				if header == "" && isStream(r) {
+					header = r.URL.Query().Get(streamLedgerParam)
+				}
+				if header == "" {
+					writeError(w, huma.NewError(http.StatusBadRequest, ledgerIDHeader+" header is required"))
+					return
+				}
+				id, err := uuid.FromString(header)
+				if err != nil {
+					writeError(w, huma.NewError(http.StatusBadRequest, "invalid "+ledgerIDHeader+" header"))
+					return
+				}
+				ledgerID = id
+			}
+
+			membership, err := memberships.GetMembership(r.Context(), ledgerID, principal.UserID)
+			if errors.Is(err, sql.ErrNoRows) {
+				writeError(w, huma.NewError(http.StatusNotFound, "ledger not found"))
+				return
+			}
+			if err != nil {
+				logger.WithError(err).Error("ledgerMiddleware.GetMembership")
+				writeError(w, huma.NewError(http.StatusInternalServerError, "failed to check ledger membership"))
+				return
+			}
+			if !inPath && r.Method != http.MethodGet && r.Method != http.MethodHead && !membership.Role.Allows(storageledger.RoleEditor) {
+				writeError(w, huma.NewError(http.StatusForbidden, "ledger viewers cannot make changes"))
+				return
+			}
+
+			ctx := requestctx.WithLedgerID(r.Context(), ledgerID)
+			ctx = requestctx.WithLedgerRole(ctx, membership.Role)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// writeError writes err in the same problem+json shape Huma uses.
+func writeError(w http.ResponseWriter, err huma.StatusError) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(err.GetStatus())
+	_ = json.NewEncoder(w).Encode(err)
+}
+
 type responseWriter struct {
 	http.ResponseWriter
 	statusCode int
@@ -42,12 +306,34 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap lets http.ResponseController reach the underlying writer so
+// streaming handlers can flush and extend their write deadline.
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
+// unmatchedOperation labels metrics for requests that reached no named
+// operation, such as 404s and the API docs, keeping label values bounded.
+const unmatchedOperation = "other"
+
+// operationMiddleware names the Huma operation in the request's log data,
+// for loggingMiddleware to log and label metrics with.
+func operationMiddleware(ctx huma.Context, next func(huma.Context)) {
+	if logData := logging.GetLogData(ctx.Context()); logData != nil {
+		logData.AddData(logging.OperationKey, ctx.Operation().OperationID)
+	}
+	next(ctx)
+}
+
+// loggingMiddleware logs each request and records its count and latency,
+// labelled by the operation it ran.
 func loggingMiddleware(logger *logrus.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			logData := logging.NewLogData(logger)
 			logData.AddData("method", r.Method)
 			logData.AddData("path", r.URL.Path)
+			logData.AddData("requestID", requestctx.RequestID(r.Context()))
 
 			ctx := logging.WithLogData(r.Context(), logData)
 			r = r.WithContext(ctx)
@@ -57,6 +343,7 @@ func loggingMiddleware(logger *logrus.Logger) func(http.Handler) http.Handler {
 				"path":   r.URL.Path,
 			}).Info("Handler.Start")
 
+			start := time.Now()
 			stopTimer := logData.AddTiming("durationMs")
 			rw := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
 			next.ServeHTTP(rw, r)
@@ -64,62 +351,271 @@ func loggingMiddleware(logger *logrus.Logger) func(http.Handler) http.Handler {
 
 			logData.AddData("status", rw.statusCode)
 			logData.Log().Info("Handler.Complete")
+
+			operation := unmatchedOperation
+			if name, ok := logData.Data(logging.OperationKey); ok {
+				operation = name.(string)
+			}
+			metrics.HTTPRequests.WithLabelValues(operation, r.Method, strconv.Itoa(rw.statusCode)).Inc()
+			metrics.HTTPDuration.WithLabelValues(operation, r.Method).Observe(time.Since(start).Seconds())
 		})
 	}
 }
 
+// metricsHandler names the operation for requests to /metrics, which is a
+// plain handler rather than a Huma operation or LoggingWrapper.
+func metricsHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if logData := logging.GetLogData(r.Context()); logData != nil {
+			logData.AddData(logging.OperationKey, "metrics")
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// defaultShutdownTimeout is used when Rest.ShutdownTimeout is unset.
+const defaultShutdownTimeout = 15 * time.Second
+
 type Rest struct {
-	Logger   *logrus.Logger
-	Port     string
+	Logger *logrus.Logger
+	// Addr is the listen address, e.g. ":9446" or "192.168.1.10:9446".
+	Addr string
+	// CORS decides which browser origins may call the API.
+	CORS CORSPolicy
+	// TLSCertFile and TLSKeyFile, when both set, serve HTTPS. The pair is
+	// reloaded from disk on SIGHUP.
+	TLSCertFile string
+	TLSKeyFile  string
+	// ShutdownTimeout bounds how long Serve waits for in-flight requests
+	// once its context ends.
+	ShutdownTimeout time.Duration
+	// Timeouts for each connection; see http.Server.
+	ReadTimeout       time.Duration
+	ReadHeaderTimeout time.Duration
+	WriteTimeout      time.Duration
+	IdleTimeout       time.Duration
+
 	Storage  *storage.Storage
 	Operator *operator.OperatorDelegator
+	// SchemaVersion is the migration version GET /readyz expects the
+	// database to be at.
+	SchemaVersion uint
+	Cursors       *pagination.Codec
+	// BatchMaxItems caps the number of items in one POST /v1/batch request.
+	BatchMaxItems int
+	// Webhooks sends test deliveries for POST /v1/webhooks/{id}/test.
+	Webhooks *webhooks.Deliverer
+	// Events feeds GET /v1/events/stream.
+	Events *events.Broker
 }
 
-func (r *Rest) Serve() {
+// routes registers every endpoint and wraps them in the middleware chain.
+func (r *Rest) routes() http.Handler {
 	mux := http.NewServeMux()
 
 	config := huma.DefaultConfig("Budget API", "1.0.0")
 	api := humago.New(mux, config)
+	// Middlewares apply to operations registered after them.
+	api.UseMiddleware(operationMiddleware)
 
 	statusHandler := status.NewHandler(r.Operator)
 	mux.HandleFunc("/status", logging.LoggingWrapper("Status", r.Logger, statusHandler.Handler))
 
-	listTransactionsHandler := transaction.NewListTransactionsHandler(r.Storage.Read().Transactions)
+	livenessHandler := status.NewHealthHandler(map[string]status.Check{
+		"operator": status.WorkersCheck(r.Operator),
+	})
+	mux.HandleFunc("/healthz", logging.LoggingWrapper("Healthz", r.Logger, livenessHandler.Handler))
+
+	readinessHandler := status.NewHealthHandler(map[string]status.Check{
+		"database":   status.DatabaseCheck(r.Storage),
+		"migrations": status.MigrationCheck(r.Storage, r.SchemaVersion),
+		"operator":   status.QueueCheck(r.Operator),
+	})
+	mux.HandleFunc("/readyz", logging.LoggingWrapper("Readyz", r.Logger, readinessHandler.Handler))
+
+	mux.Handle("/metrics", metricsHandler(metrics.Handler()))
+
+	listTransactionsHandler := transaction.NewListTransactionsHandler(r.Storage.Read().Transactions, r.Cursors)
 	listTransactionsHandler.Register(api)
 
-	listAccountsHandler := account.NewListAccountsHandler(r.Storage.Read().Accounts)
+	getTransactionHandler := transaction.NewGetTransactionHandler(r.Storage.Read().Transactions)
+	getTransactionHandler.Register(api)
+
+	listAccountsHandler := account.NewListAccountsHandler(r.Storage.Read().Accounts, r.Cursors)
 	listAccountsHandler.Register(api)
 
+	getAccountHandler := account.NewGetAccountHandler(r.Storage.Read().Accounts)
+	getAccountHandler.Register(api)
+
 	createAccountHandler := account.NewCreateAccountHandler(r.Operator)
 	createAccountHandler.Register(api)
 
 	createTransactionHandler := transaction.NewCreateTransactionHandler(r.Operator)
 	createTransactionHandler.Register(api)
 
-	listCategoriesHandler := category.NewListCategoriesHandler(r.Storage.Read().Categories)
+	listCategoriesHandler := category.NewListCategoriesHandler(r.Storage.Read().Categories, r.Cursors)
 	listCategoriesHandler.Register(api)
 
+	categoryTreeHandler := category.NewCategoryTreeHandler(r.Storage.Read().Categories, r.Storage.Read().Transactions)
+	categoryTreeHandler.Register(api)
+
+	getCategoryHandler := category.NewGetCategoryHandler(r.Storage.Read().Categories)
+	getCategoryHandler.Register(api)
+
 	createCategoryHandler := category.NewCreateCategoryHandler(r.Operator, r.Storage.Read().Categories)
 	createCategoryHandler.Register(api)
 
 	updateCategoryHandler := category.NewUpdateCategoryHandler(r.Operator, r.Storage.Read().Categories)
 	updateCategoryHandler.Register(api)
 
-	handler := loggingMiddleware(r.Logger)(corsMiddleware(mux))
+	mergeCategoriesHandler := category.NewMergeCategoriesHandler(r.Operator)
+	mergeCategoriesHandler.Register(api)
+
+	deleteCategoryHandler := category.NewDeleteCategoryHandler(r.Operator)
+	deleteCategoryHandler.Register(api)
+
+	categoryTemplatesHandler := category.NewCategoryTemplatesHandler(r.Operator)
+	categoryTemplatesHandler.Register(api)
+
+	batchHandler := batch.NewBatchHandler(r.Operator, r.BatchMaxItems)
+	batchHandler.Register(api)
+
+	listWebhooksHandler := webhook.NewListWebhooksHandler(r.Storage.Read().Webhooks)
+	listWebhooksHandler.Register(api)
+
+	getWebhookHandler := webhook.NewGetWebhookHandler(r.Storage.Read().Webhooks)
+	getWebhookHandler.Register(api)
+
+	createWebhookHandler := webhook.NewCreateWebhookHandler(r.Operator)
+	createWebhookHandler.Register(api)
+
+	updateWebhookHandler := webhook.NewUpdateWebhookHandler(r.Operator)
+	updateWebhookHandler.Register(api)
+
+	deleteWebhookHandler := webhook.NewDeleteWebhookHandler(r.Operator)
+	deleteWebhookHandler.Register(api)
+
+	listDeliveriesHandler := webhook.NewListDeliveriesHandler(r.Storage.Read().Webhooks)
+	listDeliveriesHandler.Register(api)
+
+	testWebhookHandler := webhook.NewTestWebhookHandler(r.Webhooks)
+	testWebhookHandler.Register(api)
+
+	streamEventsHandler := stream.NewStreamEventsHandler(r.Events, r.Storage.Outbox())
+	streamEventsHandler.Register(api)
 
-	server := http.Server{
-		Addr:              ":" + r.Port,
-		Handler:           handler,
-		ReadTimeout:       time.Duration(30) * time.Second,
-		WriteTimeout:      time.Duration(30) * time.Second,
-		IdleTimeout:       time.Duration(10) * time.Second,
-		ReadHeaderTimeout: time.Duration(10) * time.Second,
+	listAuditRecordsHandler := audit.NewListAuditRecordsHandler(r.Storage.Read().Audit)
+	listAuditRecordsHandler.Register(api)
+
+	undoActionHandler := audit.NewUndoActionHandler(r.Operator)
+	undoActionHandler.Register(api)
+
+	createUserHandler := user.NewCreateUserHandler(r.Operator)
+	createUserHandler.Register(api)
+
+	loginHandler := user.NewLoginHandler(r.Operator, r.Storage.Read().Users)
+	loginHandler.Register(api)
+
+	logoutHandler := user.NewLogoutHandler(r.Operator)
+	logoutHandler.Register(api)
+
+	listTokensHandler := user.NewListTokensHandler(r.Storage.Read().Users)
+	listTokensHandler.Register(api)
+
+	createTokenHandler := user.NewCreateTokenHandler(r.Operator)
+	createTokenHandler.Register(api)
+
+	createStreamTokenHandler := user.NewCreateStreamTokenHandler(r.Operator)
+	createStreamTokenHandler.Register(api)
+
+	deleteTokenHandler := user.NewDeleteTokenHandler(r.Operator)
+	deleteTokenHandler.Register(api)
+
+	listLedgersHandler := ledger.NewListLedgersHandler(r.Storage.Read().Ledgers)
+	listLedgersHandler.Register(api)
+
+	createLedgerHandler := ledger.NewCreateLedgerHandler(r.Operator)
+	createLedgerHandler.Register(api)
+
+	listMembersHandler := ledger.NewListMembersHandler(r.Storage.Read().Ledgers)
+	listMembersHandler.Register(api)
+
+	setMemberHandler := ledger.NewSetMemberHandler(r.Operator, r.Storage.Read().Users)
+	setMemberHandler.Register(api)
+
+	removeMemberHandler := ledger.NewRemoveMemberHandler(r.Operator, r.Storage.Read().Users)
+	removeMemberHandler.Register(api)
+
+	authenticator := auth.NewAuthenticator(r.Storage.Read().Users)
+	return requestMiddleware(loggingMiddleware(r.Logger)(corsMiddleware(r.CORS)(
+		authMiddleware(authenticator, r.Logger)(ledgerMiddleware(r.Storage.Read().Ledgers, r.Logger)(mux)))))
+}
+
+// Serve listens on Addr and serves until ctx ends. It then stops accepting
+// connections, ends event streams and waits up to ShutdownTimeout for
+// in-flight requests to finish.
+func (r *Rest) Serve(ctx context.Context) error {
+	server := &http.Server{
+		Handler:           r.routes(),
+		ReadTimeout:       r.ReadTimeout,
+		ReadHeaderTimeout: r.ReadHeaderTimeout,
+		WriteTimeout:      r.WriteTimeout,
+		IdleTimeout:       r.IdleTimeout,
+	}
+	if r.Events != nil {
+		server.RegisterOnShutdown(r.Events.Close)
 	}
 
-	r.Logger.Info("HttpServer.Serve.listening")
-	err := server.ListenAndServe()
+	listener, err := net.Listen("tcp", r.Addr)
 	if err != nil {
-		r.Logger.WithError(err).Error("HttpServer.Serve.listen error")
+		return fmt.Errorf("listen on %s: %w", r.Addr, err)
+	}
+
+	if r.TLSCertFile != "" {
+		certs, err := newCertReloader(r.TLSCertFile, r.TLSKeyFile)
+		if err != nil {
+			_ = listener.Close()
+			return err
+		}
+		stopWatch := certs.watchSIGHUP(r.Logger)
+		defer stopWatch()
+		server.TLSConfig = &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: certs.GetCertificate,
+		}
+		listener = tls.NewListener(listener, server.TLSConfig)
 	}
+
+	r.Logger.WithField("addr", r.Addr).WithField("tls", server.TLSConfig != nil).Info("HttpServer.Serve.listening")
+	return r.serve(ctx, server, listener)
+}
+
+// serve runs server on listener until ctx ends, then shuts it down.
+func (r *Rest) serve(ctx context.Context, server *http.Server, listener net.Listener) error {
+	served := make(chan error, 1)
+	go func() {
+		served <- server.Serve(listener)
+	}()
+
+	select {
+	case err := <-served:
+		return err
+	case <-ctx.Done():
+	}
+
 	r.Logger.Info("HttpServer.Serve.shutting down")
+	timeout := r.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = defaultShutdownTimeout
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		_ = server.Close()
+		return fmt.Errorf("shutdown: %w", err)
+	}
+	if err := <-served; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
 }
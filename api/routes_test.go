@@ -0,0 +1,264 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/adapters/humago"
+	"github.com/gofrs/uuid/v5"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/logging"
+	"github.com/carson-networks/budget-server/internal/metrics"
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+type fakeTokenStore struct {
+	tokens map[string]*user.Token
+	user   *user.User
+}
+
+func (f *fakeTokenStore) GetTokenByHash(_ context.Context, hash []byte) (*user.Token, error) {
+	if token, ok := f.tokens[string(hash)]; ok {
+		return token, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (f *fakeTokenStore) GetByID(context.Context, uuid.UUID) (*user.User, error) {
+	return f.user, nil
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	sam := &user.User{ID: uuid.Must(uuid.NewV4()), Username: "sam"}
+	store := &fakeTokenStore{tokens: map[string]*user.Token{}, user: sam}
+	issue := func(kind user.TokenKind, scope user.Scope) string {
+		secret, hash, err := auth.NewToken()
+		require.NoError(t, err)
+		store.tokens[string(hash)] = &user.Token{ID: uuid.Must(uuid.NewV4()), UserID: sam.ID, Kind: kind, Scope: scope}
+		return secret
+	}
+	readSecret := issue(user.TokenKindPersonal, user.ScopeRead)
+	readToken := "Bearer " + readSecret
+	writeToken := "Bearer " + issue(user.TokenKindSession, user.ScopeWrite)
+	streamSecret := issue(user.TokenKindStream, user.ScopeRead)
+
+	var seenActor string
+	var seenPrincipal *auth.Principal
+	handler := authMiddleware(auth.NewAuthenticator(store), logrus.New())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenActor = requestctx.Actor(r.Context())
+		seenPrincipal = auth.FromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		authorization string
+		wantStatus    int
+		wantActor     string
+	}{
+		{"status is open", http.MethodGet, "/status", "", http.StatusOK, requestctx.Anonymous},
+		{"docs are open", http.MethodGet, "/docs", "", http.StatusOK, requestctx.Anonymous},
+		{"login is open", http.MethodPost, "/v1/auth/login", "", http.StatusOK, requestctx.Anonymous},
+		{"first user is open", http.MethodPost, "/v1/users", "", http.StatusOK, requestctx.Anonymous},
+		{"v1 needs a token", http.MethodGet, "/v1/accounts", "", http.StatusUnauthorized, ""},
+		{"unknown token", http.MethodGet, "/v1/accounts", "Bearer bst_nope", http.StatusUnauthorized, ""},
+		{"bad token on open endpoint", http.MethodPost, "/v1/users", "Bearer bst_nope", http.StatusUnauthorized, ""},
+		{"read token reads", http.MethodGet, "/v1/accounts", readToken, http.StatusOK, "sam"},
+		{"read token cannot write", http.MethodPost, "/v1/accounts", readToken, http.StatusForbidden, ""},
+		{"write token writes", http.MethodPost, "/v1/accounts", writeToken, http.StatusOK, "sam"},
+		{"token on open endpoint is used", http.MethodPost, "/v1/users", writeToken, http.StatusOK, "sam"},
+		{"stream token opens the stream", http.MethodGet, "/v1/events/stream?token=" + streamSecret, "", http.StatusOK, "sam"},
+		{"header token opens the stream", http.MethodGet, "/v1/events/stream", readToken, http.StatusOK, "sam"},
+		{"only stream tokens in the query", http.MethodGet, "/v1/events/stream?token=" + readSecret, "", http.StatusUnauthorized, ""},
+		{"query token only on the stream", http.MethodGet, "/v1/accounts?token=" + streamSecret, "", http.StatusUnauthorized, ""},
+		{"stream token not in the header", http.MethodGet, "/v1/accounts", "Bearer " + streamSecret, http.StatusUnauthorized, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seenActor, seenPrincipal = "", nil
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantActor, seenActor)
+			if tt.wantActor == "sam" {
+				require.NotNil(t, seenPrincipal)
+				assert.Equal(t, sam.ID, seenPrincipal.UserID)
+			}
+			if tt.wantStatus == http.StatusUnauthorized {
+				assert.Equal(t, "Bearer", rec.Header().Get("WWW-Authenticate"))
+				assert.Equal(t, "application/problem+json", rec.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
+
+type fakeMemberships map[uuid.UUID]ledger.Role
+
+func (f fakeMemberships) GetMembership(_ context.Context, ledgerID uuid.UUID, userID uuid.UUID) (*ledger.Membership, error) {
+	role, ok := f[ledgerID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &ledger.Membership{LedgerID: ledgerID, UserID: userID, Role: role}, nil
+}
+
+func TestLedgerMiddleware(t *testing.T) {
+	editorLedger := uuid.Must(uuid.NewV4())
+	viewerLedger := uuid.Must(uuid.NewV4())
+	otherLedger := uuid.Must(uuid.NewV4())
+	memberships := fakeMemberships{editorLedger: ledger.RoleEditor, viewerLedger: ledger.RoleViewer}
+
+	var seenLedger uuid.UUID
+	var seenRole ledger.Role
+	handler := ledgerMiddleware(memberships, logrus.New())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenLedger, _ = requestctx.LedgerID(r.Context())
+		seenRole, _ = requestctx.LedgerRole(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		ledgerID   string
+		wantStatus int
+		wantLedger uuid.UUID
+	}{
+		{"status is unscoped", http.MethodGet, "/status", "", http.StatusOK, uuid.Nil},
+		{"auth is unscoped", http.MethodGet, "/v1/auth/tokens", "", http.StatusOK, uuid.Nil},
+		{"users are unscoped", http.MethodPost, "/v1/users", "", http.StatusOK, uuid.Nil},
+		{"ledgers are unscoped", http.MethodGet, "/v1/ledgers", "", http.StatusOK, uuid.Nil},
+		{"ledger path names the ledger", http.MethodGet, "/v1/ledgers/" + viewerLedger.String() + "/members", "", http.StatusOK, viewerLedger},
+		{"ledger path beats the header", http.MethodGet, "/v1/ledgers/" + viewerLedger.String() + "/members", editorLedger.String(), http.StatusOK, viewerLedger},
+		{"ledger path leaves writes to the operator", http.MethodDelete, "/v1/ledgers/" + viewerLedger.String() + "/members/me", "", http.StatusOK, viewerLedger},
+		{"ledger path must be a member's", http.MethodGet, "/v1/ledgers/" + otherLedger.String() + "/members", "", http.StatusNotFound, uuid.Nil},
+		{"ledger path must be a UUID", http.MethodGet, "/v1/ledgers/nope/members", "", http.StatusBadRequest, uuid.Nil},
+		{"ledger header is required", http.MethodGet, "/v1/accounts", "", http.StatusBadRequest, uuid.Nil},
+		{"ledger header must be a UUID", http.MethodGet, "/v1/accounts", "nope", http.StatusBadRequest, uuid.Nil},
+		{"other ledger is not found", http.MethodGet, "/v1/accounts", otherLedger.String(), http.StatusNotFound, uuid.Nil},
+		{"editor reads", http.MethodGet, "/v1/accounts", editorLedger.String(), http.StatusOK, editorLedger},
+		{"editor writes", http.MethodPost, "/v1/accounts", editorLedger.String(), http.StatusOK, editorLedger},
+		{"viewer reads", http.MethodGet, "/v1/accounts", viewerLedger.String(), http.StatusOK, viewerLedger},
+		{"viewer cannot write", http.MethodPost, "/v1/accounts", viewerLedger.String(), http.StatusForbidden, uuid.Nil},
+		{"stream takes the ledger from its query", http.MethodGet, "/v1/events/stream?ledgerID=" + viewerLedger.String(), "", http.StatusOK, viewerLedger},
+		{"stream query ledger must be a member's", http.MethodGet, "/v1/events/stream?ledgerID=" + otherLedger.String(), "", http.StatusNotFound, uuid.Nil},
+		{"only the stream takes a query ledger", http.MethodGet, "/v1/accounts?ledgerID=" + editorLedger.String(), "", http.StatusBadRequest, uuid.Nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seenLedger, seenRole = uuid.Nil, 0
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req = req.WithContext(auth.WithPrincipal(req.Context(), &auth.Principal{UserID: uuid.Must(uuid.NewV4())}))
+			if tt.ledgerID != "" {
+				req.Header.Set(ledgerIDHeader, tt.ledgerID)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantLedger, seenLedger)
+			if tt.wantLedger != uuid.Nil {
+				assert.Equal(t, memberships[tt.wantLedger], seenRole)
+			}
+		})
+	}
+}
+
+func TestCORSMiddleware(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) })
+	lan := CORSPolicy{AllowedOrigins: []string{"https://budget.lan"}, AllowCredentials: true}
+	anyOrigin := CORSPolicy{AllowedOrigins: []string{"*"}}
+
+	tests := []struct {
+		name            string
+		policy          CORSPolicy
+		method          string
+		origin          string
+		wantStatus      int
+		wantOrigin      string
+		wantCredentials string
+	}{
+		{"wildcard allows any origin", anyOrigin, http.MethodGet, "https://elsewhere.example", http.StatusOK, "*", ""},
+		{"listed origin is echoed", lan, http.MethodGet, "https://budget.lan", http.StatusOK, "https://budget.lan", "true"},
+		{"origin match ignores case", lan, http.MethodGet, "https://Budget.LAN", http.StatusOK, "https://Budget.LAN", "true"},
+		{"unlisted origin gets no headers", lan, http.MethodGet, "https://evil.example", http.StatusOK, "", ""},
+		{"missing origin gets no headers", lan, http.MethodGet, "", http.StatusOK, "", ""},
+		{"preflight is answered", lan, http.MethodOptions, "https://budget.lan", http.StatusNoContent, "https://budget.lan", "true"},
+		{"credentials never pair with wildcard", CORSPolicy{AllowedOrigins: []string{"*"}, AllowCredentials: true}, http.MethodGet, "https://budget.lan", http.StatusOK, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/v1/accounts", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			rec := httptest.NewRecorder()
+
+			corsMiddleware(tt.policy)(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantOrigin, rec.Header().Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, tt.wantCredentials, rec.Header().Get("Access-Control-Allow-Credentials"))
+			assert.Contains(t, rec.Header().Values("Vary"), "Origin")
+		})
+	}
+}
+
+func TestLoggingMiddleware_RecordsMetricsByOperation(t *testing.T) {
+	mux := http.NewServeMux()
+	humaAPI := humago.New(mux, huma.DefaultConfig("Test", "1.0.0"))
+	humaAPI.UseMiddleware(operationMiddleware)
+	huma.Register(humaAPI, huma.Operation{
+		OperationID: "get-widget",
+		Method:      http.MethodGet,
+		Path:        "/v1/widgets",
+	}, func(context.Context, *struct{}) (*struct{}, error) {
+		return nil, nil
+	})
+	mux.HandleFunc("/probe", logging.LoggingWrapper("Probe", logrus.New(), func(w http.ResponseWriter, _ *http.Request, _ *logging.LogData) error {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return nil
+	}))
+	handler := loggingMiddleware(logrus.New())(mux)
+
+	tests := []struct {
+		name      string
+		path      string
+		operation string
+		status    string
+	}{
+		{"huma operation", "/v1/widgets", "get-widget", "204"},
+		{"wrapped handler", "/probe", "probe", "503"},
+		{"unmatched path", "/v1/nothing-here", unmatchedOperation, "404"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := metrics.HTTPRequests.WithLabelValues(tt.operation, http.MethodGet, tt.status)
+			before := testutil.ToFloat64(requests)
+
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, before+1, testutil.ToFloat64(requests))
+		})
+	}
+}
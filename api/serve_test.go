@@ -0,0 +1,145 @@
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage"
+)
+
+// committingTx records whether the action's transaction committed.
+type committingTx struct {
+	committed chan struct{}
+}
+
+func (tx *committingTx) Commit(context.Context) error {
+	close(tx.committed)
+	return nil
+}
+
+func (tx *committingTx) Rollback(context.Context) error { return nil }
+
+// blockingAction signals when it starts and finishes once released.
+type blockingAction struct {
+	actions.IAction
+	started chan struct{}
+	release chan struct{}
+}
+
+func (a *blockingAction) Perform(context.Context, *storage.Writer) (any, error) {
+	close(a.started)
+	<-a.release
+	return "done", nil
+}
+
+func (a *blockingAction) RequiredPermission(context.Context) actions.Permission {
+	return actions.PermissionNone
+}
+
+func TestRest_Serve_GracefulShutdown(t *testing.T) {
+	tx := &committingTx{committed: make(chan struct{})}
+	writer := storage.NewWriterForTestWithTx(tx)
+	writer.Audit.(*storage.MockIAuditWriter).EXPECT().Insert(mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockStorage := &operator.MockIStorage{}
+	mockStorage.EXPECT().Write(mock.Anything, mock.Anything).Return(writer, nil).Once()
+
+	op := operator.NewOperatorDelegator(mockStorage, 1, operator.DefaultQueueCapacity)
+	op.Start()
+
+	action := &blockingAction{started: make(chan struct{}), release: make(chan struct{})}
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := op.Process(r.Context(), action); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	url := "http://" + listener.Addr().String()
+
+	ctx, stop := context.WithCancel(context.Background())
+	rest := &Rest{Logger: logrus.New(), ShutdownTimeout: 5 * time.Second}
+	served := make(chan error, 1)
+	go func() {
+		served <- rest.serve(ctx, server, listener)
+	}()
+
+	inFlight := make(chan *http.Response, 1)
+	go func() {
+		resp, err := http.Post(url, "application/json", nil)
+		if assert.NoError(t, err) {
+			inFlight <- resp
+		}
+		close(inFlight)
+	}()
+	<-action.started
+
+	// The signal arrives while the write is still running.
+	stop()
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		if err == nil {
+			_ = conn.Close()
+		}
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+
+	select {
+	case <-served:
+		t.Fatal("serve returned before the in-flight request finished")
+	default:
+	}
+
+	close(action.release)
+	resp := <-inFlight
+	require.NotNil(t, resp)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.NoError(t, <-served)
+
+	drainCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.NoError(t, op.Stop(drainCtx))
+	<-tx.committed
+	mockStorage.AssertExpectations(t)
+}
+
+func TestRest_Serve_ShutdownTimeout(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+	})}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, stop := context.WithCancel(context.Background())
+	rest := &Rest{Logger: logrus.New(), ShutdownTimeout: 50 * time.Millisecond}
+	served := make(chan error, 1)
+	go func() {
+		served <- rest.serve(ctx, server, listener)
+	}()
+	go func() {
+		if resp, err := http.Get("http://" + listener.Addr().String()); err == nil {
+			_ = resp.Body.Close()
+		}
+	}()
+	<-started
+
+	stop()
+
+	assert.ErrorIs(t, <-served, context.DeadlineExceeded)
+}
@@ -0,0 +1,153 @@
+// Package auth authenticates API requests by bearer token and hashes the
+// passwords and tokens it checks them against.
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+// ErrUnauthenticated is returned by Authenticate when the request has no
+// valid token.
+var ErrUnauthenticated = errors.New("missing, invalid or expired token")
+
+const (
+	// SessionTTL is how long a token issued by password login lasts.
+	SessionTTL = 24 * time.Hour
+	// StreamTokenTTL is how long a stream token may be used to open the
+	// event stream.
+	StreamTokenTTL = 5 * time.Minute
+
+	// tokenPrefix marks a string as one of our tokens, so it can be spotted
+	// if leaked.
+	tokenPrefix = "bst_"
+	tokenBytes  = 32
+)
+
+// Principal is the user a request was authenticated as.
+type Principal struct {
+	UserID   uuid.UUID
+	Username string
+	TokenID  uuid.UUID
+	Kind     user.TokenKind
+	Scope    user.Scope
+}
+
+// CanWrite reports whether the principal's token may make changes.
+func (p *Principal) CanWrite() bool {
+	return p.Scope.Allows(user.ScopeWrite)
+}
+
+type contextKey struct{}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, principal)
+}
+
+// FromContext returns the request's principal, or nil when the request was
+// not authenticated.
+func FromContext(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(contextKey{}).(*Principal)
+	return principal
+}
+
+// NewToken returns a random bearer token and the hash it is stored under.
+func NewToken() (string, []byte, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, err
+	}
+	token := tokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return token, HashToken(token), nil
+}
+
+// HashToken returns the hash a token is stored and looked up by. Tokens are
+// random, so an unsalted fast hash is enough.
+func HashToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
+
+// HashPassword returns the bcrypt hash of password.
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// dummyHash is compared against when the user does not exist, so a login for
+// an unknown username takes as long as one with a wrong password.
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("budget-server"), bcrypt.DefaultCost)
+
+// CheckPassword reports whether password matches u's hash. u may be nil.
+func CheckPassword(u *user.User, password string) bool {
+	if u == nil {
+		_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) == nil
+}
+
+// TokenStore looks up tokens and their users.
+type TokenStore interface {
+	GetTokenByHash(ctx context.Context, hash []byte) (*user.Token, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*user.User, error)
+}
+
+// Authenticator resolves Authorization headers to principals.
+type Authenticator struct {
+	store TokenStore
+	now   func() time.Time
+}
+
+func NewAuthenticator(store TokenStore) *Authenticator {
+	return &Authenticator{store: store, now: time.Now}
+}
+
+// Authenticate returns the principal for an "Authorization: Bearer <token>"
+// header, or ErrUnauthenticated.
+func (a *Authenticator) Authenticate(ctx context.Context, authorization string) (*Principal, error) {
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || !strings.HasPrefix(token, tokenPrefix) {
+		return nil, ErrUnauthenticated
+	}
+
+	stored, err := a.store.GetTokenByHash(ctx, HashToken(token))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrUnauthenticated
+	}
+	if err != nil {
+		return nil, err
+	}
+	if stored.Expired(a.now()) {
+		return nil, ErrUnauthenticated
+	}
+
+	u, err := a.store.GetByID(ctx, stored.UserID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrUnauthenticated
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Principal{
+		UserID:   u.ID,
+		Username: u.Username,
+		TokenID:  stored.ID,
+		Kind:     stored.Kind,
+		Scope:    stored.Scope,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+type fakeTokenStore struct {
+	tokens map[string]*user.Token
+	users  map[uuid.UUID]*user.User
+}
+
+func (f *fakeTokenStore) GetTokenByHash(_ context.Context, hash []byte) (*user.Token, error) {
+	if token, ok := f.tokens[string(hash)]; ok {
+		return token, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (f *fakeTokenStore) GetByID(_ context.Context, id uuid.UUID) (*user.User, error) {
+	if u, ok := f.users[id]; ok {
+		return u, nil
+	}
+	return nil, sql.ErrNoRows
+}
+
+func TestAuthenticate(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	expired := now.Add(-time.Minute)
+	later := now.Add(time.Hour)
+	sam := &user.User{ID: uuid.Must(uuid.NewV4()), Username: "sam"}
+	store := &fakeTokenStore{tokens: map[string]*user.Token{}, users: map[uuid.UUID]*user.User{sam.ID: sam}}
+
+	issue := func(scope user.Scope, expiresAt *time.Time, userID uuid.UUID) (string, *user.Token) {
+		secret, hash, err := NewToken()
+		require.NoError(t, err)
+		token := &user.Token{ID: uuid.Must(uuid.NewV4()), UserID: userID, Scope: scope, ExpiresAt: expiresAt}
+		store.tokens[string(hash)] = token
+		return secret, token
+	}
+	valid, validToken := issue(user.ScopeRead, &later, sam.ID)
+	personal, _ := issue(user.ScopeWrite, nil, sam.ID)
+	stale, _ := issue(user.ScopeWrite, &expired, sam.ID)
+	orphaned, _ := issue(user.ScopeWrite, nil, uuid.Must(uuid.NewV4()))
+
+	authenticator := NewAuthenticator(store)
+	authenticator.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	principal, err := authenticator.Authenticate(ctx, "Bearer "+valid)
+	require.NoError(t, err)
+	assert.Equal(t, &Principal{UserID: sam.ID, Username: "sam", TokenID: validToken.ID, Scope: user.ScopeRead}, principal)
+	assert.False(t, principal.CanWrite())
+
+	principal, err = authenticator.Authenticate(ctx, "bearer "+personal)
+	require.NoError(t, err)
+	assert.True(t, principal.CanWrite())
+
+	for _, header := range []string{
+		"",
+		valid,
+		"Basic " + valid,
+		"Bearer bst_unknown",
+		"Bearer " + stale,
+		"Bearer " + orphaned,
+	} {
+		_, err := authenticator.Authenticate(ctx, header)
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	}
+}
+
+func TestPrincipalContext(t *testing.T) {
+	assert.Nil(t, FromContext(context.Background()))
+	principal := &Principal{Username: "sam"}
+	assert.Same(t, principal, FromContext(WithPrincipal(context.Background(), principal)))
+}
+
+func TestNewToken(t *testing.T) {
+	token, hash, err := NewToken()
+	require.NoError(t, err)
+	assert.Regexp(t, `^bst_[A-Za-z0-9_-]{43}$`, token)
+	assert.Equal(t, HashToken(token), hash)
+
+	other, _, err := NewToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
+
+func TestCheckPassword(t *testing.T) {
+	hash, err := HashPassword("correct horse battery")
+	require.NoError(t, err)
+	u := &user.User{PasswordHash: hash}
+
+	assert.True(t, CheckPassword(u, "correct horse battery"))
+	assert.False(t, CheckPassword(u, "wrong horse battery"))
+	assert.False(t, CheckPassword(nil, "correct horse battery"))
+}
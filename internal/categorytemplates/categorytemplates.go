@@ -0,0 +1,103 @@
+// Package categorytemplates provides built-in category hierarchies that can
+// be created in one step.
+package categorytemplates
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/carson-networks/budget-server/internal/storage/category"
+)
+
+var ErrTemplateNotFound = errors.New("category template not found")
+
+//go:embed templates/*.json
+var templateFS embed.FS
+
+// Template is a named set of parent groups and their child categories.
+type Template struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Groups      []Group `json:"groups"`
+}
+
+// Group is a parent category with the names of its children. Children
+// inherit the group's type.
+type Group struct {
+	Name     string   `json:"name"`
+	Type     string   `json:"type"`
+	Children []string `json:"children"`
+}
+
+// CategoryType maps the group's "income"/"expense" type to a category.CategoryType.
+func (g Group) CategoryType() (category.CategoryType, error) {
+	switch g.Type {
+	case "income":
+		return category.CatergoryType_Income, nil
+	case "expense":
+		return category.CatergoryType_Expense, nil
+	default:
+		return 0, fmt.Errorf("group %q: unknown type %q", g.Name, g.Type)
+	}
+}
+
+var templates = mustLoad()
+
+// Get returns the built-in template with the given name.
+func Get(name string) (*Template, error) {
+	t, ok := templates[name]
+	if !ok {
+		return nil, ErrTemplateNotFound
+	}
+	return t, nil
+}
+
+// List returns all built-in templates ordered by name.
+func List() []*Template {
+	result := make([]*Template, 0, len(templates))
+	for _, t := range templates {
+		result = append(result, t)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+func mustLoad() map[string]*Template {
+	result, err := load()
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func load() (map[string]*Template, error) {
+	entries, err := templateFS.ReadDir("templates")
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]*Template, len(entries))
+	for _, entry := range entries {
+		data, err := templateFS.ReadFile(path.Join("templates", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var t Template
+		if err := json.Unmarshal(data, &t); err != nil {
+			return nil, fmt.Errorf("template %s: %w", entry.Name(), err)
+		}
+		for _, g := range t.Groups {
+			if _, err := g.CategoryType(); err != nil {
+				return nil, fmt.Errorf("template %s: %w", entry.Name(), err)
+			}
+		}
+		if _, dup := result[t.Name]; dup {
+			return nil, fmt.Errorf("template %s: duplicate name %q", entry.Name(), t.Name)
+		}
+		result[t.Name] = &t
+	}
+	return result, nil
+}
@@ -0,0 +1,29 @@
+package categorytemplates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuiltInTemplatesLoad(t *testing.T) {
+	list := List()
+	require.NotEmpty(t, list)
+	for _, tmpl := range list {
+		assert.NotEmpty(t, tmpl.Description)
+		require.NotEmpty(t, tmpl.Groups)
+		for _, g := range tmpl.Groups {
+			assert.NotEmpty(t, g.Children)
+		}
+	}
+}
+
+func TestGet(t *testing.T) {
+	tmpl, err := Get("household")
+	require.NoError(t, err)
+	assert.Equal(t, "household", tmpl.Name)
+
+	_, err = Get("missing")
+	assert.ErrorIs(t, err, ErrTemplateNotFound)
+}
@@ -1,52 +1,478 @@
 package config
 
 import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"net/url"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
 )
 
+// redacted replaces secret values in WriteRedacted.
+const redacted = "<redacted>"
+
+// sslModes are the sslmode values lib/pq accepts.
+var sslModes = []string{"disable", "require", "verify-ca", "verify-full"}
+
+// Config is the server's configuration. Load builds it in layers: defaults,
+// then the config file, then environment variables, then flags, each
+// overriding the one before.
 type Config struct {
+	// PostgresDSN, when set, is used as the connection string as-is and the
+	// other Postgres* connection settings are ignored.
+	PostgresDSN      string
 	PostgresAddress  string
 	PostgresPort     string
 	PostgresDB       string
 	PostgresUsername string
 	PostgresPassword string
+	PostgresSSLMode  string
+	// PostgresSSLRootCert is the CA certificate file the server's
+	// certificate is verified against under sslmode verify-ca or verify-full.
+	PostgresSSLRootCert string
+	// PostgresMaxOpenConns caps the connection pool; PostgresMaxIdleConns of
+	// them are kept open while idle.
+	PostgresMaxOpenConns int
+	PostgresMaxIdleConns int
+	// PostgresConnMaxLifetime is how long a connection is reused before it
+	// is replaced.
+	PostgresConnMaxLifetime time.Duration
+	// PostgresStatementTimeout aborts statements running longer than this;
+	// zero disables it.
+	PostgresStatementTimeout time.Duration
+	// PostgresConnectTimeout is how long startup waits for Postgres to
+	// accept connections.
+	PostgresConnectTimeout time.Duration
+
+	// CursorSigningKey signs pagination cursors. When empty a random key is
+	// generated at startup, so cursors do not survive a restart.
+	CursorSigningKey string
+
+	// BatchMaxItems caps the number of items accepted by POST /v1/batch.
+	BatchMaxItems int
+
+	// IdempotencyKeyTTL is how long the response stored for an
+	// Idempotency-Key is replayed before the key is swept.
+	IdempotencyKeyTTL time.Duration
+
+	// OperatorWorkers is the number of workers performing writes.
+	OperatorWorkers int
+	// OperatorQueueSize is the number of writes buffered across all workers
+	// before new ones are rejected.
+	OperatorQueueSize int
+	// OperatorDrainTimeout bounds how long shutdown waits for queued writes.
+	OperatorDrainTimeout time.Duration
+	// ShutdownTimeout bounds how long shutdown waits for in-flight HTTP
+	// requests before closing their connections.
+	ShutdownTimeout time.Duration
+
+	// OutboxWebhookURLs receive every domain event as JSON batches.
+	OutboxWebhookURLs []string
+	// OutboxLogFile, when set, is appended with every domain event as JSON lines.
+	OutboxLogFile string
+
+	// WebhookAllowedNetworks lists CIDRs webhooks may deliver to even though
+	// they are loopback, private or otherwise internal, such as a home LAN.
+	// Every other internal address is refused.
+	WebhookAllowedNetworks []string
+
+	// ListenAddr is the address the HTTP server binds, e.g. ":9446" or
+	// "192.168.1.10:9446".
+	ListenAddr string
+	// CORSAllowedOrigins lists the browser origins allowed to call the API;
+	// "*" allows any origin.
+	CORSAllowedOrigins []string
+	// CORSAllowCredentials lets browsers send credentials cross-origin. It
+	// requires explicit origins.
+	CORSAllowCredentials bool
+	// TLSCertFile and TLSKeyFile, when both set, serve HTTPS instead of HTTP.
+	// The pair is reloaded on SIGHUP.
+	TLSCertFile string
+	TLSKeyFile  string
+
+	HTTPReadTimeout       time.Duration
+	HTTPReadHeaderTimeout time.Duration
+	HTTPWriteTimeout      time.Duration
+	HTTPIdleTimeout       time.Duration
+
+	// LogLevel is a logrus level name such as "info" or "debug".
+	LogLevel string
+}
+
+// Default returns the configuration used when nothing overrides it, which
+// suits the docker compose setup apart from the database password.
+func Default() *Config {
+	return &Config{
+		PostgresAddress:      "localhost",
+		PostgresPort:         "5433",
+		PostgresDB:           "postgres",
+		PostgresUsername:     "postgres",
+		PostgresSSLMode:      "disable",
+		PostgresMaxOpenConns: 10,
+		PostgresMaxIdleConns: 5,
+
+		PostgresConnMaxLifetime:  30 * time.Minute,
+		PostgresStatementTimeout: 30 * time.Second,
+		PostgresConnectTimeout:   60 * time.Second,
+
+		BatchMaxItems: 500,
+
+		IdempotencyKeyTTL: 24 * time.Hour,
+
+		OperatorWorkers:      4,
+		OperatorQueueSize:    1000,
+		OperatorDrainTimeout: 30 * time.Second,
+		ShutdownTimeout:      15 * time.Second,
+
+		ListenAddr:         ":9446",
+		CORSAllowedOrigins: []string{"*"},
+
+		HTTPReadTimeout:       30 * time.Second,
+		HTTPReadHeaderTimeout: 10 * time.Second,
+		HTTPWriteTimeout:      30 * time.Second,
+		HTTPIdleTimeout:       10 * time.Second,
+
+		LogLevel: "info",
+	}
+}
+
+// setting binds a Config field to its config file key. Its environment
+// variable is the key upper-cased and its flag is the key with dashes.
+type setting struct {
+	key   string
+	usage string
+	// value points at the Config field.
+	value  any
+	secret bool
+	// allowZero accepts zero for a number or duration that must otherwise
+	// be positive.
+	allowZero bool
+}
+
+func (s setting) env() string {
+	return strings.ToUpper(s.key)
+}
+
+func (s setting) flag() string {
+	return strings.ReplaceAll(s.key, "_", "-")
+}
+
+// settings lists every field Load can set, in the order WriteRedacted
+// prints them.
+func (c *Config) settings() []setting {
+	return []setting{
+		{key: "postgres_dsn", usage: "Postgres connection string; overrides the other postgres connection settings", value: &c.PostgresDSN, secret: true},
+		{key: "postgres_address", usage: "Postgres host", value: &c.PostgresAddress},
+		{key: "postgres_port", usage: "Postgres port", value: &c.PostgresPort},
+		{key: "postgres_db", usage: "Postgres database", value: &c.PostgresDB},
+		{key: "postgres_username", usage: "Postgres user", value: &c.PostgresUsername},
+		{key: "postgres_password", usage: "Postgres password", value: &c.PostgresPassword, secret: true},
+		{key: "postgres_sslmode", usage: "Postgres sslmode: " + strings.Join(sslModes, ", "), value: &c.PostgresSSLMode},
+		{key: "postgres_sslrootcert", usage: "CA certificate file verifying the Postgres server", value: &c.PostgresSSLRootCert},
+		{key: "postgres_max_open_conns", usage: "maximum open Postgres connections", value: &c.PostgresMaxOpenConns},
+		{key: "postgres_max_idle_conns", usage: "Postgres connections kept open while idle", value: &c.PostgresMaxIdleConns, allowZero: true},
+		{key: "postgres_conn_max_lifetime", usage: "how long a Postgres connection is reused", value: &c.PostgresConnMaxLifetime},
+		{key: "postgres_statement_timeout", usage: "abort statements running longer than this; 0 disables", value: &c.PostgresStatementTimeout, allowZero: true},
+		{key: "postgres_connect_timeout", usage: "how long startup waits for Postgres to accept connections", value: &c.PostgresConnectTimeout},
+		{key: "cursor_signing_key", usage: "key signing pagination cursors; random per process when empty", value: &c.CursorSigningKey, secret: true},
+		{key: "batch_max_items", usage: "maximum items in one POST /v1/batch", value: &c.BatchMaxItems},
+		{key: "idempotency_key_ttl", usage: "how long Idempotency-Key responses are kept for replay", value: &c.IdempotencyKeyTTL},
+		{key: "operator_workers", usage: "number of write workers", value: &c.OperatorWorkers},
+		{key: "operator_queue_size", usage: "writes buffered across all workers", value: &c.OperatorQueueSize},
+		{key: "operator_drain_timeout", usage: "how long shutdown waits for queued writes", value: &c.OperatorDrainTimeout},
+		{key: "shutdown_timeout", usage: "how long shutdown waits for in-flight HTTP requests", value: &c.ShutdownTimeout},
+		{key: "outbox_webhook_urls", usage: "comma-separated URLs receiving every domain event", value: &c.OutboxWebhookURLs},
+		{key: "outbox_log_file", usage: "file appended with every domain event", value: &c.OutboxLogFile},
+		{key: "webhook_allowed_networks", usage: "comma-separated internal CIDRs webhooks may deliver to", value: &c.WebhookAllowedNetworks},
+		{key: "listen_addr", usage: "HTTP listen address", value: &c.ListenAddr},
+		{key: "cors_allowed_origins", usage: `comma-separated browser origins allowed to call the API, or "*"`, value: &c.CORSAllowedOrigins},
+		{key: "cors_allow_credentials", usage: "allow credentialed cross-origin requests", value: &c.CORSAllowCredentials},
+		{key: "tls_cert_file", usage: "TLS certificate file; serves HTTPS when set", value: &c.TLSCertFile},
+		{key: "tls_key_file", usage: "TLS key file", value: &c.TLSKeyFile},
+		{key: "http_read_timeout", usage: "HTTP read timeout", value: &c.HTTPReadTimeout},
+		{key: "http_read_header_timeout", usage: "HTTP read header timeout", value: &c.HTTPReadHeaderTimeout},
+		{key: "http_write_timeout", usage: "HTTP write timeout", value: &c.HTTPWriteTimeout},
+		{key: "http_idle_timeout", usage: "HTTP keep-alive idle timeout", value: &c.HTTPIdleTimeout},
+		{key: "log_level", usage: "log level: trace, debug, info, warn, error", value: &c.LogLevel},
+	}
 }
 
-func ProcessEnvironmentVariables() (*Config, error) {
-	// In all cases the default behavior should be for the docker compose setup
-	env := Config{
-		PostgresAddress:  "localhost",
-		PostgresPort:     "5433",
-		PostgresDB:       "postgres",
-		PostgresUsername: "postgres",
-		PostgresPassword: "testpassword",
+// Load builds the configuration from args, the command line without the
+// program name. The config file is named by --config or CONFIG_FILE.
+// printConfig reports whether --print-config was given. On -h it returns
+// flag.ErrHelp after printing usage.
+func Load(args []string) (cfg *Config, printConfig bool, err error) {
+	cfg = Default()
+	settings := cfg.settings()
+
+	fs := flag.NewFlagSet("budget-server", flag.ContinueOnError)
+	configFile := fs.String("config", os.Getenv("CONFIG_FILE"), "YAML config file (env CONFIG_FILE)")
+	fs.BoolVar(&printConfig, "print-config", false, "print the configuration with secrets redacted and exit")
+	// Flags are applied last, so only record them while parsing.
+	flagged := map[string]string{}
+	for _, s := range settings {
+		usage := fmt.Sprintf("%s (env %s, default %v)", s.usage, s.env(), s.display())
+		fs.Func(s.flag(), usage, func(raw string) error {
+			flagged[s.key] = raw
+			return nil
+		})
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, false, err
+	}
+	if fs.NArg() > 0 {
+		return nil, false, fmt.Errorf("unexpected argument %q", fs.Arg(0))
 	}
 
-	envPostgresAddress := os.Getenv("POSTGRES_ADDRESS")
-	envPostgresPort := os.Getenv("POSTGRES_PORT")
-	envPostgresDB := os.Getenv("POSTGRES_DB")
-	envPostgresUsername := os.Getenv("POSTGRES_USERNAME")
-	envPostgresPassword := os.Getenv("POSTGRES_PASSWORD")
+	if *configFile != "" {
+		if err := loadFile(*configFile, settings); err != nil {
+			return nil, false, err
+		}
+	}
 
-	if len(envPostgresAddress) != 0 {
-		env.PostgresAddress = envPostgresAddress
+	var errs []error
+	for _, s := range settings {
+		if raw := os.Getenv(s.env()); len(raw) != 0 {
+			if err := set(s.value, raw); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", s.env(), err))
+			}
+		}
+	}
+	for _, s := range settings {
+		if raw, ok := flagged[s.key]; ok {
+			if err := set(s.value, raw); err != nil {
+				errs = append(errs, fmt.Errorf("--%s: %w", s.flag(), err))
+			}
+		}
+	}
+	if len(errs) != 0 {
+		return nil, false, errors.Join(errs...)
 	}
 
-	if len(envPostgresPort) != 0 {
-		env.PostgresPort = envPostgresPort
+	if err := cfg.validate(); err != nil {
+		return nil, false, err
 	}
+	return cfg, printConfig, nil
+}
 
-	if len(envPostgresDB) != 0 {
-		env.PostgresDB = envPostgresDB
+// loadFile sets the settings named in the YAML file at path. Unknown keys
+// are errors, so a misspelt key is not silently ignored.
+func loadFile(path string, settings []setting) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+	var doc map[string]yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("parse config file %s: %w", path, err)
 	}
 
-	if len(envPostgresUsername) != 0 {
-		env.PostgresUsername = envPostgresUsername
+	keys := make([]string, 0, len(doc))
+	for key := range doc {
+		keys = append(keys, key)
 	}
+	slices.Sort(keys)
 
-	if len(envPostgresPassword) != 0 {
-		env.PostgresPassword = envPostgresPassword
+	var errs []error
+	for _, key := range keys {
+		i := slices.IndexFunc(settings, func(s setting) bool { return s.key == key })
+		if i < 0 {
+			errs = append(errs, fmt.Errorf("%s: unknown key %q", path, key))
+			continue
+		}
+		node := doc[key]
+		if err := node.Decode(settings[i].value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %s: %w", path, key, err))
+		}
 	}
+	return errors.Join(errs...)
+}
 
-	return &env, nil
+// set parses raw, from an environment variable or flag, into value.
+func set(value any, raw string) error {
+	switch v := value.(type) {
+	case *string:
+		*v = raw
+	case *int:
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return fmt.Errorf("must be an integer, got %q", raw)
+		}
+		*v = n
+	case *bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("must be a boolean, got %q", raw)
+		}
+		*v = b
+	case *time.Duration:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("must be a duration, got %q", raw)
+		}
+		*v = d
+	case *[]string:
+		*v = nil
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); len(item) != 0 {
+				*v = append(*v, item)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported setting type %T", value)
+	}
+	return nil
+}
+
+// display is the setting's value as printed by WriteRedacted.
+func (s setting) display() any {
+	switch v := s.value.(type) {
+	case *string:
+		if s.secret && *v != "" {
+			return redacted
+		}
+		return *v
+	case *int:
+		return *v
+	case *bool:
+		return *v
+	case *time.Duration:
+		return v.String()
+	case *[]string:
+		return *v
+	}
+	return nil
+}
+
+// validate checks the assembled configuration, reporting every problem at
+// once.
+func (c *Config) validate() error {
+	var errs []error
+
+	for _, s := range c.settings() {
+		switch v := s.value.(type) {
+		case *int:
+			if *v < 0 || (*v == 0 && !s.allowZero) {
+				errs = append(errs, fmt.Errorf("%s must be a positive integer, got %d", s.key, *v))
+			}
+		case *time.Duration:
+			if *v < 0 || (*v == 0 && !s.allowZero) {
+				errs = append(errs, fmt.Errorf("%s must be a positive duration, got %s", s.key, *v))
+			}
+		}
+	}
+
+	if c.PostgresMaxIdleConns > c.PostgresMaxOpenConns {
+		errs = append(errs, fmt.Errorf("postgres_max_idle_conns (%d) must not exceed postgres_max_open_conns (%d)", c.PostgresMaxIdleConns, c.PostgresMaxOpenConns))
+	}
+
+	if c.PostgresDSN == "" {
+		if !slices.Contains(sslModes, c.PostgresSSLMode) {
+			errs = append(errs, fmt.Errorf("postgres_sslmode must be one of %s, got %q", strings.Join(sslModes, ", "), c.PostgresSSLMode))
+		}
+		for _, required := range []struct{ key, value string }{
+			{"postgres_address", c.PostgresAddress},
+			{"postgres_port", c.PostgresPort},
+			{"postgres_db", c.PostgresDB},
+			{"postgres_username", c.PostgresUsername},
+		} {
+			if required.value == "" {
+				errs = append(errs, fmt.Errorf("%s is required unless postgres_dsn is set", required.key))
+			}
+		}
+	}
+
+	if c.ListenAddr == "" {
+		errs = append(errs, errors.New("listen_addr is required"))
+	}
+
+	if c.CORSAllowCredentials && slices.Contains(c.CORSAllowedOrigins, "*") {
+		errs = append(errs, errors.New(`cors_allow_credentials requires explicit cors_allowed_origins, not "*"`))
+	}
+
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		errs = append(errs, errors.New("tls_cert_file and tls_key_file must be set together"))
+	}
+
+	for _, network := range c.WebhookAllowedNetworks {
+		if _, err := netip.ParsePrefix(network); err != nil {
+			errs = append(errs, fmt.Errorf("webhook_allowed_networks: %w", err))
+		}
+	}
+
+	if _, err := logrus.ParseLevel(c.LogLevel); err != nil {
+		errs = append(errs, fmt.Errorf("log_level: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
+
+// PostgresConnString is the connection string for the configured database.
+func (c *Config) PostgresConnString() string {
+	if c.PostgresDSN != "" {
+		return c.PostgresDSN
+	}
+	query := url.Values{"sslmode": {c.PostgresSSLMode}}
+	if c.PostgresSSLRootCert != "" {
+		query.Set("sslrootcert", c.PostgresSSLRootCert)
+	}
+	conn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.PostgresUsername, c.PostgresPassword),
+		Host:     net.JoinHostPort(c.PostgresAddress, c.PostgresPort),
+		Path:     "/" + c.PostgresDB,
+		RawQuery: query.Encode(),
+	}
+	return conn.String()
+}
+
+// Level is the parsed LogLevel; Load has already validated it.
+func (c *Config) Level() logrus.Level {
+	level, err := logrus.ParseLevel(c.LogLevel)
+	if err != nil {
+		return logrus.InfoLevel
+	}
+	return level
+}
+
+// WebhookAllowedPrefixes is the parsed WebhookAllowedNetworks; Load has
+// already validated them.
+func (c *Config) WebhookAllowedPrefixes() []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(c.WebhookAllowedNetworks))
+	for _, network := range c.WebhookAllowedNetworks {
+		if prefix, err := netip.ParsePrefix(network); err == nil {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+// WriteRedacted writes the configuration as a config file, with secrets
+// replaced so the output is safe to share.
+func (c *Config) WriteRedacted(w io.Writer) error {
+	doc := &yaml.Node{Kind: yaml.MappingNode}
+	for _, s := range c.settings() {
+		value := &yaml.Node{}
+		if err := value.Encode(s.display()); err != nil {
+			return fmt.Errorf("encode %s: %w", s.key, err)
+		}
+		doc.Content = append(doc.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: s.key}, value)
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	return enc.Close()
 }
@@ -0,0 +1,86 @@
+package events
+
+import (
+	"context"
+	"sync"
+)
+
+// Broker is a sink that fans events out to in-process subscribers. A
+// subscriber that falls a full buffer behind is dropped and its channel
+// closed rather than holding back the others; it can subscribe again and
+// catch up from the outbox.
+type Broker struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	closed      bool
+}
+
+type subscriber struct {
+	events chan Event
+}
+
+func NewBroker() *Broker {
+	return &Broker{subscribers: make(map[*subscriber]struct{})}
+}
+
+func (b *Broker) Name() string {
+	return "broker"
+}
+
+// Subscribe returns a channel receiving every event published from now on
+// and a function that unsubscribes and closes it. After Close the channel is
+// returned already closed.
+func (b *Broker) Subscribe(buffer int) (<-chan Event, func()) {
+	sub := &subscriber{events: make(chan Event, buffer)}
+	b.mu.Lock()
+	if b.closed {
+		close(sub.events)
+	} else {
+		b.subscribers[sub] = struct{}{}
+	}
+	b.mu.Unlock()
+
+	return sub.events, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.remove(sub)
+	}
+}
+
+// Publish never fails: delivery to subscribers is best effort.
+func (b *Broker) Publish(_ context.Context, events []Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subscribers {
+	send:
+		for _, event := range events {
+			select {
+			case sub.events <- event:
+			default:
+				b.remove(sub)
+				break send
+			}
+		}
+	}
+	return nil
+}
+
+// Close drops every subscriber, closing their channels, so long-lived
+// streams end during shutdown; clients reconnect and catch up from the
+// outbox.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for sub := range b.subscribers {
+		b.remove(sub)
+	}
+}
+
+// remove must be called with mu held.
+func (b *Broker) remove(sub *subscriber) {
+	if _, ok := b.subscribers[sub]; ok {
+		delete(b.subscribers, sub)
+		close(sub.events)
+	}
+}
@@ -0,0 +1,64 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroker_Publish_FansOut(t *testing.T) {
+	broker := NewBroker()
+	first, unsubscribeFirst := broker.Subscribe(2)
+	defer unsubscribeFirst()
+	second, unsubscribeSecond := broker.Subscribe(2)
+	defer unsubscribeSecond()
+
+	require.NoError(t, broker.Publish(context.Background(), []Event{{ID: 1}, {ID: 2}}))
+
+	for _, ch := range []<-chan Event{first, second} {
+		assert.Equal(t, int64(1), (<-ch).ID)
+		assert.Equal(t, int64(2), (<-ch).ID)
+	}
+}
+
+func TestBroker_Publish_DropsSlowSubscriber(t *testing.T) {
+	broker := NewBroker()
+	slow, unsubscribe := broker.Subscribe(1)
+	defer unsubscribe()
+
+	require.NoError(t, broker.Publish(context.Background(), []Event{{ID: 1}, {ID: 2}}))
+
+	assert.Equal(t, int64(1), (<-slow).ID)
+	_, open := <-slow
+	assert.False(t, open)
+}
+
+func TestBroker_Unsubscribe_ClosesChannel(t *testing.T) {
+	broker := NewBroker()
+	ch, unsubscribe := broker.Subscribe(1)
+
+	unsubscribe()
+	unsubscribe()
+
+	_, open := <-ch
+	assert.False(t, open)
+	require.NoError(t, broker.Publish(context.Background(), []Event{{ID: 1}}))
+}
+
+func TestBroker_Close_EndsSubscriptions(t *testing.T) {
+	broker := NewBroker()
+	before, unsubscribe := broker.Subscribe(1)
+	defer unsubscribe()
+
+	broker.Close()
+	after, unsubscribeAfter := broker.Subscribe(1)
+	defer unsubscribeAfter()
+
+	_, open := <-before
+	assert.False(t, open)
+	_, open = <-after
+	assert.False(t, open)
+	require.NoError(t, broker.Publish(context.Background(), []Event{{ID: 1}}))
+}
@@ -0,0 +1,125 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/carson-networks/budget-server/internal/storage/outbox"
+)
+
+const (
+	defaultPollInterval = 500 * time.Millisecond
+	defaultBatchSize    = 100
+)
+
+// Sink receives events from the dispatcher.
+type Sink interface {
+	// Name identifies the sink's cursor. Renaming a sink replays the outbox
+	// to it from the start.
+	Name() string
+	// Publish delivers events in order. On error the dispatcher sends the
+	// same events again later, so sinks may see an event more than once.
+	Publish(ctx context.Context, events []Event) error
+}
+
+// Store is the outbox access used by the dispatcher.
+type Store interface {
+	Horizons
+	ListAfter(ctx context.Context, after int64, through int64, limit int) ([]*outbox.Event, error)
+	GetCursor(ctx context.Context, sink string) (int64, error)
+	SaveCursor(ctx context.Context, sink string, position int64) error
+}
+
+// Dispatcher publishes outbox events to its sinks with at-least-once
+// delivery. Each sink has its own cursor, stored in the database, and is
+// served by its own goroutine, so a failing sink only holds back itself.
+type Dispatcher struct {
+	store Store
+	sinks []Sink
+
+	pollInterval time.Duration
+	batchSize    int
+}
+
+func NewDispatcher(store Store, sinks ...Sink) *Dispatcher {
+	return &Dispatcher{
+		store:        store,
+		sinks:        sinks,
+		pollInterval: defaultPollInterval,
+		batchSize:    defaultBatchSize,
+	}
+}
+
+// Run dispatches events until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, sink := range d.sinks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.runSink(ctx, sink)
+		}()
+	}
+	wg.Wait()
+}
+
+func (d *Dispatcher) runSink(ctx context.Context, sink Sink) {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := d.drain(ctx, sink); err != nil && ctx.Err() == nil {
+			logrus.WithError(err).WithField("sink", sink.Name()).Warn("Dispatcher.drain")
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// drain sends batches to sink until it has caught up with the settled part
+// of the outbox, so a cursor never passes an event yet to commit.
+func (d *Dispatcher) drain(ctx context.Context, sink Sink) error {
+	through, err := settledThrough(ctx, d.store)
+	if err != nil {
+		return err
+	}
+	for {
+		sent, err := d.dispatch(ctx, sink, through)
+		if err != nil {
+			return err
+		}
+		if sent < d.batchSize {
+			return nil
+		}
+	}
+}
+
+// dispatch sends the next batch of events up to through to sink and
+// advances its cursor, returning the number of events sent.
+func (d *Dispatcher) dispatch(ctx context.Context, sink Sink, through int64) (int, error) {
+	cursor, err := d.store.GetCursor(ctx, sink.Name())
+	if err != nil {
+		return 0, err
+	}
+	rows, err := d.store.ListAfter(ctx, cursor, through, d.batchSize)
+	if err != nil || len(rows) == 0 {
+		return 0, err
+	}
+
+	batch := make([]Event, len(rows))
+	for i, row := range rows {
+		batch[i] = fromOutbox(row)
+	}
+	if err := sink.Publish(ctx, batch); err != nil {
+		return 0, err
+	}
+	if err := d.store.SaveCursor(ctx, sink.Name(), batch[len(batch)-1].ID); err != nil {
+		return 0, err
+	}
+	return len(batch), nil
+}
@@ -0,0 +1,199 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage/outbox"
+)
+
+// memoryStore is an in-memory Store. Horizon returns the queued horizons in
+// turn, then one with no transactions running.
+type memoryStore struct {
+	mu       sync.Mutex
+	events   []*outbox.Event
+	cursors  map[string]int64
+	horizons []outbox.Horizon
+}
+
+// memoryLedger is the ledger of every event in a memoryStore.
+var memoryLedger = uuid.Must(uuid.NewV4())
+
+func newMemoryStore(n int) *memoryStore {
+	store := &memoryStore{cursors: make(map[string]int64)}
+	for i := 1; i <= n; i++ {
+		store.events = append(store.events, &outbox.Event{
+			ID:          int64(i),
+			Type:        string(CategoryDeleted),
+			AggregateID: uuid.Must(uuid.NewV4()),
+			LedgerID:    &memoryLedger,
+			Payload:     []byte(`{}`),
+		})
+	}
+	return store
+}
+
+func (s *memoryStore) ListAfter(_ context.Context, after int64, through int64, limit int) ([]*outbox.Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []*outbox.Event
+	for _, event := range s.events {
+		if event.ID > after && event.ID <= through && len(result) < limit {
+			result = append(result, event)
+		}
+	}
+	return result, nil
+}
+
+func (s *memoryStore) ListAfterInLedger(ctx context.Context, ledgerID uuid.UUID, after int64, through int64, limit int) ([]*outbox.Event, error) {
+	events, err := s.ListAfter(ctx, after, through, len(s.events))
+	var result []*outbox.Event
+	for _, event := range events {
+		if *event.LedgerID == ledgerID && len(result) < limit {
+			result = append(result, event)
+		}
+	}
+	return result, err
+}
+
+func (s *memoryStore) Horizon(context.Context) (outbox.Horizon, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.horizons) > 0 {
+		horizon := s.horizons[0]
+		s.horizons = s.horizons[1:]
+		return horizon, nil
+	}
+	var maxID int64
+	if len(s.events) > 0 {
+		maxID = s.events[len(s.events)-1].ID
+	}
+	return outbox.Horizon{MaxID: maxID, XMin: 100, XMax: 100}, nil
+}
+
+func (s *memoryStore) GetCursor(_ context.Context, sink string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cursors[sink], nil
+}
+
+func (s *memoryStore) SaveCursor(_ context.Context, sink string, position int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cursors[sink] = position
+	return nil
+}
+
+// recordingSink records the IDs it is sent and fails while err is set.
+type recordingSink struct {
+	name string
+	mu   sync.Mutex
+	ids  []int64
+	err  error
+}
+
+func (s *recordingSink) Name() string {
+	return s.name
+}
+
+func (s *recordingSink) Publish(_ context.Context, events []Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	for _, event := range events {
+		s.ids = append(s.ids, event.ID)
+	}
+	return nil
+}
+
+func (s *recordingSink) received() []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]int64(nil), s.ids...)
+}
+
+func TestDispatcher_Drain_SendsAllEventsInBatches(t *testing.T) {
+	store := newMemoryStore(5)
+	sink := &recordingSink{name: "test"}
+	d := NewDispatcher(store, sink)
+	d.batchSize = 2
+
+	require.NoError(t, d.drain(context.Background(), sink))
+
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, sink.received())
+	assert.Equal(t, int64(5), store.cursors["test"])
+}
+
+func TestDispatcher_Drain_StopsAtSettledHorizon(t *testing.T) {
+	store := newMemoryStore(5)
+	// Events 4 and 5 became visible while a transaction that started before
+	// the first look could still commit an event below them.
+	store.horizons = []outbox.Horizon{
+		{MaxID: 3, XMin: 10, XMax: 12},
+		{MaxID: 5, XMin: 11, XMax: 13},
+		{MaxID: 5, XMin: 12, XMax: 14},
+	}
+	sink := &recordingSink{name: "test"}
+
+	require.NoError(t, NewDispatcher(store, sink).drain(context.Background(), sink))
+
+	assert.Equal(t, []int64{1, 2, 3}, sink.received())
+	assert.Equal(t, int64(3), store.cursors["test"])
+	assert.Empty(t, store.horizons)
+}
+
+func TestDispatcher_Drain_ResumesFromCursor(t *testing.T) {
+	store := newMemoryStore(3)
+	store.cursors["test"] = 2
+	sink := &recordingSink{name: "test"}
+
+	require.NoError(t, NewDispatcher(store, sink).drain(context.Background(), sink))
+
+	assert.Equal(t, []int64{3}, sink.received())
+}
+
+func TestDispatcher_Drain_FailedPublishKeepsCursor(t *testing.T) {
+	store := newMemoryStore(3)
+	sink := &recordingSink{name: "test", err: errors.New("sink down")}
+	d := NewDispatcher(store, sink)
+
+	assert.ErrorIs(t, d.drain(context.Background(), sink), sink.err)
+	assert.Zero(t, store.cursors["test"])
+
+	sink.err = nil
+	require.NoError(t, d.drain(context.Background(), sink))
+	assert.Equal(t, []int64{1, 2, 3}, sink.received())
+}
+
+func TestDispatcher_Run_SinksHaveIndependentCursors(t *testing.T) {
+	store := newMemoryStore(3)
+	healthy := &recordingSink{name: "healthy"}
+	failing := &recordingSink{name: "failing", err: errors.New("sink down")}
+	d := NewDispatcher(store, healthy, failing)
+	d.pollInterval = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		d.Run(ctx)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return len(healthy.received()) == 3 }, time.Second, time.Millisecond)
+	cancel()
+	<-done
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	assert.Equal(t, int64(3), store.cursors["healthy"])
+	assert.Zero(t, store.cursors["failing"])
+}
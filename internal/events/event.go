@@ -0,0 +1,189 @@
+package events
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+
+	"github.com/carson-networks/budget-server/internal/storage/account"
+	"github.com/carson-networks/budget-server/internal/storage/category"
+	"github.com/carson-networks/budget-server/internal/storage/outbox"
+	"github.com/carson-networks/budget-server/internal/storage/transaction"
+)
+
+// Type names a kind of domain event.
+type Type string
+
+const (
+	AccountCreated        Type = "AccountCreated"
+	AccountBalanceChanged Type = "AccountBalanceChanged"
+	TransactionCreated    Type = "TransactionCreated"
+	TransactionDeleted    Type = "TransactionDeleted"
+	CategoryCreated       Type = "CategoryCreated"
+	CategoryUpdated       Type = "CategoryUpdated"
+	CategoryDeleted       Type = "CategoryDeleted"
+	CategoriesMerged      Type = "CategoriesMerged"
+)
+
+// Types lists every event type actions record.
+var Types = []Type{
+	AccountCreated,
+	AccountBalanceChanged,
+	TransactionCreated,
+	TransactionDeleted,
+	CategoryCreated,
+	CategoryUpdated,
+	CategoryDeleted,
+	CategoriesMerged,
+}
+
+// Event is a domain event as delivered to sinks. ID increases in the order
+// events were recorded and is the position sinks are tracked by.
+type Event struct {
+	ID          int64  `json:"id"`
+	Type        Type   `json:"type"`
+	AggregateID string `json:"aggregateID"`
+	// LedgerID is the ledger the event happened in; empty for events
+	// outside any ledger.
+	LedgerID  string          `json:"ledgerID,omitempty"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+// New builds the outbox record for an event about aggregateID.
+func New(eventType Type, aggregateID uuid.UUID, payload any) (*outbox.EventCreate, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &outbox.EventCreate{
+		Type:        string(eventType),
+		AggregateID: aggregateID,
+		Payload:     data,
+	}, nil
+}
+
+func fromOutbox(event *outbox.Event) Event {
+	e := Event{
+		ID:          event.ID,
+		Type:        Type(event.Type),
+		AggregateID: event.AggregateID.String(),
+		Payload:     event.Payload,
+		CreatedAt:   event.CreatedAt,
+	}
+	if event.LedgerID != nil {
+		e.LedgerID = event.LedgerID.String()
+	}
+	return e
+}
+
+// Account is the payload of AccountCreated.
+type Account struct {
+	ID              string    `json:"id"`
+	Name            string    `json:"name"`
+	Type            int       `json:"type"`
+	SubType         string    `json:"subType"`
+	Balance         string    `json:"balance"`
+	StartingBalance string    `json:"startingBalance"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+func NewAccount(a *account.Account) Account {
+	return Account{
+		ID:              a.ID.String(),
+		Name:            a.Name,
+		Type:            int(a.Type),
+		SubType:         a.SubType,
+		Balance:         a.Balance.String(),
+		StartingBalance: a.StartingBalance.String(),
+		CreatedAt:       a.CreatedAt,
+	}
+}
+
+// BalanceChange is the payload of AccountBalanceChanged.
+type BalanceChange struct {
+	AccountID       string `json:"accountID"`
+	TransactionID   string `json:"transactionID"`
+	PreviousBalance string `json:"previousBalance"`
+	Balance         string `json:"balance"`
+}
+
+func NewBalanceChange(accountID uuid.UUID, transactionID uuid.UUID, previous decimal.Decimal, balance decimal.Decimal) BalanceChange {
+	return BalanceChange{
+		AccountID:       accountID.String(),
+		TransactionID:   transactionID.String(),
+		PreviousBalance: previous.String(),
+		Balance:         balance.String(),
+	}
+}
+
+// Transaction is the payload of TransactionCreated and TransactionDeleted.
+type Transaction struct {
+	ID              string    `json:"id"`
+	AccountID       string    `json:"accountID"`
+	CategoryID      string    `json:"categoryID"`
+	Amount          string    `json:"amount"`
+	TransactionName string    `json:"transactionName"`
+	TransactionDate time.Time `json:"transactionDate"`
+	IsRefund        bool      `json:"isRefund"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+func NewTransaction(t *transaction.Transaction) Transaction {
+	return Transaction{
+		ID:              t.ID.String(),
+		AccountID:       t.AccountID.String(),
+		CategoryID:      t.CategoryID.String(),
+		Amount:          t.Amount.String(),
+		TransactionName: t.TransactionName,
+		TransactionDate: t.TransactionDate,
+		IsRefund:        t.IsRefund,
+		CreatedAt:       t.CreatedAt,
+	}
+}
+
+// Category is the payload of CategoryCreated and CategoryUpdated.
+type Category struct {
+	ID               string    `json:"id"`
+	Name             string    `json:"name"`
+	IsParent         bool      `json:"isParent"`
+	ParentCategoryID *string   `json:"parentCategoryID,omitempty"`
+	IsDisabled       bool      `json:"isDisabled"`
+	CategoryType     int       `json:"categoryType"`
+	MonthlyBudget    *string   `json:"monthlyBudget,omitempty"`
+	CreatedAt        time.Time `json:"createdAt"`
+}
+
+func NewCategory(c *category.Category) Category {
+	payload := Category{
+		ID:           c.ID.String(),
+		Name:         c.Name,
+		IsParent:     c.IsParent,
+		IsDisabled:   c.IsDisabled,
+		CategoryType: int(c.CategoryType),
+		CreatedAt:    c.CreatedAt,
+	}
+	if c.ParentCategoryID != nil {
+		parentID := c.ParentCategoryID.String()
+		payload.ParentCategoryID = &parentID
+	}
+	if c.MonthlyBudget != nil {
+		budget := c.MonthlyBudget.String()
+		payload.MonthlyBudget = &budget
+	}
+	return payload
+}
+
+// CategoryRef is the payload of CategoryDeleted.
+type CategoryRef struct {
+	ID string `json:"id"`
+}
+
+// Merge is the payload of CategoriesMerged.
+type Merge struct {
+	SourceID          string `json:"sourceID"`
+	TargetID          string `json:"targetID"`
+	TransactionsMoved int64  `json:"transactionsMoved"`
+}
@@ -0,0 +1,28 @@
+package events
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogSink_Publish_AppendsJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.log")
+	sink, err := NewLogSink(path)
+	require.NoError(t, err)
+	defer sink.Close()
+
+	require.NoError(t, sink.Publish(context.Background(), []Event{{ID: 1, Type: CategoryDeleted, Payload: []byte(`{}`)}}))
+	require.NoError(t, sink.Publish(context.Background(), []Event{{ID: 2, Type: CategoryDeleted, Payload: []byte(`{}`)}}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t,
+		`{"id":1,"type":"CategoryDeleted","aggregateID":"","payload":{},"createdAt":"0001-01-01T00:00:00Z"}`+"\n"+
+			`{"id":2,"type":"CategoryDeleted","aggregateID":"","payload":{},"createdAt":"0001-01-01T00:00:00Z"}`+"\n",
+		string(data))
+}
@@ -0,0 +1,77 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/storage/outbox"
+)
+
+// settleInterval is how often settledThrough looks again while transactions
+// that could still record events are running.
+const settleInterval = 10 * time.Millisecond
+
+// Horizons reports what the outbox looks like now; see settledThrough.
+type Horizons interface {
+	Horizon(ctx context.Context) (outbox.Horizon, error)
+}
+
+// History is read access to a ledger's events already recorded in the
+// outbox.
+type History interface {
+	Horizons
+	ListAfterInLedger(ctx context.Context, ledgerID uuid.UUID, after int64, through int64, limit int) ([]*outbox.Event, error)
+}
+
+// settledThrough returns an event ID at or below which the outbox will never
+// gain another event. Transactions commit out of ID order, so an event just
+// below the highest visible ID can still appear until every transaction
+// running now has ended; settledThrough waits for those, which only takes
+// as long as the writes in flight.
+func settledThrough(ctx context.Context, horizons Horizons) (int64, error) {
+	start, err := horizons.Horizon(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for now := start; !start.SettledBy(now); {
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(settleInterval):
+		}
+		if now, err = horizons.Horizon(ctx); err != nil {
+			return 0, err
+		}
+	}
+	return start.MaxID, nil
+}
+
+// Replay calls fn, oldest first, for every event in ledgerID after the
+// given ID that the dispatcher could already have published, and returns
+// the ID it replayed through (or after when that is later). Newer events are
+// left for the dispatcher, so a Broker subscriber that calls Replay once
+// subscribed sees each event once by skipping broker events up to the
+// returned ID.
+func Replay(ctx context.Context, history History, ledgerID uuid.UUID, after int64, fn func(Event) error) (int64, error) {
+	through, err := settledThrough(ctx, history)
+	if err != nil {
+		return after, err
+	}
+	for {
+		rows, err := history.ListAfterInLedger(ctx, ledgerID, after, through, defaultBatchSize)
+		if err != nil {
+			return after, err
+		}
+		for _, row := range rows {
+			if err := fn(fromOutbox(row)); err != nil {
+				return after, err
+			}
+			after = row.ID
+		}
+		if len(rows) < defaultBatchSize {
+			return max(after, through), nil
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage/outbox"
+)
+
+func TestReplay_PagesFromPosition(t *testing.T) {
+	store := newMemoryStore(defaultBatchSize + 5)
+	var ids []int64
+
+	last, err := Replay(context.Background(), store, memoryLedger, 3, func(event Event) error {
+		ids = append(ids, event.ID)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(defaultBatchSize+5), last)
+	assert.Len(t, ids, defaultBatchSize+2)
+	assert.Equal(t, int64(4), ids[0])
+}
+
+func TestReplay_StopsAtSettledHorizon(t *testing.T) {
+	store := newMemoryStore(5)
+	store.horizons = []outbox.Horizon{{MaxID: 3, XMin: 10, XMax: 12}, {MaxID: 5, XMin: 12, XMax: 14}}
+	var ids []int64
+
+	last, err := Replay(context.Background(), store, memoryLedger, 0, func(event Event) error {
+		ids = append(ids, event.ID)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), last)
+	assert.Equal(t, []int64{1, 2, 3}, ids)
+}
+
+func TestReplay_CanceledWhileSettling(t *testing.T) {
+	store := newMemoryStore(1)
+	store.horizons = []outbox.Horizon{{MaxID: 1, XMin: 10, XMax: 12}}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	last, err := Replay(ctx, store, memoryLedger, 0, func(Event) error {
+		t.Fatal("unexpected event")
+		return nil
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Zero(t, last)
+}
+
+func TestReplay_OnlyTheLedgersEvents(t *testing.T) {
+	store := newMemoryStore(4)
+	otherLedger := uuid.Must(uuid.NewV4())
+	store.events[1].LedgerID = &otherLedger
+	store.events[3].LedgerID = &otherLedger
+	var ids []int64
+
+	last, err := Replay(context.Background(), store, memoryLedger, 0, func(event Event) error {
+		ids = append(ids, event.ID)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 3}, ids)
+	assert.Equal(t, int64(4), last)
+}
+
+func TestReplay_NothingNew(t *testing.T) {
+	last, err := Replay(context.Background(), newMemoryStore(2), memoryLedger, 2, func(Event) error {
+		t.Fatal("unexpected event")
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), last)
+}
+
+func TestReplay_StopsOnCallbackError(t *testing.T) {
+	writeErr := errors.New("client went away")
+
+	last, err := Replay(context.Background(), newMemoryStore(3), memoryLedger, 0, func(event Event) error {
+		if event.ID == 2 {
+			return writeErr
+		}
+		return nil
+	})
+
+	assert.ErrorIs(t, err, writeErr)
+	assert.Equal(t, int64(1), last)
+}
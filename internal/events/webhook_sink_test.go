@@ -0,0 +1,41 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSink_Publish_PostsBatch(t *testing.T) {
+	var received []Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL)
+	err := sink.Publish(context.Background(), []Event{{ID: 7, Type: CategoryDeleted, Payload: []byte(`{}`)}})
+
+	require.NoError(t, err)
+	require.Len(t, received, 1)
+	assert.Equal(t, int64(7), received[0].ID)
+	assert.Equal(t, "webhook:"+server.URL, sink.Name())
+}
+
+func TestWebhookSink_Publish_NonSuccessStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	err := NewWebhookSink(server.URL).Publish(context.Background(), []Event{{ID: 1, Payload: []byte(`{}`)}})
+	assert.ErrorContains(t, err, "502")
+}
@@ -0,0 +1,139 @@
+// Package idempotent lets write handlers honor the Idempotency-Key header.
+package idempotent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage/idempotency"
+)
+
+// Header is embedded in the input of every write endpoint.
+type Header struct {
+	IdempotencyKey string `header:"Idempotency-Key" maxLength:"255" doc:"Client-chosen unique key; retrying with the same key and body returns the original response without repeating the write"`
+}
+
+// Process runs action through op and returns the output respond builds from
+// the action's result.
+//
+// Without a key this is a plain op.Process. With a key the action is wrapped
+// in actions.Idempotent so the key and response are stored in the action's
+// transaction, under the caller's ledger or user so keys from different
+// tenants never collide: a retry with the same scope and body returns the stored
+// output, and reuse of the key for a different request is a 409. A full or
+// stopped operator is a 503 and an action the caller's ledger role doesn't
+// permit is a 403. Errors from the action itself are passed to mapErr.
+func Process[O any, E error](
+	ctx context.Context,
+	op operator.IProcessor,
+	header Header,
+	scope string,
+	body any,
+	action actions.IAction,
+	respond func(result any) *O,
+	mapErr func(error) E,
+) (*O, error) {
+	if header.IdempotencyKey == "" {
+		result, err := op.Process(ctx, action)
+		if err != nil {
+			if opErr := operatorError(err); opErr != nil {
+				return nil, opErr
+			}
+			return nil, mapErr(err)
+		}
+		return respond(result), nil
+	}
+
+	requestHash, err := hashRequest(ctx, scope, body)
+	if err != nil {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to hash request", err)
+	}
+
+	var out *O
+	wrapped := &actions.Idempotent{
+		Scope:       keyScope(ctx),
+		Key:         header.IdempotencyKey,
+		RequestHash: requestHash,
+		Action:      action,
+		Response: func(result any) (json.RawMessage, error) {
+			out = respond(result)
+			return json.Marshal(out)
+		},
+	}
+
+	_, err = op.Process(ctx, wrapped)
+	if errors.Is(err, idempotency.ErrKeyExists) {
+		// A concurrent request with the same key committed first; retrying
+		// replays its response or reports the mismatch.
+		_, err = op.Process(ctx, wrapped)
+	}
+	if err != nil {
+		if errors.Is(err, actions.ErrIdempotencyKeyReused) {
+			return nil, huma.NewError(http.StatusConflict, "Idempotency-Key was already used with a different request", err)
+		}
+		if opErr := operatorError(err); opErr != nil {
+			return nil, opErr
+		}
+		return nil, mapErr(err)
+	}
+
+	if wrapped.Replay != nil {
+		replayed := new(O)
+		if err := json.Unmarshal(wrapped.Replay, replayed); err != nil {
+			return nil, huma.NewError(http.StatusInternalServerError, "failed to decode stored response", err)
+		}
+		return replayed, nil
+	}
+	return out, nil
+}
+
+// operatorError maps operator overload and shutdown to a 503 and a denied
+// permission to a 403, or returns nil for any other error.
+func operatorError(err error) huma.StatusError {
+	if errors.Is(err, operator.ErrQueueFull) || errors.Is(err, operator.ErrStopped) {
+		return huma.NewError(http.StatusServiceUnavailable, "server is busy, retry later", err)
+	}
+	if errors.Is(err, operator.ErrPermissionDenied) {
+		return huma.NewError(http.StatusForbidden, "your ledger role does not permit this action", err)
+	}
+	return nil
+}
+
+// keyScope names the namespace a request's Idempotency-Key lives in: the
+// ledger it acts on, else the authenticated user. Unauthenticated requests,
+// such as sign-up, share the empty scope.
+func keyScope(ctx context.Context) string {
+	if ledgerID, err := requestctx.LedgerID(ctx); err == nil {
+		return "ledger:" + ledgerID.String()
+	}
+	if principal := auth.FromContext(ctx); principal != nil {
+		return "user:" + principal.UserID.String()
+	}
+	return ""
+}
+
+// hashRequest covers the request's ledger too, so a key reused in another
+// ledger is rejected rather than replaying that ledger's response.
+func hashRequest(ctx context.Context, scope string, body any) ([]byte, error) {
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	if ledgerID, err := requestctx.LedgerID(ctx); err == nil {
+		h.Write(ledgerID.Bytes())
+	}
+	h.Write([]byte(scope))
+	h.Write([]byte{0})
+	h.Write(encoded)
+	return h.Sum(nil), nil
+}
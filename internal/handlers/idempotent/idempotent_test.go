@@ -0,0 +1,191 @@
+package idempotent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage/idempotency"
+)
+
+type testOutput struct {
+	Status int `json:"status"`
+}
+
+type testBody struct {
+	Name string `json:"name"`
+}
+
+func respond(any) *testOutput {
+	return &testOutput{Status: http.StatusCreated}
+}
+
+func mapErr(err error) huma.StatusError {
+	return huma.NewError(http.StatusBadRequest, "mapped", err)
+}
+
+func TestProcess_NoKey(t *testing.T) {
+	action := &actions.MockIAction{}
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().Process(mock.Anything, action).Return(nil, nil)
+
+	out, err := Process(context.Background(), mockOp, Header{}, "scope", testBody{Name: "a"}, action, respond, mapErr)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, out.Status)
+	mockOp.AssertExpectations(t)
+}
+
+func TestProcess_NoKeyMapsError(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, errors.New("boom"))
+
+	_, err := Process(context.Background(), mockOp, Header{}, "scope", testBody{}, &actions.MockIAction{}, respond, mapErr)
+
+	var statusErr huma.StatusError
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusBadRequest, statusErr.GetStatus())
+}
+
+func TestProcess_QueueFullIsUnavailable(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, operator.ErrQueueFull)
+
+	_, err := Process(context.Background(), mockOp, Header{}, "scope", testBody{}, &actions.MockIAction{}, respond, mapErr)
+
+	var statusErr huma.StatusError
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusServiceUnavailable, statusErr.GetStatus())
+}
+
+func TestProcess_PermissionDeniedIsForbidden(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, operator.ErrPermissionDenied)
+
+	_, err := Process(context.Background(), mockOp, Header{IdempotencyKey: "key-1"}, "scope", testBody{}, &actions.MockIAction{}, respond, mapErr)
+
+	var statusErr huma.StatusError
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusForbidden, statusErr.GetStatus())
+}
+
+func TestProcess_FirstUseStoresResponse(t *testing.T) {
+	action := &actions.MockIAction{}
+	var stored json.RawMessage
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			wrapped, ok := a.(*actions.Idempotent)
+			return ok && wrapped.Key == "key-1" && wrapped.Action == action && len(wrapped.RequestHash) > 0
+		})).
+		Run(func(_ context.Context, a actions.IAction) {
+			var err error
+			stored, err = a.(*actions.Idempotent).Response(nil)
+			require.NoError(t, err)
+		}).
+		Return(nil, nil)
+
+	out, err := Process(context.Background(), mockOp, Header{IdempotencyKey: "key-1"}, "scope", testBody{Name: "a"}, action, respond, mapErr)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, out.Status)
+	assert.JSONEq(t, `{"status":201}`, string(stored))
+}
+
+func TestProcess_Replay(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, a actions.IAction) {
+			a.(*actions.Idempotent).Replay = json.RawMessage(`{"status":200}`)
+		}).
+		Return(nil, nil)
+
+	out, err := Process(context.Background(), mockOp, Header{IdempotencyKey: "key-1"}, "scope", testBody{}, &actions.MockIAction{}, respond, mapErr)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, out.Status)
+}
+
+func TestProcess_KeyReused(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, actions.ErrIdempotencyKeyReused)
+
+	_, err := Process(context.Background(), mockOp, Header{IdempotencyKey: "key-1"}, "scope", testBody{}, &actions.MockIAction{}, respond, mapErr)
+
+	var statusErr huma.StatusError
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusConflict, statusErr.GetStatus())
+}
+
+func TestProcess_ConcurrentFirstUseRetries(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, idempotency.ErrKeyExists).Once()
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, a actions.IAction) {
+			a.(*actions.Idempotent).Replay = json.RawMessage(`{"status":201}`)
+		}).
+		Return(nil, nil).Once()
+
+	out, err := Process(context.Background(), mockOp, Header{IdempotencyKey: "key-1"}, "scope", testBody{}, &actions.MockIAction{}, respond, mapErr)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, out.Status)
+	mockOp.AssertExpectations(t)
+}
+
+func TestKeyScope(t *testing.T) {
+	ledgerID := uuid.Must(uuid.NewV4())
+	userID := uuid.Must(uuid.NewV4())
+	userCtx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: userID})
+	ledgerCtx := requestctx.WithLedgerID(userCtx, ledgerID)
+
+	assert.Equal(t, "ledger:"+ledgerID.String(), keyScope(ledgerCtx))
+	assert.Equal(t, "user:"+userID.String(), keyScope(userCtx))
+	assert.Equal(t, "", keyScope(context.Background()))
+}
+
+func TestProcess_KeyIsScopedToLedger(t *testing.T) {
+	ledgerID := uuid.Must(uuid.NewV4())
+	ctx := requestctx.WithLedgerID(context.Background(), ledgerID)
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			wrapped, ok := a.(*actions.Idempotent)
+			return ok && wrapped.Scope == "ledger:"+ledgerID.String()
+		})).
+		Return(nil, nil)
+
+	_, err := Process(ctx, mockOp, Header{IdempotencyKey: "key-1"}, "scope", testBody{}, &actions.MockIAction{}, respond, mapErr)
+	require.NoError(t, err)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHashRequest(t *testing.T) {
+	ctx := requestctx.WithLedgerID(context.Background(), uuid.Must(uuid.NewV4()))
+	a, err := hashRequest(ctx, "scope", testBody{Name: "a"})
+	require.NoError(t, err)
+	same, err := hashRequest(ctx, "scope", testBody{Name: "a"})
+	require.NoError(t, err)
+	otherBody, err := hashRequest(ctx, "scope", testBody{Name: "b"})
+	require.NoError(t, err)
+	otherScope, err := hashRequest(ctx, "other", testBody{Name: "a"})
+	require.NoError(t, err)
+	otherCtx := requestctx.WithLedgerID(context.Background(), uuid.Must(uuid.NewV4()))
+	otherLedger, err := hashRequest(otherCtx, "scope", testBody{Name: "a"})
+	require.NoError(t, err)
+
+	assert.Equal(t, a, same)
+	assert.NotEqual(t, a, otherBody)
+	assert.NotEqual(t, a, otherScope)
+	assert.NotEqual(t, a, otherLedger)
+}
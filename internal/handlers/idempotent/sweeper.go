@@ -0,0 +1,62 @@
+package idempotent
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const defaultSweepInterval = 10 * time.Minute
+
+// Store is the idempotency storage used by the sweeper.
+type Store interface {
+	DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// Sweeper deletes stored responses once they are older than ttl. A retry
+// after that runs the write again, so ttl should outlast any client's retry
+// window.
+type Sweeper struct {
+	store Store
+	ttl   time.Duration
+
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewSweeper(store Store, ttl time.Duration) *Sweeper {
+	return &Sweeper{
+		store:    store,
+		ttl:      ttl,
+		interval: defaultSweepInterval,
+		now:      time.Now,
+	}
+}
+
+// Run sweeps expired keys until ctx is done.
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.sweep(ctx); err != nil && ctx.Err() == nil {
+			logrus.WithError(err).Warn("Sweeper.sweep")
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Sweeper) sweep(ctx context.Context) error {
+	deleted, err := s.store.DeleteBefore(ctx, s.now().Add(-s.ttl))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		logrus.WithField("deleted", deleted).Debug("expired idempotency keys")
+	}
+	return nil
+}
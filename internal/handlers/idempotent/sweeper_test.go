@@ -0,0 +1,48 @@
+package idempotent
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingStore struct {
+	cutoffs []time.Time
+	err     error
+}
+
+func (s *recordingStore) DeleteBefore(_ context.Context, cutoff time.Time) (int64, error) {
+	s.cutoffs = append(s.cutoffs, cutoff)
+	return 1, s.err
+}
+
+func TestSweeper_DeletesOlderThanTTL(t *testing.T) {
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	store := &recordingStore{}
+	sweeper := NewSweeper(store, 24*time.Hour)
+	sweeper.now = func() time.Time { return now }
+
+	require.NoError(t, sweeper.sweep(context.Background()))
+	assert.Equal(t, []time.Time{now.Add(-24 * time.Hour)}, store.cutoffs)
+}
+
+func TestSweeper_ReturnsStoreError(t *testing.T) {
+	store := &recordingStore{err: errors.New("boom")}
+	sweeper := NewSweeper(store, time.Hour)
+
+	assert.EqualError(t, sweeper.sweep(context.Background()), "boom")
+}
+
+func TestSweeper_RunStopsWithContext(t *testing.T) {
+	store := &recordingStore{}
+	sweeper := NewSweeper(store, time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	sweeper.Run(ctx)
+	assert.Len(t, store.cutoffs, 1)
+}
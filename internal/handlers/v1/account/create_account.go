@@ -6,6 +6,7 @@ import (
 
 	"github.com/danielgtaylor/huma/v2"
 
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
 	"github.com/carson-networks/budget-server/internal/operator"
 	"github.com/carson-networks/budget-server/internal/operator/actions"
 	"github.com/carson-networks/budget-server/internal/storage/account"
@@ -22,12 +23,15 @@ type CreateAccountBody struct {
 
 // CreateAccountInput is the Huma input for creating an account.
 type CreateAccountInput struct {
+	idempotent.Header
 	Body CreateAccountBody
 }
 
 // CreateAccountOutput is the Huma output for creating an account.
 type CreateAccountOutput struct {
-	Status int `json:"status" doc:"HTTP status"`
+	Status   int    `json:"status" doc:"HTTP status"`
+	Location string `header:"Location" doc:"URL of the created account"`
+	Body     Account
 }
 
 // CreateAccountHandler handles POST /v1/accounts.
@@ -47,27 +51,50 @@ func (h *CreateAccountHandler) Register(api huma.API) {
 		Method:      http.MethodPost,
 		Path:        "/v1/accounts",
 		Summary:     "Create account",
-		Description: "Creates a new account.",
+		Description: "Creates a new account and returns it.",
 		Tags:        []string{"Accounts"},
 	}, h.handle)
 }
 
 func (h *CreateAccountHandler) handle(ctx context.Context, input *CreateAccountInput) (*CreateAccountOutput, error) {
-	startingBalance, err := decimal.NewFromString(input.Body.StartingBalance)
+	action, err := input.Body.Action()
+	if err != nil {
+		return nil, err
+	}
+
+	return idempotent.Process(ctx, h.Operator, input.Header, "create-account", input.Body, action,
+		func(result any) *CreateAccountOutput {
+			created := input.Body.Result(result).(Account)
+			return &CreateAccountOutput{
+				Status:   http.StatusCreated,
+				Location: "/v1/accounts/" + created.ID,
+				Body:     created,
+			}
+		},
+		input.Body.MapError)
+}
+
+// Action converts the request body into the operator action.
+func (b CreateAccountBody) Action() (actions.IAction, error) {
+	startingBalance, err := decimal.NewFromString(b.StartingBalance)
 	if err != nil {
 		startingBalance = decimal.Zero
 	}
 
-	action := &actions.CreateAccount{
-		Name:            input.Body.Name,
-		Type:            account.AccountType(input.Body.Type),
-		SubType:         input.Body.SubType,
+	return &actions.CreateAccount{
+		Name:            b.Name,
+		Type:            account.AccountType(b.Type),
+		SubType:         b.SubType,
 		StartingBalance: startingBalance,
-	}
+	}, nil
+}
 
-	if err := h.Operator.Process(ctx, action); err != nil {
-		return nil, huma.NewError(http.StatusInternalServerError, "failed to create account", err)
-	}
+// Result converts the action's result into the API model.
+func (b CreateAccountBody) Result(result any) any {
+	return toAPIAccount(result.(*account.Account))
+}
 
-	return &CreateAccountOutput{Status: http.StatusCreated}, nil
+// MapError converts an error from processing the action into an API error.
+func (b CreateAccountBody) MapError(err error) huma.StatusError {
+	return huma.NewError(http.StatusInternalServerError, "failed to create account", err)
 }
@@ -1,14 +1,17 @@
 package account
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"testing"
 
 	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/carson-networks/budget-server/internal/operator"
 	"github.com/carson-networks/budget-server/internal/operator/actions"
@@ -23,6 +26,14 @@ func newCreateAccountTestAPI(t *testing.T, op operator.IProcessor) humatest.Test
 }
 
 func TestHTTP_CreateAccount_Success(t *testing.T) {
+	created := &account.Account{
+		ID:              uuid.Must(uuid.NewV4()),
+		Name:            "Checking",
+		Type:            account.AccountTypeCash,
+		SubType:         "Personal",
+		Balance:         decimal.NewFromInt(100),
+		StartingBalance: decimal.NewFromInt(100),
+	}
 	mockOp := &operator.MockIProcessor{}
 	mockOp.EXPECT().
 		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
@@ -33,7 +44,7 @@ func TestHTTP_CreateAccount_Success(t *testing.T) {
 				ca.SubType == "Personal" &&
 				ca.StartingBalance.Equal(decimal.NewFromInt(100))
 		})).
-		Return(nil)
+		Return(created, nil)
 
 	resp := newCreateAccountTestAPI(t, mockOp).Post("/v1/accounts", CreateAccountBody{
 		Name:            "Checking",
@@ -42,7 +53,13 @@ func TestHTTP_CreateAccount_Success(t *testing.T) {
 		StartingBalance: "100",
 	})
 
-	assert.Equal(t, http.StatusCreated, resp.Code)
+	require.Equal(t, http.StatusCreated, resp.Code)
+	assert.Equal(t, "/v1/accounts/"+created.ID.String(), resp.Header().Get("Location"))
+	var body Account
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, created.ID.String(), body.ID)
+	assert.Equal(t, "Checking", body.Name)
+	assert.Equal(t, "100", body.Balance)
 	mockOp.AssertExpectations(t)
 }
 
@@ -55,7 +72,7 @@ func TestHTTP_CreateAccount_InvalidStartingBalance_DefaultsToZero(t *testing.T)
 				ca.Name == "Savings" &&
 				ca.StartingBalance.Equal(decimal.Zero)
 		})).
-		Return(nil)
+		Return(&account.Account{ID: uuid.Must(uuid.NewV4()), Name: "Savings"}, nil)
 
 	resp := newCreateAccountTestAPI(t, mockOp).Post("/v1/accounts", CreateAccountBody{
 		Name:            "Savings",
@@ -73,7 +90,7 @@ func TestHTTP_CreateAccount_ProcessReturnsError(t *testing.T) {
 	mockOp := &operator.MockIProcessor{}
 	mockOp.EXPECT().
 		Process(mock.Anything, mock.Anything).
-		Return(processErr)
+		Return(nil, processErr)
 
 	resp := newCreateAccountTestAPI(t, mockOp).Post("/v1/accounts", CreateAccountBody{
 		Name:            "Checking",
@@ -0,0 +1,66 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/storage/account"
+)
+
+// GetAccountInput is the Huma input for fetching an account.
+type GetAccountInput struct {
+	ID string `path:"id" doc:"Account UUID"`
+}
+
+// GetAccountOutput is the Huma output for fetching an account.
+type GetAccountOutput struct {
+	Body Account
+}
+
+type accountFinder interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*account.Account, error)
+}
+
+// GetAccountHandler handles GET /v1/accounts/{id}.
+type GetAccountHandler struct {
+	AccountReader accountFinder
+}
+
+// NewGetAccountHandler creates a new GetAccountHandler.
+func NewGetAccountHandler(reader accountFinder) *GetAccountHandler {
+	return &GetAccountHandler{AccountReader: reader}
+}
+
+// Register registers the get account endpoint with the Huma API.
+func (h *GetAccountHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-account",
+		Method:      http.MethodGet,
+		Path:        "/v1/accounts/{id}",
+		Summary:     "Get account",
+		Description: "Returns a single account by ID.",
+		Tags:        []string{"Accounts"},
+	}, h.handle)
+}
+
+func (h *GetAccountHandler) handle(ctx context.Context, input *GetAccountInput) (*GetAccountOutput, error) {
+	id, err := uuid.FromString(input.ID)
+	if err != nil {
+		return nil, huma.NewError(http.StatusBadRequest, "invalid account ID", err)
+	}
+
+	acc, err := h.AccountReader.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, huma.NewError(http.StatusNotFound, "account not found", err)
+		}
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to get account", err)
+	}
+
+	return &GetAccountOutput{Body: toAPIAccount(acc)}, nil
+}
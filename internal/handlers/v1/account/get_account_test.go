@@ -0,0 +1,59 @@
+package account
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage/account"
+)
+
+func newGetAccountTestAPI(t *testing.T, reader accountFinder) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	NewGetAccountHandler(reader).Register(api)
+	return api
+}
+
+func TestHTTP_GetAccount_Success(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockReader := &mockAccountReader{}
+	mockReader.On("FindByID", mock.Anything, id).
+		Return(&account.Account{ID: id, Name: "Checking", Balance: decimal.NewFromInt(10)}, nil)
+
+	resp := newGetAccountTestAPI(t, mockReader).Get("/v1/accounts/" + id.String())
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	var body Account
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, id.String(), body.ID)
+	assert.Equal(t, "10", body.Balance)
+	mockReader.AssertExpectations(t)
+}
+
+func TestHTTP_GetAccount_NotFound(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockReader := &mockAccountReader{}
+	mockReader.On("FindByID", mock.Anything, id).Return(nil, sql.ErrNoRows)
+
+	resp := newGetAccountTestAPI(t, mockReader).Get("/v1/accounts/" + id.String())
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func TestHTTP_GetAccount_InvalidID(t *testing.T) {
+	mockReader := &mockAccountReader{}
+
+	resp := newGetAccountTestAPI(t, mockReader).Get("/v1/accounts/not-a-uuid")
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockReader.AssertNotCalled(t, "FindByID")
+}
@@ -8,28 +8,23 @@ import (
 	"github.com/danielgtaylor/huma/v2"
 
 	"github.com/carson-networks/budget-server/internal/logging"
+	"github.com/carson-networks/budget-server/internal/pagination"
 	"github.com/carson-networks/budget-server/internal/storage/account"
 )
 
-// ListAccountsCursor represents a pagination cursor in request query params.
-type ListAccountsCursor struct {
-	Position int `query:"position" minimum:"0" doc:"Numeric offset position for the next page"`
-	Limit    int `query:"limit" minimum:"1" maximum:"100" doc:"Page size"`
-}
+// accountsCursorScope binds account list cursors so they cannot be replayed against other listings.
+const accountsCursorScope = "accounts"
 
 // ListAccountsInput is the Huma input for listing accounts.
 type ListAccountsInput struct {
-	Position int `query:"position" minimum:"0" doc:"Offset for pagination"`
-	Limit    int `query:"limit" minimum:"1" maximum:"100" doc:"Page size, default 20"`
+	Cursor string `query:"cursor" doc:"Opaque cursor from a previous response to fetch the next page"`
+	Limit  int    `query:"limit" minimum:"1" maximum:"100" doc:"Page size, default 20; ignored when a cursor is given"`
 }
 
 // ListAccountsResponseBody is the response body for listing accounts.
 type ListAccountsResponseBody struct {
 	Accounts   []Account `json:"accounts" doc:"Page of accounts"`
-	NextCursor *struct {
-		Position int `json:"position" doc:"Offset for next page"`
-		Limit    int `json:"limit" doc:"Page size"`
-	} `json:"nextCursor,omitempty" doc:"Cursor to fetch the next page, absent on the last page"`
+	NextCursor string    `json:"nextCursor,omitempty" doc:"Opaque cursor to fetch the next page, absent on the last page"`
 }
 
 // ListAccountsOutput is the Huma output for listing accounts.
@@ -45,11 +40,12 @@ type accountReader interface {
 // ListAccountsHandler handles GET /v1/accounts.
 type ListAccountsHandler struct {
 	AccountReader accountReader
+	Cursors       *pagination.Codec
 }
 
 // NewListAccountsHandler creates a new ListAccountsHandler.
-func NewListAccountsHandler(reader accountReader) *ListAccountsHandler {
-	return &ListAccountsHandler{AccountReader: reader}
+func NewListAccountsHandler(reader accountReader, cursors *pagination.Codec) *ListAccountsHandler {
+	return &ListAccountsHandler{AccountReader: reader, Cursors: cursors}
 }
 
 // Register registers the list accounts endpoint with the Huma API.
@@ -59,7 +55,7 @@ func (h *ListAccountsHandler) Register(api huma.API) {
 		Method:      http.MethodGet,
 		Path:        "/v1/accounts",
 		Summary:     "List accounts",
-		Description: "Returns a paginated list of accounts.",
+		Description: "Returns a paginated list of accounts ordered by name using keyset cursors.",
 		Tags:        []string{"Accounts"},
 	}, h.handle)
 }
@@ -67,13 +63,16 @@ func (h *ListAccountsHandler) Register(api huma.API) {
 func (h *ListAccountsHandler) handle(ctx context.Context, input *ListAccountsInput) (*ListAccountsOutput, error) {
 	logData := logging.GetLogData(ctx)
 
-	limit := input.Limit
-	if limit == 0 {
-		limit = 20
-	}
 	filter := &account.AccountFilter{
-		Limit:  limit,
-		Offset: input.Position,
+		Limit: pagination.Limit(input.Limit),
+	}
+	if input.Cursor != "" {
+		cursor, err := pagination.Decode[pagination.NameKey](h.Cursors, accountsCursorScope, "", input.Cursor)
+		if err != nil {
+			return nil, huma.NewError(http.StatusBadRequest, "invalid cursor", err)
+		}
+		filter.Limit = cursor.Limit
+		filter.After = &cursor.After
 	}
 
 	var stopTimer func()
@@ -102,26 +101,27 @@ func (h *ListAccountsHandler) handle(ctx context.Context, input *ListAccountsInp
 	}
 
 	for i, acc := range accounts {
-		resp.Accounts[i] = Account{
-			ID:              acc.ID.String(),
-			Name:            acc.Name,
-			Type:            int(acc.Type),
-			SubType:         acc.SubType,
-			Balance:         acc.Balance.String(),
-			StartingBalance: acc.StartingBalance.String(),
-			CreatedAt:       acc.CreatedAt.Format(time.RFC3339),
-		}
+		resp.Accounts[i] = toAPIAccount(acc)
 	}
 
 	if result.NextCursor != nil {
-		resp.NextCursor = &struct {
-			Position int `json:"position" doc:"Offset for next page"`
-			Limit    int `json:"limit" doc:"Page size"`
-		}{
-			Position: result.NextCursor.Position,
-			Limit:    result.NextCursor.Limit,
+		resp.NextCursor, err = pagination.Encode(h.Cursors, accountsCursorScope, "", result.NextCursor)
+		if err != nil {
+			return nil, huma.NewError(http.StatusInternalServerError, "failed to encode cursor", err)
 		}
 	}
 
 	return &ListAccountsOutput{Body: resp}, nil
 }
+
+func toAPIAccount(acc *account.Account) Account {
+	return Account{
+		ID:              acc.ID.String(),
+		Name:            acc.Name,
+		Type:            int(acc.Type),
+		SubType:         acc.SubType,
+		Balance:         acc.Balance.String(),
+		StartingBalance: acc.StartingBalance.String(),
+		CreatedAt:       acc.CreatedAt.Format(time.RFC3339),
+	}
+}
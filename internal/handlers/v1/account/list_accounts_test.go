@@ -0,0 +1,107 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/carson-networks/budget-server/internal/pagination"
+	"github.com/carson-networks/budget-server/internal/storage/account"
+)
+
+type mockAccountReader struct {
+	mock.Mock
+}
+
+func (m *mockAccountReader) List(ctx context.Context, filter *account.AccountFilter) (*account.AccountListResult, error) {
+	args := m.Called(ctx, filter)
+	result, _ := args.Get(0).(*account.AccountListResult)
+	return result, args.Error(1)
+}
+
+func (m *mockAccountReader) FindByID(ctx context.Context, id uuid.UUID) (*account.Account, error) {
+	args := m.Called(ctx, id)
+	result, _ := args.Get(0).(*account.Account)
+	return result, args.Error(1)
+}
+
+var testCursors = pagination.NewCodec([]byte("test-key"))
+
+func newListAccountsTestAPI(t *testing.T, reader accountReader) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	NewListAccountsHandler(reader, testCursors).Register(api)
+	return api
+}
+
+func TestHTTP_ListAccounts_FirstPage(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockReader := &mockAccountReader{}
+	mockReader.On("List", mock.Anything, mock.MatchedBy(func(f *account.AccountFilter) bool {
+		return f != nil && f.Limit == 1 && f.After == nil
+	})).Return(&account.AccountListResult{
+		Accounts: []*account.Account{{
+			ID:        id,
+			Name:      "Checking",
+			Balance:   decimal.NewFromInt(10),
+			CreatedAt: time.Now(),
+		}},
+		NextCursor: &pagination.Cursor[pagination.NameKey]{
+			After: pagination.NameKey{Name: "Checking", ID: id},
+			Limit: 1,
+		},
+	}, nil)
+
+	resp := newListAccountsTestAPI(t, mockReader).Get("/v1/accounts?limit=1")
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var body ListAccountsResponseBody
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Len(t, body.Accounts, 1)
+
+	cursor, err := pagination.Decode[pagination.NameKey](testCursors, accountsCursorScope, "", body.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, "Checking", cursor.After.Name)
+	assert.Equal(t, id, cursor.After.ID)
+	mockReader.AssertExpectations(t)
+}
+
+func TestHTTP_ListAccounts_WithCursor(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	token, err := pagination.Encode(testCursors, accountsCursorScope, "", &pagination.Cursor[pagination.NameKey]{
+		After: pagination.NameKey{Name: "Checking", ID: id},
+		Limit: 5,
+	})
+	assert.NoError(t, err)
+
+	mockReader := &mockAccountReader{}
+	mockReader.On("List", mock.Anything, mock.MatchedBy(func(f *account.AccountFilter) bool {
+		return f != nil && f.Limit == 5 && f.After != nil && f.After.ID == id
+	})).Return(&account.AccountListResult{}, nil)
+
+	resp := newListAccountsTestAPI(t, mockReader).Get("/v1/accounts?cursor=" + token)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var body ListAccountsResponseBody
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Empty(t, body.Accounts)
+	assert.Empty(t, body.NextCursor)
+	mockReader.AssertExpectations(t)
+}
+
+func TestHTTP_ListAccounts_InvalidCursor(t *testing.T) {
+	mockReader := &mockAccountReader{}
+
+	resp := newListAccountsTestAPI(t, mockReader).Get("/v1/accounts?cursor=bogus")
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockReader.AssertNotCalled(t, "List")
+}
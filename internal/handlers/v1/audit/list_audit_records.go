@@ -0,0 +1,128 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/pagination"
+	"github.com/carson-networks/budget-server/internal/storage/audit"
+)
+
+// AuditRecord is the API response model for one audited action.
+type AuditRecord struct {
+	ID         string   `json:"id" doc:"Audit record UUID"`
+	ActionType string   `json:"actionType" doc:"Action performed, e.g. CreateTransaction"`
+	Actor      string   `json:"actor" doc:"Who made the request"`
+	RequestID  string   `json:"requestID" doc:"X-Request-ID of the request"`
+	Changes    []Change `json:"changes" doc:"Rows the action created, updated or deleted"`
+	CreatedAt  string   `json:"createdAt" doc:"RFC3339 time the action committed"`
+	Undoable   bool     `json:"undoable" doc:"Whether the action has an inverse; see POST /v1/actions/{id}/undo"`
+}
+
+// Change is one row changed by an audited action.
+type Change struct {
+	Entity   string `json:"entity" doc:"account, transaction, category, webhook, user, token, ledger or ledger_member; ledger_member rows are identified by the member's user ID"`
+	EntityID string `json:"entityID" doc:"UUID of the row"`
+	Before   any    `json:"before,omitempty" doc:"Row before the action; absent when it was created"`
+	After    any    `json:"after,omitempty" doc:"Row after the action; absent when it was deleted"`
+}
+
+// ListAuditRecordsInput is the Huma input for listing audit records.
+type ListAuditRecordsInput struct {
+	Entity    string `query:"entity" enum:"account,transaction,category,webhook,user,token,ledger,ledger_member" doc:"Only records that changed this kind of row"`
+	ID        string `query:"id" doc:"Only records that changed this row; requires entity"`
+	RequestID string `query:"requestID" maxLength:"128" doc:"Only records written by the request with this X-Request-ID"`
+	Limit     int    `query:"limit" minimum:"1" maximum:"100" doc:"Number of records, default 20"`
+}
+
+// ListAuditRecordsResponseBody is the response body for listing audit records.
+type ListAuditRecordsResponseBody struct {
+	Records []AuditRecord `json:"records" doc:"Matching records, newest first"`
+}
+
+// ListAuditRecordsOutput is the Huma output for listing audit records.
+type ListAuditRecordsOutput struct {
+	Body ListAuditRecordsResponseBody
+}
+
+// auditReader is the interface for reading the audit log.
+type auditReader interface {
+	List(ctx context.Context, filter *audit.RecordFilter) ([]*audit.Record, error)
+}
+
+// ListAuditRecordsHandler handles GET /v1/audit.
+type ListAuditRecordsHandler struct {
+	AuditReader auditReader
+}
+
+// NewListAuditRecordsHandler creates a new ListAuditRecordsHandler.
+func NewListAuditRecordsHandler(reader auditReader) *ListAuditRecordsHandler {
+	return &ListAuditRecordsHandler{AuditReader: reader}
+}
+
+// Register registers the list audit records endpoint with the Huma API.
+func (h *ListAuditRecordsHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-audit-records",
+		Method:      http.MethodGet,
+		Path:        "/v1/audit",
+		Summary:     "List audit records",
+		Description: "Returns the audit log of committed writes, newest first, optionally only those that changed one row.",
+		Tags:        []string{"Audit"},
+	}, h.handle)
+}
+
+func (h *ListAuditRecordsHandler) handle(ctx context.Context, input *ListAuditRecordsInput) (*ListAuditRecordsOutput, error) {
+	filter := &audit.RecordFilter{
+		Entity:    audit.Entity(input.Entity),
+		RequestID: input.RequestID,
+		Limit:     pagination.Limit(input.Limit),
+	}
+	if input.ID != "" {
+		if input.Entity == "" {
+			return nil, huma.NewError(http.StatusBadRequest, "id requires entity")
+		}
+		id, err := uuid.FromString(input.ID)
+		if err != nil {
+			return nil, huma.NewError(http.StatusBadRequest, "invalid id", err)
+		}
+		filter.EntityID = &id
+	}
+
+	records, err := h.AuditReader.List(ctx, filter)
+	if err != nil {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to list audit records", err)
+	}
+
+	body := ListAuditRecordsResponseBody{Records: make([]AuditRecord, len(records))}
+	for i, record := range records {
+		body.Records[i] = toAPIAuditRecord(record)
+	}
+	return &ListAuditRecordsOutput{Body: body}, nil
+}
+
+func toAPIAuditRecord(r *audit.Record) AuditRecord {
+	changes := make([]Change, len(r.Changes))
+	for i, c := range r.Changes {
+		changes[i] = Change{Entity: string(c.Entity), EntityID: c.EntityID.String()}
+		if c.Before != nil {
+			changes[i].Before = c.Before
+		}
+		if c.After != nil {
+			changes[i].After = c.After
+		}
+	}
+	return AuditRecord{
+		ID:         r.ID.String(),
+		ActionType: r.ActionType,
+		Actor:      r.Actor,
+		RequestID:  r.RequestID,
+		Changes:    changes,
+		CreatedAt:  r.CreatedAt.Format(time.RFC3339),
+		Undoable:   r.Inverse != nil,
+	}
+}
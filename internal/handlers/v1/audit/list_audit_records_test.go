@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/pagination"
+	"github.com/carson-networks/budget-server/internal/storage/audit"
+)
+
+type mockAuditReader struct {
+	mock.Mock
+}
+
+func (m *mockAuditReader) List(ctx context.Context, filter *audit.RecordFilter) ([]*audit.Record, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*audit.Record), args.Error(1)
+}
+
+func newListAuditRecordsTestAPI(t *testing.T, reader auditReader) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	NewListAuditRecordsHandler(reader).Register(api)
+	return api
+}
+
+func TestHTTP_ListAuditRecords_ByEntityID(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockReader := &mockAuditReader{}
+	mockReader.On("List", mock.Anything, mock.MatchedBy(func(f *audit.RecordFilter) bool {
+		return f.Entity == audit.EntityTransaction && f.EntityID != nil && *f.EntityID == id && f.Limit == pagination.DefaultLimit
+	})).Return([]*audit.Record{{
+		ID:         uuid.Must(uuid.NewV4()),
+		ActionType: "CreateTransaction",
+		Actor:      "sam",
+		RequestID:  "req-1",
+		Changes: []audit.Change{{
+			Entity:   audit.EntityTransaction,
+			EntityID: id,
+			After:    json.RawMessage(`{"amount":"12.5"}`),
+		}},
+	}}, nil)
+
+	resp := newListAuditRecordsTestAPI(t, mockReader).Get("/v1/audit?entity=transaction&id=" + id.String())
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.NotContains(t, resp.Body.String(), `"before"`)
+	var body struct {
+		Records []struct {
+			ActionType string `json:"actionType"`
+			Actor      string `json:"actor"`
+			Changes    []struct {
+				EntityID string          `json:"entityID"`
+				After    json.RawMessage `json:"after"`
+			} `json:"changes"`
+		} `json:"records"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Records, 1)
+	assert.Equal(t, "CreateTransaction", body.Records[0].ActionType)
+	assert.Equal(t, "sam", body.Records[0].Actor)
+	require.Len(t, body.Records[0].Changes, 1)
+	assert.Equal(t, id.String(), body.Records[0].Changes[0].EntityID)
+	assert.JSONEq(t, `{"amount":"12.5"}`, string(body.Records[0].Changes[0].After))
+	mockReader.AssertExpectations(t)
+}
+
+func TestHTTP_ListAuditRecords_IDRequiresEntity(t *testing.T) {
+	resp := newListAuditRecordsTestAPI(t, &mockAuditReader{}).Get("/v1/audit?id=" + uuid.Must(uuid.NewV4()).String())
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestHTTP_ListAuditRecords_InvalidID(t *testing.T) {
+	resp := newListAuditRecordsTestAPI(t, &mockAuditReader{}).Get("/v1/audit?entity=account&id=nope")
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestHTTP_ListAuditRecords_ReaderError(t *testing.T) {
+	mockReader := &mockAuditReader{}
+	mockReader.On("List", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+	resp := newListAuditRecordsTestAPI(t, mockReader).Get("/v1/audit")
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
+
+func TestHTTP_ListAuditRecords_ByRequestID(t *testing.T) {
+	mockReader := &mockAuditReader{}
+	mockReader.On("List", mock.Anything, mock.MatchedBy(func(f *audit.RecordFilter) bool {
+		return f.RequestID == "req-1"
+	})).Return([]*audit.Record{{
+		ID:         uuid.Must(uuid.NewV4()),
+		ActionType: "Undo",
+		Inverse:    json.RawMessage(`{"type":"DeleteCategory"}`),
+	}}, nil)
+
+	resp := newListAuditRecordsTestAPI(t, mockReader).Get("/v1/audit?requestID=req-1")
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	var body ListAuditRecordsResponseBody
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Records, 1)
+	assert.True(t, body.Records[0].Undoable)
+	mockReader.AssertExpectations(t)
+}
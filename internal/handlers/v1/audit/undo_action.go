@@ -0,0 +1,105 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/requestctx"
+)
+
+// UndoActionInput is the Huma input for undoing an audited action.
+type UndoActionInput struct {
+	idempotent.Header
+	ID string `path:"id" doc:"Audit record UUID of the action to undo"`
+}
+
+// UndoActionResponseBody is the response body for undoing an action.
+type UndoActionResponseBody struct {
+	UndoneID  string `json:"undoneID" doc:"Audit record UUID of the undone action"`
+	Action    string `json:"action" doc:"Inverse action performed, e.g. DeleteCategory"`
+	RequestID string `json:"requestID" doc:"X-Request-ID of this request; the undo is audited under it"`
+}
+
+// UndoActionOutput is the Huma output for undoing an action.
+type UndoActionOutput struct {
+	Body UndoActionResponseBody
+}
+
+// UndoActionHandler handles POST /v1/actions/{id}/undo.
+type UndoActionHandler struct {
+	Operator operator.IProcessor
+}
+
+// NewUndoActionHandler creates a new UndoActionHandler.
+func NewUndoActionHandler(op operator.IProcessor) *UndoActionHandler {
+	return &UndoActionHandler{Operator: op}
+}
+
+// Register registers the undo action endpoint with the Huma API.
+func (h *UndoActionHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "undo-action",
+		Method:      http.MethodPost,
+		Path:        "/v1/actions/{id}/undo",
+		Summary:     "Undo action",
+		Description: "Performs the inverse of an audited action. Refused with 409 when a later action changed any of the same rows. " +
+			"The undo is audited too, so undoing its record redoes the original action.",
+		Tags: []string{"Audit"},
+	}, h.handle)
+}
+
+func (h *UndoActionHandler) handle(ctx context.Context, input *UndoActionInput) (*UndoActionOutput, error) {
+	id, err := uuid.FromString(input.ID)
+	if err != nil {
+		return nil, huma.NewError(http.StatusBadRequest, "invalid id", err)
+	}
+
+	scopedBody := struct {
+		ID string `json:"id"`
+	}{input.ID}
+	return idempotent.Process(ctx, h.Operator, input.Header, "undo-action", scopedBody, &actions.Undo{RecordID: id},
+		func(result any) *UndoActionOutput {
+			body := UndoActionResponseBody{UndoneID: id.String(), RequestID: requestctx.RequestID(ctx)}
+			if undone, ok := result.(*actions.UndoResult); ok {
+				body.Action = undone.Action
+			}
+			return &UndoActionOutput{Body: body}
+		},
+		mapUndoError)
+}
+
+// mapUndoError converts an error from undoing an action into an API error.
+// The inverse can fail like any other action once the rows it restores are
+// gone or taken, which is a conflict with the current state.
+func mapUndoError(err error) huma.StatusError {
+	switch {
+	case errors.Is(err, actions.ErrAuditRecordNotFound):
+		return huma.NewError(http.StatusNotFound, "audit record not found", err)
+	case errors.Is(err, actions.ErrNotInvertible):
+		return huma.NewError(http.StatusUnprocessableEntity, "action cannot be undone", err)
+	case errors.Is(err, actions.ErrUndoConflict):
+		return huma.NewError(http.StatusConflict, "a later action changed the same rows", err)
+	case errors.Is(err, actions.ErrCategoryExists),
+		errors.Is(err, actions.ErrCategoryNotFound),
+		errors.Is(err, actions.ErrCategoryHasChildren),
+		errors.Is(err, actions.ErrCategoryHasTransactions),
+		errors.Is(err, actions.ErrParentCategoryNotFound),
+		errors.Is(err, actions.ErrMergeTargetNotFound),
+		errors.Is(err, actions.ErrCategoryDisabled),
+		errors.Is(err, actions.ErrCategoryIsParent),
+		errors.Is(err, actions.ErrCategoryNotFoundForTransaction),
+		errors.Is(err, actions.ErrTransactionNotFound),
+		errors.Is(err, actions.ErrAccountNotFound),
+		errors.Is(err, actions.ErrWebhookNotFound):
+		return huma.NewError(http.StatusConflict, "action can no longer be undone", err)
+	default:
+		return huma.NewError(http.StatusInternalServerError, "failed to undo action", err)
+	}
+}
@@ -0,0 +1,71 @@
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+)
+
+func newUndoActionTestAPI(t *testing.T, op operator.IProcessor) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	NewUndoActionHandler(op).Register(api)
+	return api
+}
+
+func TestHTTP_UndoAction_Success(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			undo, ok := a.(*actions.Undo)
+			return ok && undo.RecordID == id
+		})).
+		Return(&actions.UndoResult{Action: "RestoreCategory"}, nil)
+
+	resp := newUndoActionTestAPI(t, mockOp).Post("/v1/actions/" + id.String() + "/undo")
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	var body UndoActionResponseBody
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, id.String(), body.UndoneID)
+	assert.Equal(t, "RestoreCategory", body.Action)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_UndoAction_InvalidID(t *testing.T) {
+	resp := newUndoActionTestAPI(t, &operator.MockIProcessor{}).Post("/v1/actions/not-a-uuid/undo")
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestHTTP_UndoAction_Errors(t *testing.T) {
+	tests := []struct {
+		err  error
+		code int
+	}{
+		{actions.ErrAuditRecordNotFound, http.StatusNotFound},
+		{actions.ErrNotInvertible, http.StatusUnprocessableEntity},
+		{actions.ErrUndoConflict, http.StatusConflict},
+		{&actions.BatchItemError{Index: 0, Err: actions.ErrCategoryHasTransactions}, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			mockOp := &operator.MockIProcessor{}
+			mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, tt.err)
+
+			resp := newUndoActionTestAPI(t, mockOp).Post("/v1/actions/" + uuid.Must(uuid.NewV4()).String() + "/undo")
+
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/handlers/v1/account"
+	"github.com/carson-networks/budget-server/internal/handlers/v1/category"
+	"github.com/carson-networks/budget-server/internal/handlers/v1/transaction"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+)
+
+// BatchItem is a single write in a batch. Exactly one field must be set; each
+// takes the same body as the corresponding standalone endpoint.
+type BatchItem struct {
+	CreateAccount     *account.CreateAccountBody         `json:"createAccount,omitempty" doc:"Same body as POST /v1/accounts"`
+	CreateCategory    *category.CreateCategoryBody       `json:"createCategory,omitempty" doc:"Same body as POST /v1/categories/create"`
+	UpdateCategory    *category.UpdateCategoryItem       `json:"updateCategory,omitempty" doc:"Same body as PATCH /v1/categories/update/{id}, plus the id"`
+	DeleteCategory    *category.DeleteCategoryItem       `json:"deleteCategory,omitempty" doc:"Category to delete, as DELETE /v1/categories/delete/{id}"`
+	MergeCategories   *category.MergeCategoriesBody      `json:"mergeCategories,omitempty" doc:"Same body as POST /v1/categories/merge"`
+	CreateTransaction *transaction.CreateTransactionBody `json:"createTransaction,omitempty" doc:"Same body as POST /v1/transaction"`
+}
+
+// BatchBody is the request body for a batch.
+type BatchBody struct {
+	Items []BatchItem `json:"items" minItems:"1" doc:"Writes to perform in order; all are committed or none are"`
+}
+
+// BatchInput is the Huma input for a batch.
+type BatchInput struct {
+	idempotent.Header
+	Body BatchBody
+}
+
+// BatchItemResult reports the outcome of one batch item.
+type BatchItemResult struct {
+	Index  int    `json:"index" doc:"Position of the item in the request"`
+	Status int    `json:"status" doc:"HTTP status for this item; 424 when the item was not applied because another item failed"`
+	Error  string `json:"error,omitempty" doc:"Why the item failed"`
+	Result any    `json:"result,omitempty" doc:"Resource the item created or updated, as returned by the standalone endpoint"`
+}
+
+// BatchResponseBody is the response body for a batch.
+type BatchResponseBody struct {
+	Committed bool              `json:"committed" doc:"Whether the batch was committed"`
+	Results   []BatchItemResult `json:"results" doc:"One result per item, in request order"`
+}
+
+// BatchOutput is the Huma output for a batch. Status is 200 when the batch
+// commits, otherwise the status of the item that failed.
+type BatchOutput struct {
+	Status int
+	Body   BatchResponseBody
+}
+
+// batchOperation is implemented by every item body.
+type batchOperation interface {
+	Action() (actions.IAction, error)
+	MapError(err error) huma.StatusError
+	Result(result any) any
+}
+
+// BatchHandler handles POST /v1/batch.
+type BatchHandler struct {
+	Operator operator.IProcessor
+	MaxItems int
+}
+
+// NewBatchHandler creates a new BatchHandler accepting at most maxItems items.
+func NewBatchHandler(op operator.IProcessor, maxItems int) *BatchHandler {
+	return &BatchHandler{Operator: op, MaxItems: maxItems}
+}
+
+// Register registers the batch endpoint with the Huma API.
+func (h *BatchHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "batch",
+		Method:      http.MethodPost,
+		Path:        "/v1/batch",
+		Summary:     "Batch writes",
+		Description: "Performs an ordered list of writes in a single database transaction. If any item fails, none are applied.",
+		Tags:        []string{"Batch"},
+	}, h.handle)
+}
+
+func (h *BatchHandler) handle(ctx context.Context, input *BatchInput) (*BatchOutput, error) {
+	items := input.Body.Items
+	if len(items) > h.MaxItems {
+		return nil, huma.NewError(http.StatusRequestEntityTooLarge, fmt.Sprintf("batch has %d items; the limit is %d", len(items), h.MaxItems))
+	}
+
+	ops := make([]batchOperation, len(items))
+	batchActions := make([]actions.IAction, len(items))
+	for i, item := range items {
+		op, err := item.operation()
+		if err != nil {
+			return failed(len(items), i, huma.NewError(http.StatusBadRequest, err.Error())), nil
+		}
+		action, err := op.Action()
+		if err != nil {
+			return failed(len(items), i, asStatusError(err)), nil
+		}
+		ops[i] = op
+		batchActions[i] = action
+	}
+
+	out, err := idempotent.Process(ctx, h.Operator, input.Header, "batch", input.Body, &actions.Batch{Actions: batchActions},
+		func(result any) *BatchOutput { return succeeded(ops, result.([]any)) },
+		func(err error) error { return err })
+	if err != nil {
+		var itemErr *actions.BatchItemError
+		if errors.As(err, &itemErr) {
+			return failed(len(items), itemErr.Index, ops[itemErr.Index].MapError(itemErr.Err)), nil
+		}
+		var statusErr huma.StatusError
+		if errors.As(err, &statusErr) {
+			return nil, err
+		}
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to process batch", err)
+	}
+	return out, nil
+}
+
+func (i BatchItem) operation() (batchOperation, error) {
+	var set []batchOperation
+	if i.CreateAccount != nil {
+		set = append(set, i.CreateAccount)
+	}
+	if i.CreateCategory != nil {
+		set = append(set, i.CreateCategory)
+	}
+	if i.UpdateCategory != nil {
+		set = append(set, i.UpdateCategory)
+	}
+	if i.DeleteCategory != nil {
+		set = append(set, i.DeleteCategory)
+	}
+	if i.MergeCategories != nil {
+		set = append(set, i.MergeCategories)
+	}
+	if i.CreateTransaction != nil {
+		set = append(set, i.CreateTransaction)
+	}
+	if len(set) != 1 {
+		return nil, fmt.Errorf("exactly one operation must be set, got %d", len(set))
+	}
+	return set[0], nil
+}
+
+// succeeded builds the response for a committed batch from the result of
+// each item's action.
+func succeeded(ops []batchOperation, results []any) *BatchOutput {
+	resp := BatchResponseBody{
+		Committed: true,
+		Results:   make([]BatchItemResult, len(ops)),
+	}
+	for i, op := range ops {
+		resp.Results[i] = BatchItemResult{Index: i, Status: http.StatusOK, Result: op.Result(results[i])}
+	}
+	return &BatchOutput{Status: http.StatusOK, Body: resp}
+}
+
+// failed builds the response for a batch that was not committed because the
+// item at index failed with cause.
+func failed(count int, index int, cause huma.StatusError) *BatchOutput {
+	resp := BatchResponseBody{
+		Committed: false,
+		Results:   make([]BatchItemResult, count),
+	}
+	for i := range resp.Results {
+		resp.Results[i] = BatchItemResult{Index: i, Status: http.StatusFailedDependency}
+	}
+	resp.Results[index] = BatchItemResult{Index: index, Status: cause.GetStatus(), Error: cause.Error()}
+	return &BatchOutput{Status: cause.GetStatus(), Body: resp}
+}
+
+func asStatusError(err error) huma.StatusError {
+	var statusErr huma.StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr
+	}
+	return huma.NewError(http.StatusBadRequest, err.Error())
+}
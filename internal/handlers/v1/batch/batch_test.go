@@ -0,0 +1,143 @@
+package batch
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/handlers/v1/account"
+	"github.com/carson-networks/budget-server/internal/handlers/v1/category"
+	"github.com/carson-networks/budget-server/internal/handlers/v1/transaction"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	storageaccount "github.com/carson-networks/budget-server/internal/storage/account"
+	storagetransaction "github.com/carson-networks/budget-server/internal/storage/transaction"
+)
+
+func newBatchTestAPI(t *testing.T, op operator.IProcessor, maxItems int) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	NewBatchHandler(op, maxItems).Register(api)
+	return api
+}
+
+func createTransactionItem(amount string) BatchItem {
+	return BatchItem{CreateTransaction: &transaction.CreateTransactionBody{
+		AccountID:       uuid.Must(uuid.NewV4()).String(),
+		CategoryID:      uuid.Must(uuid.NewV4()).String(),
+		Amount:          amount,
+		TransactionName: "Groceries",
+	}}
+}
+
+func TestHTTP_Batch_Success(t *testing.T) {
+	accountID := uuid.Must(uuid.NewV4())
+	transactionID := uuid.Must(uuid.NewV4())
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			b, ok := a.(*actions.Batch)
+			if !ok || len(b.Actions) != 2 {
+				return false
+			}
+			_, first := b.Actions[0].(*actions.CreateAccount)
+			_, second := b.Actions[1].(*actions.CreateTransaction)
+			return first && second
+		})).
+		Return([]any{
+			&storageaccount.Account{ID: accountID, Name: "Checking"},
+			&storagetransaction.Transaction{ID: transactionID},
+		}, nil)
+
+	resp := newBatchTestAPI(t, mockOp, 10).Post("/v1/batch", BatchBody{Items: []BatchItem{
+		{CreateAccount: &account.CreateAccountBody{Name: "Checking", StartingBalance: "100"}},
+		createTransactionItem("-10"),
+	}})
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	var body BatchResponseBody
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.True(t, body.Committed)
+	require.Len(t, body.Results, 2)
+	assert.Equal(t, http.StatusOK, body.Results[1].Status)
+	assert.Equal(t, accountID.String(), body.Results[0].Result.(map[string]any)["id"])
+	assert.Equal(t, transactionID.String(), body.Results[1].Result.(map[string]any)["id"])
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_Batch_ItemFailureRollsBack(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.Anything).
+		Return(nil, &actions.BatchItemError{Index: 1, Err: actions.ErrCategoryDisabled})
+
+	resp := newBatchTestAPI(t, mockOp, 10).Post("/v1/batch", BatchBody{Items: []BatchItem{
+		createTransactionItem("-10"),
+		createTransactionItem("-20"),
+		createTransactionItem("-30"),
+	}})
+
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	var body BatchResponseBody
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.False(t, body.Committed)
+	require.Len(t, body.Results, 3)
+	assert.Equal(t, http.StatusFailedDependency, body.Results[0].Status)
+	assert.Equal(t, http.StatusBadRequest, body.Results[1].Status)
+	assert.Contains(t, body.Results[1].Error, "disabled")
+	assert.Equal(t, http.StatusFailedDependency, body.Results[2].Status)
+}
+
+func TestHTTP_Batch_InvalidItemNotProcessed(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+
+	resp := newBatchTestAPI(t, mockOp, 10).Post("/v1/batch", BatchBody{Items: []BatchItem{
+		createTransactionItem("-10"),
+		{DeleteCategory: &category.DeleteCategoryItem{ID: "nope"}},
+	}})
+
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	var body BatchResponseBody
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, http.StatusBadRequest, body.Results[1].Status)
+	mockOp.AssertNotCalled(t, "Process")
+}
+
+func TestHTTP_Batch_ItemMustSetExactlyOneOperation(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	item := createTransactionItem("-10")
+	item.DeleteCategory = &category.DeleteCategoryItem{ID: uuid.Must(uuid.NewV4()).String()}
+
+	resp := newBatchTestAPI(t, mockOp, 10).Post("/v1/batch", BatchBody{Items: []BatchItem{item, {}}})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockOp.AssertNotCalled(t, "Process")
+}
+
+func TestHTTP_Batch_TooManyItems(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+
+	resp := newBatchTestAPI(t, mockOp, 1).Post("/v1/batch", BatchBody{Items: []BatchItem{
+		createTransactionItem("-10"),
+		createTransactionItem("-20"),
+	}})
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.Code)
+	mockOp.AssertNotCalled(t, "Process")
+}
+
+func TestHTTP_Batch_ProcessError(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, errors.New("commit failed"))
+
+	resp := newBatchTestAPI(t, mockOp, 10).Post("/v1/batch", BatchBody{Items: []BatchItem{createTransactionItem("-10")}})
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
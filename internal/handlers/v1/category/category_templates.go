@@ -0,0 +1,134 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/categorytemplates"
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+)
+
+// CategoryTemplateGroup is a parent group in a category template.
+type CategoryTemplateGroup struct {
+	Name     string   `json:"name" doc:"Parent category name"`
+	Type     string   `json:"type" enum:"income,expense" doc:"Category direction shared by the group and its children"`
+	Children []string `json:"children" doc:"Child category names"`
+}
+
+// CategoryTemplate is the API response model for a built-in category template.
+type CategoryTemplate struct {
+	Name        string                  `json:"name" doc:"Template name used when applying it"`
+	Description string                  `json:"description" doc:"What the template is for"`
+	Groups      []CategoryTemplateGroup `json:"groups" doc:"Parent groups and their children"`
+}
+
+// ListCategoryTemplatesOutput is the Huma output for listing category templates.
+type ListCategoryTemplatesOutput struct {
+	Body struct {
+		Templates []CategoryTemplate `json:"templates" doc:"Built-in category templates"`
+	}
+}
+
+// ApplyCategoryTemplateInput is the Huma input for applying a category template.
+type ApplyCategoryTemplateInput struct {
+	idempotent.Header
+	Name string `path:"name" doc:"Template name"`
+}
+
+// ApplyCategoryTemplateResponseBody is the response body for applying a category template.
+type ApplyCategoryTemplateResponseBody struct {
+	Created    int        `json:"created" doc:"Number of categories created"`
+	Skipped    int        `json:"skipped" doc:"Number of template categories skipped because the name already exists"`
+	Categories []Category `json:"categories" doc:"Categories created, parents before their children"`
+}
+
+// ApplyCategoryTemplateOutput is the Huma output for applying a category template.
+type ApplyCategoryTemplateOutput struct {
+	Body ApplyCategoryTemplateResponseBody
+}
+
+// CategoryTemplatesHandler handles GET /v1/categories/templates and
+// POST /v1/categories/templates/{name}/apply.
+type CategoryTemplatesHandler struct {
+	Operator operator.IProcessor
+}
+
+// NewCategoryTemplatesHandler creates a new CategoryTemplatesHandler.
+func NewCategoryTemplatesHandler(op operator.IProcessor) *CategoryTemplatesHandler {
+	return &CategoryTemplatesHandler{Operator: op}
+}
+
+// Register registers the category template endpoints with the Huma API.
+func (h *CategoryTemplatesHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-category-templates",
+		Method:      http.MethodGet,
+		Path:        "/v1/categories/templates",
+		Summary:     "List category templates",
+		Description: "Returns the built-in category templates.",
+		Tags:        []string{"Categories"},
+	}, h.list)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "apply-category-template",
+		Method:      http.MethodPost,
+		Path:        "/v1/categories/templates/{name}/apply",
+		Summary:     "Apply category template",
+		Description: "Creates the template's parent groups and children in one transaction, skipping names that already exist.",
+		Tags:        []string{"Categories"},
+	}, h.apply)
+}
+
+func (h *CategoryTemplatesHandler) list(ctx context.Context, _ *struct{}) (*ListCategoryTemplatesOutput, error) {
+	out := &ListCategoryTemplatesOutput{}
+	out.Body.Templates = []CategoryTemplate{}
+	for _, t := range categorytemplates.List() {
+		apiTemplate := CategoryTemplate{
+			Name:        t.Name,
+			Description: t.Description,
+			Groups:      make([]CategoryTemplateGroup, len(t.Groups)),
+		}
+		for i, g := range t.Groups {
+			apiTemplate.Groups[i] = CategoryTemplateGroup{Name: g.Name, Type: g.Type, Children: g.Children}
+		}
+		out.Body.Templates = append(out.Body.Templates, apiTemplate)
+	}
+	return out, nil
+}
+
+func (h *CategoryTemplatesHandler) apply(ctx context.Context, input *ApplyCategoryTemplateInput) (*ApplyCategoryTemplateOutput, error) {
+	template, err := categorytemplates.Get(input.Name)
+	if err != nil {
+		return nil, huma.NewError(http.StatusNotFound, "category template not found", err)
+	}
+
+	action := &actions.ApplyCategoryTemplate{Template: template}
+	return idempotent.Process(ctx, h.Operator, input.Header, "apply-category-template", input.Name, action,
+		func(result any) *ApplyCategoryTemplateOutput {
+			applied := result.(*actions.ApplyCategoryTemplateResult)
+			body := ApplyCategoryTemplateResponseBody{
+				Created:    len(applied.Created),
+				Skipped:    applied.Skipped,
+				Categories: make([]Category, len(applied.Created)),
+			}
+			for i, cat := range applied.Created {
+				body.Categories[i] = toAPICategory(cat)
+			}
+			return &ApplyCategoryTemplateOutput{Body: body}
+		},
+		func(err error) huma.StatusError {
+			switch {
+			case errors.Is(err, actions.ErrTemplateGroupNotParent):
+				return huma.NewError(http.StatusConflict, "a template group name is already used by a non-parent category", err)
+			case errors.Is(err, actions.ErrTemplateGroupTypeMismatch):
+				return huma.NewError(http.StatusConflict, "a template group name is already used by a parent category of another type", err)
+			default:
+				return huma.NewError(http.StatusInternalServerError, "failed to apply category template", err)
+			}
+		})
+}
@@ -0,0 +1,86 @@
+package category
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/category"
+)
+
+func newCategoryTemplatesTestAPI(t *testing.T, op operator.IProcessor) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	NewCategoryTemplatesHandler(op).Register(api)
+	return api
+}
+
+func TestHTTP_ListCategoryTemplates(t *testing.T) {
+	resp := newCategoryTemplatesTestAPI(t, &operator.MockIProcessor{}).Get("/v1/categories/templates")
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	var body struct {
+		Templates []CategoryTemplate `json:"templates"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.NotEmpty(t, body.Templates)
+}
+
+func TestHTTP_ApplyCategoryTemplate_Success(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			act, ok := a.(*actions.ApplyCategoryTemplate)
+			return ok && act.Template.Name == "household"
+		})).
+		Return(&actions.ApplyCategoryTemplateResult{
+			Created: []*category.Category{{ID: uuid.Must(uuid.NewV4()), Name: "Food", IsParent: true}},
+			Skipped: 1,
+		}, nil)
+
+	resp := newCategoryTemplatesTestAPI(t, mockOp).Post("/v1/categories/templates/household/apply")
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	var body ApplyCategoryTemplateResponseBody
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, 1, body.Created)
+	assert.Equal(t, 1, body.Skipped)
+	require.Len(t, body.Categories, 1)
+	assert.Equal(t, "Food", body.Categories[0].Name)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_ApplyCategoryTemplate_NotFound(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+
+	resp := newCategoryTemplatesTestAPI(t, mockOp).Post("/v1/categories/templates/nope/apply")
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	mockOp.AssertNotCalled(t, "Process")
+}
+
+func TestHTTP_ApplyCategoryTemplate_Conflict(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, actions.ErrTemplateGroupNotParent)
+
+	resp := newCategoryTemplatesTestAPI(t, mockOp).Post("/v1/categories/templates/household/apply")
+
+	assert.Equal(t, http.StatusConflict, resp.Code)
+}
+
+func TestHTTP_ApplyCategoryTemplate_TypeConflict(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, actions.ErrTemplateGroupTypeMismatch)
+
+	resp := newCategoryTemplatesTestAPI(t, mockOp).Post("/v1/categories/templates/household/apply")
+
+	assert.Equal(t, http.StatusConflict, resp.Code)
+}
@@ -0,0 +1,199 @@
+package category
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+
+	"github.com/carson-networks/budget-server/internal/logging"
+	"github.com/carson-networks/budget-server/internal/storage/category"
+)
+
+// CategoryTreeInput is the Huma input for the category tree.
+type CategoryTreeInput struct {
+	IncludeDisabled bool `query:"includeDisabled" doc:"Include disabled categories and their children"`
+	IncludeTotals   bool `query:"includeTotals" doc:"Annotate each node with month-to-date transaction totals"`
+}
+
+// CategoryTreeNode is a category with its nested children.
+type CategoryTreeNode struct {
+	Category
+	MonthToDate *string            `json:"monthToDate,omitempty" doc:"Net month-to-date transaction amount including all descendants, present when includeTotals is set"`
+	Budget      *string            `json:"budget,omitempty" doc:"Monthly budget of this category plus its listed descendants, absent when none of them is budgeted"`
+	Children    []CategoryTreeNode `json:"children" doc:"Child categories ordered by name"`
+}
+
+// CategoryTreeResponseBody is the response body for the category tree.
+type CategoryTreeResponseBody struct {
+	Categories []CategoryTreeNode `json:"categories" doc:"Root categories ordered by name"`
+}
+
+// CategoryTreeOutput is the Huma output for the category tree.
+type CategoryTreeOutput struct {
+	Body CategoryTreeResponseBody
+}
+
+type categoryTreeReader interface {
+	ListAll(ctx context.Context, filter *category.CategoryFilter) ([]*category.Category, error)
+}
+
+type categoryTotalsReader interface {
+	SumByCategory(ctx context.Context, from time.Time, to time.Time) (map[uuid.UUID]decimal.Decimal, error)
+}
+
+// CategoryTreeHandler handles GET /v1/categories/tree.
+type CategoryTreeHandler struct {
+	CategoryReader categoryTreeReader
+	TotalsReader   categoryTotalsReader
+	now            func() time.Time
+}
+
+// NewCategoryTreeHandler creates a new CategoryTreeHandler.
+func NewCategoryTreeHandler(categoryReader categoryTreeReader, totalsReader categoryTotalsReader) *CategoryTreeHandler {
+	return &CategoryTreeHandler{
+		CategoryReader: categoryReader,
+		TotalsReader:   totalsReader,
+		now:            time.Now,
+	}
+}
+
+// Register registers the category tree endpoint with the Huma API.
+func (h *CategoryTreeHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "category-tree",
+		Method:      http.MethodGet,
+		Path:        "/v1/categories/tree",
+		Summary:     "Get category tree",
+		Description: "Returns all categories nested under their parents with monthly budgets, and optionally month-to-date totals, rolled up from children.",
+		Tags:        []string{"Categories"},
+	}, h.handle)
+}
+
+func (h *CategoryTreeHandler) handle(ctx context.Context, input *CategoryTreeInput) (*CategoryTreeOutput, error) {
+	logData := logging.GetLogData(ctx)
+
+	var stopTimer func()
+	if logData != nil {
+		stopTimer = logData.AddTiming("listAllCategoriesMs")
+	}
+	categories, err := h.CategoryReader.ListAll(ctx, nil)
+	if stopTimer != nil {
+		stopTimer()
+	}
+	if err != nil {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to list categories", err)
+	}
+
+	var totals map[uuid.UUID]decimal.Decimal
+	if input.IncludeTotals {
+		now := h.now().UTC()
+		monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+		if logData != nil {
+			stopTimer = logData.AddTiming("sumByCategoryMs")
+		}
+		totals, err = h.TotalsReader.SumByCategory(ctx, monthStart, now)
+		if logData != nil {
+			stopTimer()
+		}
+		if err != nil {
+			return nil, huma.NewError(http.StatusInternalServerError, "failed to sum category totals", err)
+		}
+	}
+
+	if logData != nil {
+		logData.AddData("categoryCount", len(categories))
+	}
+
+	return &CategoryTreeOutput{
+		Body: CategoryTreeResponseBody{
+			Categories: buildCategoryTree(categories, totals, input.IncludeDisabled),
+		},
+	}, nil
+}
+
+// buildCategoryTree nests categories under their parents, preserving input
+// order among siblings. When totals is non-nil every node is annotated with
+// its own total plus those of all its descendants; rollups are computed over
+// the full hierarchy before disabled nodes are pruned so a parent's total
+// still reflects spend recorded against a since-disabled child. Budgets roll
+// up only from the children that are listed, since a disabled category no
+// longer plans any spend. Categories whose parent is missing are treated as
+// roots.
+func buildCategoryTree(categories []*category.Category, totals map[uuid.UUID]decimal.Decimal, includeDisabled bool) []CategoryTreeNode {
+	byID := make(map[uuid.UUID]*category.Category, len(categories))
+	for _, cat := range categories {
+		byID[cat.ID] = cat
+	}
+
+	children := make(map[uuid.UUID][]*category.Category)
+	var roots []*category.Category
+	for _, cat := range categories {
+		if cat.ParentCategoryID != nil {
+			if _, ok := byID[*cat.ParentCategoryID]; ok {
+				children[*cat.ParentCategoryID] = append(children[*cat.ParentCategoryID], cat)
+				continue
+			}
+		}
+		roots = append(roots, cat)
+	}
+
+	var build func(cat *category.Category, visiting map[uuid.UUID]bool) (CategoryTreeNode, decimal.Decimal, *decimal.Decimal)
+	build = func(cat *category.Category, visiting map[uuid.UUID]bool) (CategoryTreeNode, decimal.Decimal, *decimal.Decimal) {
+		visiting[cat.ID] = true
+		defer delete(visiting, cat.ID)
+
+		node := CategoryTreeNode{
+			Category: toAPICategory(cat),
+			Children: []CategoryTreeNode{},
+		}
+		total := totals[cat.ID]
+		budget := cat.MonthlyBudget
+		for _, child := range children[cat.ID] {
+			if visiting[child.ID] {
+				continue
+			}
+			childNode, childTotal, childBudget := build(child, visiting)
+			total = total.Add(childTotal)
+			if includeDisabled || !child.IsDisabled {
+				node.Children = append(node.Children, childNode)
+				budget = addBudgets(budget, childBudget)
+			}
+		}
+		if totals != nil {
+			s := total.String()
+			node.MonthToDate = &s
+		}
+		if budget != nil {
+			s := budget.String()
+			node.Budget = &s
+		}
+		return node, total, budget
+	}
+
+	result := []CategoryTreeNode{}
+	for _, root := range roots {
+		if !includeDisabled && root.IsDisabled {
+			continue
+		}
+		node, _, _ := build(root, map[uuid.UUID]bool{})
+		result = append(result, node)
+	}
+	return result
+}
+
+// addBudgets sums two optional budgets, returning nil only when both are nil.
+func addBudgets(a *decimal.Decimal, b *decimal.Decimal) *decimal.Decimal {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	sum := a.Add(*b)
+	return &sum
+}
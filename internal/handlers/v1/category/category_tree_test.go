@@ -0,0 +1,177 @@
+package category
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage/category"
+)
+
+type mockCategoryTreeReader struct {
+	mock.Mock
+}
+
+func (m *mockCategoryTreeReader) ListAll(ctx context.Context, filter *category.CategoryFilter) ([]*category.Category, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*category.Category), args.Error(1)
+}
+
+type mockCategoryTotalsReader struct {
+	mock.Mock
+}
+
+func (m *mockCategoryTotalsReader) SumByCategory(ctx context.Context, from time.Time, to time.Time) (map[uuid.UUID]decimal.Decimal, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID]decimal.Decimal), args.Error(1)
+}
+
+func newTestCategory(name string, parent *category.Category, disabled bool) *category.Category {
+	cat := &category.Category{
+		ID:         uuid.Must(uuid.NewV4()),
+		Name:       name,
+		IsParent:   parent == nil,
+		IsDisabled: disabled,
+	}
+	if parent != nil {
+		cat.ParentCategoryID = &parent.ID
+	}
+	return cat
+}
+
+func TestBuildCategoryTree_NestsChildren(t *testing.T) {
+	food := newTestCategory("Food", nil, false)
+	groceries := newTestCategory("Groceries", food, false)
+	restaurants := newTestCategory("Restaurants", food, false)
+	rent := newTestCategory("Rent", nil, false)
+
+	tree := buildCategoryTree([]*category.Category{food, groceries, rent, restaurants}, nil, false)
+
+	require.Len(t, tree, 2)
+	assert.Equal(t, "Food", tree[0].Name)
+	assert.Nil(t, tree[0].MonthToDate)
+	require.Len(t, tree[0].Children, 2)
+	assert.Equal(t, "Groceries", tree[0].Children[0].Name)
+	assert.Equal(t, "Restaurants", tree[0].Children[1].Name)
+	assert.Equal(t, "Rent", tree[1].Name)
+	assert.Empty(t, tree[1].Children)
+}
+
+func TestBuildCategoryTree_RollsUpTotals(t *testing.T) {
+	food := newTestCategory("Food", nil, false)
+	groceries := newTestCategory("Groceries", food, false)
+	restaurants := newTestCategory("Restaurants", food, true)
+	totals := map[uuid.UUID]decimal.Decimal{
+		groceries.ID:   decimal.RequireFromString("-40.25"),
+		restaurants.ID: decimal.RequireFromString("-10"),
+	}
+
+	tree := buildCategoryTree([]*category.Category{food, groceries, restaurants}, totals, false)
+
+	require.Len(t, tree, 1)
+	require.NotNil(t, tree[0].MonthToDate)
+	assert.Equal(t, "-50.25", *tree[0].MonthToDate)
+	require.Len(t, tree[0].Children, 1)
+	assert.Equal(t, "Groceries", tree[0].Children[0].Name)
+	assert.Equal(t, "-40.25", *tree[0].Children[0].MonthToDate)
+}
+
+func TestBuildCategoryTree_RollsUpBudgets(t *testing.T) {
+	food := newTestCategory("Food", nil, false)
+	groceries := newTestCategory("Groceries", food, false)
+	restaurants := newTestCategory("Restaurants", food, false)
+	takeaway := newTestCategory("Takeaway", food, true)
+	rent := newTestCategory("Rent", nil, false)
+	groceryBudget := decimal.RequireFromString("400")
+	restaurantBudget := decimal.RequireFromString("150.50")
+	takeawayBudget := decimal.RequireFromString("60")
+	groceries.MonthlyBudget = &groceryBudget
+	restaurants.MonthlyBudget = &restaurantBudget
+	takeaway.MonthlyBudget = &takeawayBudget
+
+	tree := buildCategoryTree([]*category.Category{food, groceries, restaurants, takeaway, rent}, nil, false)
+
+	require.Len(t, tree, 2)
+	require.NotNil(t, tree[0].Budget)
+	assert.Equal(t, "550.5", *tree[0].Budget)
+	require.Len(t, tree[0].Children, 2)
+	assert.Equal(t, "400", *tree[0].Children[0].Budget)
+	assert.Nil(t, tree[1].Budget)
+
+	tree = buildCategoryTree([]*category.Category{food, groceries, restaurants, takeaway, rent}, nil, true)
+	assert.Equal(t, "610.5", *tree[0].Budget)
+}
+
+func TestBuildCategoryTree_DisabledAndOrphans(t *testing.T) {
+	missingParent := newTestCategory("Missing", nil, false)
+	orphan := newTestCategory("Orphan", missingParent, false)
+	disabled := newTestCategory("Old", nil, true)
+
+	tree := buildCategoryTree([]*category.Category{disabled, orphan}, nil, false)
+	require.Len(t, tree, 1)
+	assert.Equal(t, "Orphan", tree[0].Name)
+
+	tree = buildCategoryTree([]*category.Category{disabled, orphan}, nil, true)
+	require.Len(t, tree, 2)
+	assert.Equal(t, "Old", tree[0].Name)
+}
+
+func TestHTTP_CategoryTree_WithTotals(t *testing.T) {
+	food := newTestCategory("Food", nil, false)
+	groceries := newTestCategory("Groceries", food, false)
+
+	categoryReader := &mockCategoryTreeReader{}
+	categoryReader.On("ListAll", mock.Anything, mock.Anything).
+		Return([]*category.Category{food, groceries}, nil)
+	totalsReader := &mockCategoryTotalsReader{}
+	now := time.Date(2025, 3, 17, 9, 30, 0, 0, time.UTC)
+	totalsReader.On("SumByCategory", mock.Anything, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), now).
+		Return(map[uuid.UUID]decimal.Decimal{groceries.ID: decimal.RequireFromString("-12.50")}, nil)
+
+	_, api := humatest.New(t)
+	handler := NewCategoryTreeHandler(categoryReader, totalsReader)
+	handler.now = func() time.Time { return now }
+	handler.Register(api)
+
+	resp := api.Get("/v1/categories/tree?includeTotals=true")
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	var body CategoryTreeResponseBody
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Categories, 1)
+	assert.Equal(t, "-12.5", *body.Categories[0].MonthToDate)
+	require.Len(t, body.Categories[0].Children, 1)
+	assert.Equal(t, groceries.ID.String(), body.Categories[0].Children[0].ID)
+	categoryReader.AssertExpectations(t)
+	totalsReader.AssertExpectations(t)
+}
+
+func TestHTTP_CategoryTree_WithoutTotals(t *testing.T) {
+	categoryReader := &mockCategoryTreeReader{}
+	categoryReader.On("ListAll", mock.Anything, mock.Anything).
+		Return([]*category.Category{}, nil)
+	totalsReader := &mockCategoryTotalsReader{}
+
+	_, api := humatest.New(t)
+	NewCategoryTreeHandler(categoryReader, totalsReader).Register(api)
+
+	resp := api.Get("/v1/categories/tree")
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	totalsReader.AssertNotCalled(t, "SumByCategory")
+}
@@ -7,7 +7,9 @@ import (
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
 
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
 	"github.com/carson-networks/budget-server/internal/operator"
 	"github.com/carson-networks/budget-server/internal/operator/actions"
 	"github.com/carson-networks/budget-server/internal/storage/category"
@@ -20,16 +22,20 @@ type CreateCategoryBody struct {
 	ParentCategoryID *string `json:"parentCategoryID,omitempty" doc:"Parent category UUID; required when isParent is false"`
 	IsDisabled       bool    `json:"isDisabled" doc:"Whether the category is disabled for new transactions"`
 	CategoryType     int     `json:"categoryType" doc:"Category direction: 0=Income, 1=Expense"`
+	MonthlyBudget    *string `json:"monthlyBudget,omitempty" doc:"Amount budgeted per month, e.g. \"250.00\"; omit for no budget"`
 }
 
 // CreateCategoryInput is the Huma input for creating a category.
 type CreateCategoryInput struct {
+	idempotent.Header
 	Body CreateCategoryBody
 }
 
 // CreateCategoryOutput is the Huma output for creating a category.
 type CreateCategoryOutput struct {
-	Status int `json:"status" doc:"HTTP status"`
+	Status   int    `json:"status" doc:"HTTP status"`
+	Location string `header:"Location" doc:"URL of the created category"`
+	Body     Category
 }
 
 // CreateCategoryHandler handles POST /v1/categories.
@@ -50,41 +56,76 @@ func (h *CreateCategoryHandler) Register(api huma.API) {
 		Method:      http.MethodPost,
 		Path:        "/v1/categories/create",
 		Summary:     "Create category",
-		Description: "Creates a new category.",
+		Description: "Creates a new category and returns it.",
 		Tags:        []string{"Categories"},
 	}, h.handle)
 }
 
 func (h *CreateCategoryHandler) handle(ctx context.Context, input *CreateCategoryInput) (*CreateCategoryOutput, error) {
+	action, err := input.Body.Action()
+	if err != nil {
+		return nil, err
+	}
+
+	return idempotent.Process(ctx, h.Operator, input.Header, "create-category", input.Body, action,
+		func(result any) *CreateCategoryOutput {
+			created := input.Body.Result(result).(Category)
+			return &CreateCategoryOutput{
+				Status:   http.StatusCreated,
+				Location: "/v1/categories/" + created.ID,
+				Body:     created,
+			}
+		},
+		input.Body.MapError)
+}
+
+// Action converts the request body into the operator action.
+func (b CreateCategoryBody) Action() (actions.IAction, error) {
 	var parentCatergoryID *uuid.UUID
-	if input.Body.ParentCategoryID != nil && *input.Body.ParentCategoryID != "" {
-		id, err := uuid.FromString(*input.Body.ParentCategoryID)
+	if b.ParentCategoryID != nil && *b.ParentCategoryID != "" {
+		id, err := uuid.FromString(*b.ParentCategoryID)
 		if err != nil {
 			return nil, huma.NewError(http.StatusBadRequest, "invalid ParentCategoryID", err)
 		}
 		parentCatergoryID = &id
 	}
 
-	action := &actions.CreateCategory{
-		Name:             input.Body.Name,
-		IsParent:         input.Body.IsParent,
-		ParentCategoryID: parentCatergoryID,
-		IsDisabled:       input.Body.IsDisabled,
-		CategoryType:     category.CategoryType(input.Body.CategoryType),
-	}
-
-	if err := h.Operator.Process(ctx, action); err != nil {
-		switch {
-		case errors.Is(err, actions.ErrStandaloneCategoryNotSupported):
-			return nil, huma.NewError(http.StatusBadRequest, "category must have parent; parentCategoryID is required for non-parent categories", err)
-		case errors.Is(err, actions.ErrParentCategoryNotFound):
-			return nil, huma.NewError(http.StatusNotFound, "parent category not found", err)
-		case errors.Is(err, actions.ErrParentCategoryIsNotParent):
-			return nil, huma.NewError(http.StatusBadRequest, "parent must be a parent category", err)
-		default:
-			return nil, huma.NewError(http.StatusInternalServerError, "failed to create category", err)
+	var monthlyBudget *decimal.Decimal
+	if b.MonthlyBudget != nil && *b.MonthlyBudget != "" {
+		budget, err := decimal.NewFromString(*b.MonthlyBudget)
+		if err != nil {
+			return nil, huma.NewError(http.StatusBadRequest, "invalid monthlyBudget", err)
 		}
+		monthlyBudget = &budget
 	}
 
-	return &CreateCategoryOutput{Status: http.StatusCreated}, nil
+	return &actions.CreateCategory{
+		Name:             b.Name,
+		IsParent:         b.IsParent,
+		ParentCategoryID: parentCatergoryID,
+		IsDisabled:       b.IsDisabled,
+		CategoryType:     category.CategoryType(b.CategoryType),
+		MonthlyBudget:    monthlyBudget,
+	}, nil
+}
+
+// Result converts the action's result into the API model.
+func (b CreateCategoryBody) Result(result any) any {
+	return toAPICategory(result.(*category.Category))
+}
+
+// MapError converts an error from processing the action into an API error.
+func (b CreateCategoryBody) MapError(err error) huma.StatusError {
+	switch {
+	case errors.Is(err, actions.ErrStandaloneCategoryNotSupported):
+		return huma.NewError(http.StatusBadRequest, "category must have parent; parentCategoryID is required for non-parent categories", err)
+	case errors.Is(err, actions.ErrParentCategoryNotFound):
+		return huma.NewError(http.StatusNotFound, "parent category not found", err)
+	case errors.Is(err, actions.ErrParentCategoryIsNotParent):
+		return huma.NewError(http.StatusBadRequest, "parent must be a parent category", err)
+	case errors.Is(err, actions.ErrNegativeMonthlyBudget):
+		return huma.NewError(http.StatusBadRequest, "monthlyBudget must not be negative", err)
+	default:
+		return huma.NewError(http.StatusInternalServerError, "failed to create category", err)
+	}
 }
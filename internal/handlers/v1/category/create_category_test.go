@@ -1,6 +1,7 @@
 package category
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/gofrs/uuid/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/carson-networks/budget-server/internal/operator"
 	"github.com/carson-networks/budget-server/internal/operator/actions"
@@ -23,6 +25,12 @@ func newCreateCategoryTestAPI(t *testing.T, op operator.IProcessor, reader categ
 
 func TestHTTP_CreateCategory_Success(t *testing.T) {
 	parentID := uuid.Must(uuid.NewV4())
+	created := &category.Category{
+		ID:               uuid.Must(uuid.NewV4()),
+		Name:             "Food",
+		ParentCategoryID: &parentID,
+		CategoryType:     category.CatergoryType_Expense,
+	}
 	mockOp := &operator.MockIProcessor{}
 	mockOp.EXPECT().
 		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
@@ -34,7 +42,7 @@ func TestHTTP_CreateCategory_Success(t *testing.T) {
 				*cc.ParentCategoryID == parentID &&
 				cc.CategoryType == category.CatergoryType_Expense
 		})).
-		Return(nil)
+		Return(created, nil)
 
 	parentIDStr := parentID.String()
 	resp := newCreateCategoryTestAPI(t, mockOp, &mockCategoryReader{}).Post("/v1/categories/create", CreateCategoryBody{
@@ -45,7 +53,12 @@ func TestHTTP_CreateCategory_Success(t *testing.T) {
 		CategoryType:     1,
 	})
 
-	assert.Equal(t, http.StatusCreated, resp.Code)
+	require.Equal(t, http.StatusCreated, resp.Code)
+	assert.Equal(t, "/v1/categories/"+created.ID.String(), resp.Header().Get("Location"))
+	var body Category
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, created.ID.String(), body.ID)
+	assert.Equal(t, parentIDStr, *body.ParentCategoryID)
 	mockOp.AssertExpectations(t)
 }
 
@@ -53,7 +66,7 @@ func TestHTTP_CreateCategory_MustHaveParent(t *testing.T) {
 	mockOp := &operator.MockIProcessor{}
 	mockOp.EXPECT().
 		Process(mock.Anything, mock.Anything).
-		Return(actions.ErrStandaloneCategoryNotSupported)
+		Return(nil, actions.ErrStandaloneCategoryNotSupported)
 
 	resp := newCreateCategoryTestAPI(t, mockOp, &mockCategoryReader{}).Post("/v1/categories/create", CreateCategoryBody{
 		Name:             "Leaf",
@@ -70,7 +83,7 @@ func TestHTTP_CreateCategory_ParentNotFound(t *testing.T) {
 	mockOp := &operator.MockIProcessor{}
 	mockOp.EXPECT().
 		Process(mock.Anything, mock.Anything).
-		Return(actions.ErrParentCategoryNotFound)
+		Return(nil, actions.ErrParentCategoryNotFound)
 
 	parentID := uuid.Must(uuid.NewV4()).String()
 	resp := newCreateCategoryTestAPI(t, mockOp, &mockCategoryReader{}).Post("/v1/categories/create", CreateCategoryBody{
@@ -88,7 +101,7 @@ func TestHTTP_CreateCategory_ParentMustBeParent(t *testing.T) {
 	mockOp := &operator.MockIProcessor{}
 	mockOp.EXPECT().
 		Process(mock.Anything, mock.Anything).
-		Return(actions.ErrParentCategoryIsNotParent)
+		Return(nil, actions.ErrParentCategoryIsNotParent)
 
 	parentID := uuid.Must(uuid.NewV4()).String()
 	resp := newCreateCategoryTestAPI(t, mockOp, &mockCategoryReader{}).Post("/v1/categories/create", CreateCategoryBody{
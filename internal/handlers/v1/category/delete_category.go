@@ -0,0 +1,93 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+)
+
+// DeleteCategoryInput is the Huma input for deleting a category.
+type DeleteCategoryInput struct {
+	idempotent.Header
+	ID string `path:"id" doc:"Category UUID"`
+}
+
+// DeleteCategoryOutput is the Huma output for deleting a category.
+type DeleteCategoryOutput struct {
+	Status int `json:"status" doc:"HTTP status"`
+}
+
+// DeleteCategoryHandler handles DELETE /v1/categories/delete/{id}.
+type DeleteCategoryHandler struct {
+	Operator operator.IProcessor
+}
+
+// NewDeleteCategoryHandler creates a new DeleteCategoryHandler.
+func NewDeleteCategoryHandler(op operator.IProcessor) *DeleteCategoryHandler {
+	return &DeleteCategoryHandler{Operator: op}
+}
+
+// Register registers the delete category endpoint with the Huma API.
+func (h *DeleteCategoryHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-category",
+		Method:      http.MethodDelete,
+		Path:        "/v1/categories/delete/{id}",
+		Summary:     "Delete category",
+		Description: "Deletes a category that has no children and no transactions.",
+		Tags:        []string{"Categories"},
+	}, h.handle)
+}
+
+func (h *DeleteCategoryHandler) handle(ctx context.Context, input *DeleteCategoryInput) (*DeleteCategoryOutput, error) {
+	item := DeleteCategoryItem{ID: input.ID}
+	action, err := item.Action()
+	if err != nil {
+		return nil, err
+	}
+
+	return idempotent.Process(ctx, h.Operator, input.Header, "delete-category", item, action,
+		func(any) *DeleteCategoryOutput { return &DeleteCategoryOutput{Status: http.StatusOK} },
+		item.MapError)
+}
+
+// DeleteCategoryItem identifies the category to delete, for callers such as
+// batches that have no path parameters.
+type DeleteCategoryItem struct {
+	ID string `json:"id" required:"true" doc:"Category UUID"`
+}
+
+// Action converts the delete into the operator action.
+func (d DeleteCategoryItem) Action() (actions.IAction, error) {
+	id, err := uuid.FromString(d.ID)
+	if err != nil {
+		return nil, huma.NewError(http.StatusBadRequest, "invalid category id", err)
+	}
+	return &actions.DeleteCategory{ID: id}, nil
+}
+
+// Result returns nil; deleting a category has no result.
+func (d DeleteCategoryItem) Result(any) any {
+	return nil
+}
+
+// MapError converts an error from processing the action into an API error.
+func (d DeleteCategoryItem) MapError(err error) huma.StatusError {
+	switch {
+	case errors.Is(err, actions.ErrCategoryNotFound):
+		return huma.NewError(http.StatusNotFound, "category not found", err)
+	case errors.Is(err, actions.ErrCategoryHasChildren):
+		return huma.NewError(http.StatusConflict, "category has child categories", err)
+	case errors.Is(err, actions.ErrCategoryHasTransactions):
+		return huma.NewError(http.StatusConflict, "category has transactions; merge it into another category instead", err)
+	default:
+		return huma.NewError(http.StatusInternalServerError, "failed to delete category", err)
+	}
+}
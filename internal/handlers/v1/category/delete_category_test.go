@@ -0,0 +1,64 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+)
+
+func TestDeleteCategoryHandler_Success(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			dc, ok := a.(*actions.DeleteCategory)
+			return ok && dc.ID == id
+		})).
+		Return(nil, nil)
+
+	h := NewDeleteCategoryHandler(mockOp)
+	out, err := h.handle(context.Background(), &DeleteCategoryInput{ID: id.String()})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, out.Status)
+	mockOp.AssertExpectations(t)
+}
+
+func TestDeleteCategoryHandler_InUse(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, actions.ErrCategoryHasTransactions)
+
+	h := NewDeleteCategoryHandler(mockOp)
+	out, err := h.handle(context.Background(), &DeleteCategoryInput{ID: uuid.Must(uuid.NewV4()).String()})
+	assert.Nil(t, out)
+	var statusErr huma.StatusError
+	assert.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusConflict, statusErr.GetStatus())
+}
+
+func TestHTTP_DeleteCategory_BindsPathID(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			dc, ok := a.(*actions.DeleteCategory)
+			return ok && dc.ID == id
+		})).
+		Return(nil, nil)
+
+	_, api := humatest.New(t)
+	NewDeleteCategoryHandler(mockOp).Register(api)
+	resp := api.Delete("/v1/categories/delete/" + id.String())
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockOp.AssertExpectations(t)
+}
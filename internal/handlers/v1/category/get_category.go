@@ -0,0 +1,60 @@
+package category
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+)
+
+// GetCategoryInput is the Huma input for fetching a category.
+type GetCategoryInput struct {
+	ID string `path:"id" doc:"Category UUID"`
+}
+
+// GetCategoryOutput is the Huma output for fetching a category.
+type GetCategoryOutput struct {
+	Body Category
+}
+
+// GetCategoryHandler handles GET /v1/categories/{id}.
+type GetCategoryHandler struct {
+	CategoryReader categoryReader
+}
+
+// NewGetCategoryHandler creates a new GetCategoryHandler.
+func NewGetCategoryHandler(reader categoryReader) *GetCategoryHandler {
+	return &GetCategoryHandler{CategoryReader: reader}
+}
+
+// Register registers the get category endpoint with the Huma API.
+func (h *GetCategoryHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-category",
+		Method:      http.MethodGet,
+		Path:        "/v1/categories/{id}",
+		Summary:     "Get category",
+		Description: "Returns a single category by ID.",
+		Tags:        []string{"Categories"},
+	}, h.handle)
+}
+
+func (h *GetCategoryHandler) handle(ctx context.Context, input *GetCategoryInput) (*GetCategoryOutput, error) {
+	id, err := uuid.FromString(input.ID)
+	if err != nil {
+		return nil, huma.NewError(http.StatusBadRequest, "invalid category ID", err)
+	}
+
+	cat, err := h.CategoryReader.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, huma.NewError(http.StatusNotFound, "category not found", err)
+		}
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to get category", err)
+	}
+
+	return &GetCategoryOutput{Body: toAPICategory(cat)}, nil
+}
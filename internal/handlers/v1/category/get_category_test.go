@@ -0,0 +1,49 @@
+package category
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage/category"
+)
+
+func newGetCategoryTestAPI(t *testing.T, reader categoryReader) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	NewGetCategoryHandler(reader).Register(api)
+	return api
+}
+
+func TestHTTP_GetCategory_Success(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockReader := &mockCategoryReader{}
+	mockReader.On("GetByID", mock.Anything, id).
+		Return(&category.Category{ID: id, Name: "Food", IsParent: true}, nil)
+
+	resp := newGetCategoryTestAPI(t, mockReader).Get("/v1/categories/" + id.String())
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	var body Category
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, id.String(), body.ID)
+	assert.True(t, body.IsParent)
+	mockReader.AssertExpectations(t)
+}
+
+func TestHTTP_GetCategory_NotFound(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockReader := &mockCategoryReader{}
+	mockReader.On("GetByID", mock.Anything, id).Return(nil, sql.ErrNoRows)
+
+	resp := newGetCategoryTestAPI(t, mockReader).Get("/v1/categories/" + id.String())
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/gofrs/uuid/v5"
 
 	"github.com/carson-networks/budget-server/internal/logging"
+	"github.com/carson-networks/budget-server/internal/pagination"
 	"github.com/carson-networks/budget-server/internal/storage/category"
 )
 
@@ -20,22 +21,32 @@ type Category struct {
 	ParentCategoryID *string `json:"ParentCategoryID,omitempty" doc:"Parent category UUID for non-root"`
 	IsDisabled       bool    `json:"isDisabled" doc:"Whether the category is disabled for new transactions"`
 	CategoryType     int     `json:"categoryType" doc:"Category direction: 0=Income, 1=Expense"`
+	MonthlyBudget    *string `json:"monthlyBudget,omitempty" doc:"Amount budgeted per month for this category itself, absent when unbudgeted"`
 	CreatedAt        string  `json:"createdAt" doc:"RFC3339 creation timestamp"`
 }
 
+// categoriesCursorScope binds category list cursors so they cannot be replayed against other listings.
+const categoriesCursorScope = "categories"
+
 // ListCategoriesInput is the Huma input for listing categories.
+// A cursor is only valid with the same filters it was issued for.
 type ListCategoriesInput struct {
-	Position int `query:"position" minimum:"0" doc:"Offset for pagination"`
-	Limit    int `query:"limit" minimum:"1" maximum:"100" doc:"Page size, default 20"`
+	Cursor     string `query:"cursor" doc:"Opaque cursor from a previous response to fetch the next page"`
+	Limit      int    `query:"limit" minimum:"1" maximum:"100" doc:"Page size, default 20; ignored when a cursor is given"`
+	ParentID   string `query:"parentID" format:"uuid" doc:"Only return children of this parent category"`
+	IsDisabled string `query:"isDisabled" enum:"true,false" doc:"Only return enabled (false) or disabled (true) categories"`
+}
+
+// listCategoriesFilter is the part of the request a cursor is bound to.
+type listCategoriesFilter struct {
+	ParentID   string `json:"parentID"`
+	IsDisabled string `json:"isDisabled"`
 }
 
 // ListCategoriesResponseBody is the response body for listing categories.
 type ListCategoriesResponseBody struct {
 	Categories []Category `json:"categories" doc:"Page of categories"`
-	NextCursor *struct {
-		Position int `json:"position" doc:"Offset for next page"`
-		Limit    int `json:"limit" doc:"Page size"`
-	} `json:"nextCursor,omitempty" doc:"Cursor to fetch the next page, absent on the last page"`
+	NextCursor string     `json:"nextCursor,omitempty" doc:"Opaque cursor to fetch the next page, absent on the last page"`
 }
 
 // ListCategoriesOutput is the Huma output for listing categories.
@@ -51,11 +62,12 @@ type categoryReader interface {
 // ListCategoriesHandler handles GET /v1/categories.
 type ListCategoriesHandler struct {
 	CategoryReader categoryReader
+	Cursors        *pagination.Codec
 }
 
 // NewListCategoriesHandler creates a new ListCategoriesHandler.
-func NewListCategoriesHandler(reader categoryReader) *ListCategoriesHandler {
-	return &ListCategoriesHandler{CategoryReader: reader}
+func NewListCategoriesHandler(reader categoryReader, cursors *pagination.Codec) *ListCategoriesHandler {
+	return &ListCategoriesHandler{CategoryReader: reader, Cursors: cursors}
 }
 
 // Register registers the list categories endpoint with the Huma API.
@@ -65,7 +77,7 @@ func (h *ListCategoriesHandler) Register(api huma.API) {
 		Method:      http.MethodGet,
 		Path:        "/v1/categories",
 		Summary:     "List categories",
-		Description: "Returns a paginated list of categories.",
+		Description: "Returns a paginated list of categories ordered by name using keyset cursors.",
 		Tags:        []string{"Categories"},
 	}, h.handle)
 }
@@ -73,13 +85,34 @@ func (h *ListCategoriesHandler) Register(api huma.API) {
 func (h *ListCategoriesHandler) handle(ctx context.Context, input *ListCategoriesInput) (*ListCategoriesOutput, error) {
 	logData := logging.GetLogData(ctx)
 
-	limit := input.Limit
-	if limit == 0 {
-		limit = 20
-	}
 	filter := &category.CategoryFilter{
-		Limit:  limit,
-		Offset: input.Position,
+		Limit: pagination.Limit(input.Limit),
+	}
+	if input.ParentID != "" {
+		parentID, err := uuid.FromString(input.ParentID)
+		if err != nil {
+			return nil, huma.NewError(http.StatusBadRequest, "invalid parentID", err)
+		}
+		filter.ParentID = &parentID
+	}
+	if input.IsDisabled != "" {
+		isDisabled := input.IsDisabled == "true"
+		filter.IsDisabled = &isDisabled
+	}
+	filterHash, err := pagination.FilterHash(listCategoriesFilter{
+		ParentID:   input.ParentID,
+		IsDisabled: input.IsDisabled,
+	})
+	if err != nil {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to hash filter", err)
+	}
+	if input.Cursor != "" {
+		cursor, err := pagination.Decode[pagination.NameKey](h.Cursors, categoriesCursorScope, filterHash, input.Cursor)
+		if err != nil {
+			return nil, huma.NewError(http.StatusBadRequest, "invalid cursor", err)
+		}
+		filter.Limit = cursor.Limit
+		filter.After = &cursor.After
 	}
 
 	var stopTimer func()
@@ -108,30 +141,35 @@ func (h *ListCategoriesHandler) handle(ctx context.Context, input *ListCategorie
 	}
 
 	for i, cat := range categories {
-		apiCat := Category{
-			ID:           cat.ID.String(),
-			Name:         cat.Name,
-			IsParent:     cat.IsParent,
-			IsDisabled:   cat.IsDisabled,
-			CategoryType: int(cat.CategoryType),
-			CreatedAt:    cat.CreatedAt.Format(time.RFC3339),
-		}
-		if cat.ParentCategoryID != nil {
-			s := cat.ParentCategoryID.String()
-			apiCat.ParentCategoryID = &s
-		}
-		resp.Categories[i] = apiCat
+		resp.Categories[i] = toAPICategory(cat)
 	}
 
 	if result.NextCursor != nil {
-		resp.NextCursor = &struct {
-			Position int `json:"position" doc:"Offset for next page"`
-			Limit    int `json:"limit" doc:"Page size"`
-		}{
-			Position: result.NextCursor.Position,
-			Limit:    result.NextCursor.Limit,
+		resp.NextCursor, err = pagination.Encode(h.Cursors, categoriesCursorScope, filterHash, result.NextCursor)
+		if err != nil {
+			return nil, huma.NewError(http.StatusInternalServerError, "failed to encode cursor", err)
 		}
 	}
 
 	return &ListCategoriesOutput{Body: resp}, nil
 }
+
+func toAPICategory(cat *category.Category) Category {
+	apiCat := Category{
+		ID:           cat.ID.String(),
+		Name:         cat.Name,
+		IsParent:     cat.IsParent,
+		IsDisabled:   cat.IsDisabled,
+		CategoryType: int(cat.CategoryType),
+		CreatedAt:    cat.CreatedAt.Format(time.RFC3339),
+	}
+	if cat.ParentCategoryID != nil {
+		s := cat.ParentCategoryID.String()
+		apiCat.ParentCategoryID = &s
+	}
+	if cat.MonthlyBudget != nil {
+		s := cat.MonthlyBudget.String()
+		apiCat.MonthlyBudget = &s
+	}
+	return apiCat
+}
@@ -2,6 +2,7 @@ package category
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/carson-networks/budget-server/internal/pagination"
 	"github.com/carson-networks/budget-server/internal/storage/category"
 )
 
@@ -34,10 +36,12 @@ func (m *mockCategoryReader) GetByID(ctx context.Context, id uuid.UUID) (*catego
 	return args.Get(0).(*category.Category), args.Error(1)
 }
 
+var testCursors = pagination.NewCodec([]byte("test-key"))
+
 func newListCategoriesTestAPI(t *testing.T, reader categoryReader) humatest.TestAPI {
 	t.Helper()
 	_, api := humatest.New(t)
-	NewListCategoriesHandler(reader).Register(api)
+	NewListCategoriesHandler(reader, testCursors).Register(api)
 	return api
 }
 
@@ -58,7 +62,7 @@ func TestHTTP_ListCategories_NonEmpty(t *testing.T) {
 	now := time.Now()
 	mockReader := &mockCategoryReader{}
 	mockReader.On("List", mock.Anything, mock.MatchedBy(func(f *category.CategoryFilter) bool {
-		return f != nil && f.Limit == 20 && f.Offset == 0
+		return f != nil && f.Limit == 20 && f.After == nil
 	})).
 		Return(&category.CategoryListResult{
 			Categories: []*category.Category{
@@ -80,3 +84,82 @@ func TestHTTP_ListCategories_NonEmpty(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.Code)
 	mockReader.AssertExpectations(t)
 }
+
+func TestHTTP_ListCategories_NextCursorRoundTrip(t *testing.T) {
+	lastID := uuid.Must(uuid.NewV4())
+	mockReader := &mockCategoryReader{}
+	mockReader.On("List", mock.Anything, mock.MatchedBy(func(f *category.CategoryFilter) bool {
+		return f != nil && f.After == nil
+	})).
+		Return(&category.CategoryListResult{
+			Categories: []*category.Category{{ID: lastID, Name: "Food"}},
+			NextCursor: &pagination.Cursor[pagination.NameKey]{
+				After: pagination.NameKey{Name: "Food", ID: lastID},
+				Limit: 1,
+			},
+		}, nil).Once()
+	mockReader.On("List", mock.Anything, mock.MatchedBy(func(f *category.CategoryFilter) bool {
+		return f != nil && f.Limit == 1 && f.After != nil && f.After.Name == "Food" && f.After.ID == lastID
+	})).
+		Return(&category.CategoryListResult{}, nil).Once()
+
+	api := newListCategoriesTestAPI(t, mockReader)
+	resp := api.Get("/v1/categories?limit=1")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var body ListCategoriesResponseBody
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.NotEmpty(t, body.NextCursor)
+
+	resp = api.Get("/v1/categories?cursor=" + body.NextCursor)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockReader.AssertExpectations(t)
+}
+
+func TestHTTP_ListCategories_CursorFromOtherFilter(t *testing.T) {
+	lastID := uuid.Must(uuid.NewV4())
+	mockReader := &mockCategoryReader{}
+	mockReader.On("List", mock.Anything, mock.Anything).
+		Return(&category.CategoryListResult{
+			Categories: []*category.Category{{ID: lastID, Name: "Food"}},
+			NextCursor: &pagination.Cursor[pagination.NameKey]{
+				After: pagination.NameKey{Name: "Food", ID: lastID},
+				Limit: 1,
+			},
+		}, nil).Once()
+
+	api := newListCategoriesTestAPI(t, mockReader)
+	resp := api.Get("/v1/categories?limit=1&isDisabled=false")
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	var body ListCategoriesResponseBody
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.NotEmpty(t, body.NextCursor)
+
+	resp = api.Get("/v1/categories?isDisabled=true&cursor=" + body.NextCursor)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockReader.AssertExpectations(t)
+}
+
+func TestHTTP_ListCategories_InvalidCursor(t *testing.T) {
+	mockReader := &mockCategoryReader{}
+
+	resp := newListCategoriesTestAPI(t, mockReader).Get("/v1/categories?cursor=bogus")
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockReader.AssertNotCalled(t, "List")
+}
+
+func TestHTTP_ListCategories_Filters(t *testing.T) {
+	parentID := uuid.Must(uuid.NewV4())
+	mockReader := &mockCategoryReader{}
+	mockReader.On("List", mock.Anything, mock.MatchedBy(func(f *category.CategoryFilter) bool {
+		return f != nil && f.ParentID != nil && *f.ParentID == parentID && f.IsDisabled != nil && !*f.IsDisabled
+	})).
+		Return(&category.CategoryListResult{}, nil)
+
+	resp := newListCategoriesTestAPI(t, mockReader).Get("/v1/categories?parentID=" + parentID.String() + "&isDisabled=false")
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockReader.AssertExpectations(t)
+}
@@ -0,0 +1,108 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+)
+
+// MergeCategoriesBody is the request body for merging categories.
+type MergeCategoriesBody struct {
+	SourceCategoryID string `json:"sourceCategoryID" required:"true" doc:"Category UUID to merge and delete"`
+	TargetCategoryID string `json:"targetCategoryID" required:"true" doc:"Enabled leaf category UUID that receives the source's transactions"`
+}
+
+// MergeCategoriesInput is the Huma input for merging categories.
+type MergeCategoriesInput struct {
+	idempotent.Header
+	Body MergeCategoriesBody
+}
+
+// MergeCategoriesOutput is the Huma output for merging categories.
+type MergeCategoriesOutput struct {
+	Status int `json:"status" doc:"HTTP status"`
+}
+
+// MergeCategoriesHandler handles POST /v1/categories/merge.
+type MergeCategoriesHandler struct {
+	Operator operator.IProcessor
+}
+
+// NewMergeCategoriesHandler creates a new MergeCategoriesHandler.
+func NewMergeCategoriesHandler(op operator.IProcessor) *MergeCategoriesHandler {
+	return &MergeCategoriesHandler{Operator: op}
+}
+
+// Register registers the merge categories endpoint with the Huma API.
+func (h *MergeCategoriesHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "merge-categories",
+		Method:      http.MethodPost,
+		Path:        "/v1/categories/merge",
+		Summary:     "Merge categories",
+		Description: "Moves all transactions from the source category to the target category and deletes the source.",
+		Tags:        []string{"Categories"},
+	}, h.handle)
+}
+
+func (h *MergeCategoriesHandler) handle(ctx context.Context, input *MergeCategoriesInput) (*MergeCategoriesOutput, error) {
+	action, err := input.Body.Action()
+	if err != nil {
+		return nil, err
+	}
+
+	return idempotent.Process(ctx, h.Operator, input.Header, "merge-categories", input.Body, action,
+		func(any) *MergeCategoriesOutput { return &MergeCategoriesOutput{Status: http.StatusOK} },
+		input.Body.MapError)
+}
+
+// Action converts the request body into the operator action.
+func (b MergeCategoriesBody) Action() (actions.IAction, error) {
+	sourceID, err := uuid.FromString(b.SourceCategoryID)
+	if err != nil {
+		return nil, huma.NewError(http.StatusBadRequest, "invalid sourceCategoryID", err)
+	}
+	targetID, err := uuid.FromString(b.TargetCategoryID)
+	if err != nil {
+		return nil, huma.NewError(http.StatusBadRequest, "invalid targetCategoryID", err)
+	}
+
+	return &actions.MergeCategories{
+		SourceID: sourceID,
+		TargetID: targetID,
+	}, nil
+}
+
+// Result returns nil; merging categories has no result.
+func (b MergeCategoriesBody) Result(any) any {
+	return nil
+}
+
+// MapError converts an error from processing the action into an API error.
+func (b MergeCategoriesBody) MapError(err error) huma.StatusError {
+	switch {
+	case errors.Is(err, actions.ErrMergeSameCategory):
+		return huma.NewError(http.StatusBadRequest, "source and target category must differ", err)
+	case errors.Is(err, actions.ErrCategoryNotFound):
+		return huma.NewError(http.StatusNotFound, "source category not found", err)
+	case errors.Is(err, actions.ErrMergeTargetNotFound):
+		return huma.NewError(http.StatusNotFound, "target category not found", err)
+	case errors.Is(err, actions.ErrCategoryDisabled):
+		return huma.NewError(http.StatusBadRequest, "target category is disabled", err)
+	case errors.Is(err, actions.ErrCategoryIsParent):
+		return huma.NewError(http.StatusBadRequest, "target category is a parent; merge into a child category", err)
+	case errors.Is(err, actions.ErrMergeTypeMismatch):
+		return huma.NewError(http.StatusBadRequest, "source and target category must have the same type", err)
+	case errors.Is(err, actions.ErrCategoryHasChildren):
+		return huma.NewError(http.StatusConflict, "source category has child categories", err)
+	default:
+		return huma.NewError(http.StatusInternalServerError, "failed to merge categories", err)
+	}
+}
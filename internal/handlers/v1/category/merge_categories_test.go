@@ -0,0 +1,75 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+)
+
+func TestMergeCategoriesHandler_Success(t *testing.T) {
+	sourceID := uuid.Must(uuid.NewV4())
+	targetID := uuid.Must(uuid.NewV4())
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			mc, ok := a.(*actions.MergeCategories)
+			return ok && mc.SourceID == sourceID && mc.TargetID == targetID
+		})).
+		Return(nil, nil)
+
+	h := NewMergeCategoriesHandler(mockOp)
+	out, err := h.handle(context.Background(), &MergeCategoriesInput{
+		Body: MergeCategoriesBody{SourceCategoryID: sourceID.String(), TargetCategoryID: targetID.String()},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, out.Status)
+	mockOp.AssertExpectations(t)
+}
+
+func TestMergeCategoriesHandler_InvalidID(t *testing.T) {
+	h := NewMergeCategoriesHandler(&operator.MockIProcessor{})
+	out, err := h.handle(context.Background(), &MergeCategoriesInput{
+		Body: MergeCategoriesBody{SourceCategoryID: "nope", TargetCategoryID: uuid.Must(uuid.NewV4()).String()},
+	})
+	assert.Nil(t, out)
+	var statusErr huma.StatusError
+	assert.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusBadRequest, statusErr.GetStatus())
+}
+
+func TestMergeCategoriesHandler_ErrorMapping(t *testing.T) {
+	cases := map[error]int{
+		actions.ErrMergeSameCategory:   http.StatusBadRequest,
+		actions.ErrCategoryNotFound:    http.StatusNotFound,
+		actions.ErrMergeTargetNotFound: http.StatusNotFound,
+		actions.ErrCategoryDisabled:    http.StatusBadRequest,
+		actions.ErrCategoryIsParent:    http.StatusBadRequest,
+		actions.ErrMergeTypeMismatch:   http.StatusBadRequest,
+		actions.ErrCategoryHasChildren: http.StatusConflict,
+		errors.New("boom"):             http.StatusInternalServerError,
+	}
+	for actionErr, status := range cases {
+		mockOp := &operator.MockIProcessor{}
+		mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, actionErr)
+
+		h := NewMergeCategoriesHandler(mockOp)
+		_, err := h.handle(context.Background(), &MergeCategoriesInput{
+			Body: MergeCategoriesBody{
+				SourceCategoryID: uuid.Must(uuid.NewV4()).String(),
+				TargetCategoryID: uuid.Must(uuid.NewV4()).String(),
+			},
+		})
+		var statusErr huma.StatusError
+		assert.True(t, errors.As(err, &statusErr))
+		assert.Equal(t, status, statusErr.GetStatus())
+	}
+}
@@ -2,35 +2,37 @@ package category
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
 
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
 	"github.com/carson-networks/budget-server/internal/operator"
 	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/category"
 )
 
-// UpdateCategoryPath is the path parameters for updating a category.
-type UpdateCategoryPath struct {
-	ID string `path:"id" doc:"Category UUID"`
-}
-
 // UpdateCategoryBody is the request body for updating a category.
 type UpdateCategoryBody struct {
 	Name             *string `json:"name,omitempty" doc:"Category name"`
 	ParentCategoryID *string `json:"parentCategoryID,omitempty" doc:"Parent category UUID"`
 	IsDisabled       *bool   `json:"isDisabled,omitempty" doc:"Whether the category is disabled for new transactions"`
+	MonthlyBudget    *string `json:"monthlyBudget,omitempty" doc:"Amount budgeted per month, e.g. \"250.00\"; an empty string removes the budget"`
 }
 
 // UpdateCategoryInput is the Huma input for updating a category.
 type UpdateCategoryInput struct {
-	Path UpdateCategoryPath
+	idempotent.Header
+	ID   string `path:"id" doc:"Category UUID"`
 	Body UpdateCategoryBody
 }
 
 // UpdateCategoryOutput is the Huma output for updating a category.
 type UpdateCategoryOutput struct {
+	Body Category
 }
 
 // UpdateCategoryHandler handles PATCH /v1/categories/{id}.
@@ -51,20 +53,42 @@ func (h *UpdateCategoryHandler) Register(api huma.API) {
 		Method:      http.MethodPatch,
 		Path:        "/v1/categories/update/{id}",
 		Summary:     "Update category",
-		Description: "Updates an existing category.",
+		Description: "Updates an existing category and returns it.",
 		Tags:        []string{"Categories"},
 	}, h.handle)
 }
 
 func (h *UpdateCategoryHandler) handle(ctx context.Context, input *UpdateCategoryInput) (*UpdateCategoryOutput, error) {
-	id, err := uuid.FromString(input.Path.ID)
+	item := UpdateCategoryItem{ID: input.ID, UpdateCategoryBody: input.Body}
+	action, err := item.Action()
+	if err != nil {
+		return nil, err
+	}
+
+	return idempotent.Process(ctx, h.Operator, input.Header, "update-category", item, action,
+		func(result any) *UpdateCategoryOutput {
+			return &UpdateCategoryOutput{Body: item.Result(result).(Category)}
+		},
+		item.MapError)
+}
+
+// UpdateCategoryItem is an update request with the category id alongside the
+// body, for callers such as batches that have no path parameters.
+type UpdateCategoryItem struct {
+	ID string `json:"id" required:"true" doc:"Category UUID"`
+	UpdateCategoryBody
+}
+
+// Action converts the update into the operator action.
+func (u UpdateCategoryItem) Action() (actions.IAction, error) {
+	id, err := uuid.FromString(u.ID)
 	if err != nil {
 		return nil, huma.NewError(http.StatusBadRequest, "invalid category id", err)
 	}
 
 	var parentCategoryID *uuid.UUID
-	if input.Body.ParentCategoryID != nil && *input.Body.ParentCategoryID != "" {
-		pid, err := uuid.FromString(*input.Body.ParentCategoryID)
+	if u.ParentCategoryID != nil && *u.ParentCategoryID != "" {
+		pid, err := uuid.FromString(*u.ParentCategoryID)
 		if err != nil {
 			return nil, huma.NewError(http.StatusBadRequest, "invalid parentCategoryID", err)
 		}
@@ -73,23 +97,41 @@ func (h *UpdateCategoryHandler) handle(ctx context.Context, input *UpdateCategor
 
 	action := &actions.UpdateCategory{
 		ID:               id,
-		Name:             input.Body.Name,
+		Name:             u.Name,
 		ParentCategoryID: parentCategoryID,
-		IsDisabled:       input.Body.IsDisabled,
+		IsDisabled:       u.IsDisabled,
 	}
-
-	if err := h.Operator.Process(ctx, action); err != nil {
-		switch {
-		case err == actions.ErrCategoryNotFound:
-			return nil, huma.NewError(http.StatusNotFound, "category not found", err)
-		case err == actions.ErrParentCategoryNotFound:
-			return nil, huma.NewError(http.StatusNotFound, "parent category not found", err)
-		case err == actions.ErrSpecifiedCategoryParentIsNotParent:
-			return nil, huma.NewError(http.StatusBadRequest, "specified category parent is not a parent category", err)
-		default:
-			return nil, huma.NewError(http.StatusInternalServerError, "failed to update category", err)
+	if u.MonthlyBudget != nil {
+		if *u.MonthlyBudget == "" {
+			action.ClearMonthlyBudget = true
+		} else {
+			budget, err := decimal.NewFromString(*u.MonthlyBudget)
+			if err != nil {
+				return nil, huma.NewError(http.StatusBadRequest, "invalid monthlyBudget", err)
+			}
+			action.MonthlyBudget = &budget
 		}
 	}
+	return action, nil
+}
 
-	return &UpdateCategoryOutput{}, nil
+// Result converts the action's result into the API model.
+func (u UpdateCategoryItem) Result(result any) any {
+	return toAPICategory(result.(*category.Category))
+}
+
+// MapError converts an error from processing the action into an API error.
+func (u UpdateCategoryItem) MapError(err error) huma.StatusError {
+	switch {
+	case errors.Is(err, actions.ErrCategoryNotFound):
+		return huma.NewError(http.StatusNotFound, "category not found", err)
+	case errors.Is(err, actions.ErrParentCategoryNotFound):
+		return huma.NewError(http.StatusNotFound, "parent category not found", err)
+	case errors.Is(err, actions.ErrSpecifiedCategoryParentIsNotParent):
+		return huma.NewError(http.StatusBadRequest, "specified category parent is not a parent category", err)
+	case errors.Is(err, actions.ErrNegativeMonthlyBudget):
+		return huma.NewError(http.StatusBadRequest, "monthlyBudget must not be negative", err)
+	default:
+		return huma.NewError(http.StatusInternalServerError, "failed to update category", err)
+	}
 }
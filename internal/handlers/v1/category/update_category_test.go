@@ -10,15 +10,17 @@ import (
 	"github.com/gofrs/uuid/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/carson-networks/budget-server/internal/operator"
 	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/category"
 )
 
 func TestUpdateCategoryHandler_InvalidID(t *testing.T) {
 	h := NewUpdateCategoryHandler(&operator.MockIProcessor{}, &mockCategoryReader{})
 	out, err := h.handle(context.Background(), &UpdateCategoryInput{
-		Path: UpdateCategoryPath{ID: "not-a-uuid"},
+		ID:   "not-a-uuid",
 		Body: UpdateCategoryBody{},
 	})
 	assert.Nil(t, out)
@@ -37,15 +39,16 @@ func TestUpdateCategoryHandler_Success(t *testing.T) {
 			uc, ok := a.(*actions.UpdateCategory)
 			return ok && uc.ID == id && uc.Name != nil && *uc.Name == newName && uc.ParentCategoryID == nil && uc.IsDisabled == nil
 		})).
-		Return(nil)
+		Return(&category.Category{ID: id, Name: newName}, nil)
 
 	h := NewUpdateCategoryHandler(mockOp, &mockCategoryReader{})
 	out, err := h.handle(context.Background(), &UpdateCategoryInput{
-		Path: UpdateCategoryPath{ID: id.String()},
+		ID:   id.String(),
 		Body: UpdateCategoryBody{Name: &newName},
 	})
 	assert.NoError(t, err)
-	assert.NotNil(t, out)
+	assert.Equal(t, id.String(), out.Body.ID)
+	assert.Equal(t, newName, out.Body.Name)
 	mockOp.AssertExpectations(t)
 }
 
@@ -59,11 +62,11 @@ func TestUpdateCategoryHandler_Success_WithNewParent(t *testing.T) {
 			uc, ok := a.(*actions.UpdateCategory)
 			return ok && uc.ID == id && uc.Name == nil && uc.ParentCategoryID != nil && *uc.ParentCategoryID == parentID && uc.IsDisabled == nil
 		})).
-		Return(nil)
+		Return(&category.Category{ID: id, ParentCategoryID: &parentID}, nil)
 
 	h := NewUpdateCategoryHandler(mockOp, &mockCategoryReader{})
 	out, err := h.handle(context.Background(), &UpdateCategoryInput{
-		Path: UpdateCategoryPath{ID: id.String()},
+		ID:   id.String(),
 		Body: UpdateCategoryBody{ParentCategoryID: &parentIDStr},
 	})
 	assert.NoError(t, err)
@@ -75,12 +78,12 @@ func TestUpdateCategoryHandler_NotFound(t *testing.T) {
 	mockOp := &operator.MockIProcessor{}
 	mockOp.EXPECT().
 		Process(mock.Anything, mock.Anything).
-		Return(actions.ErrCategoryNotFound)
+		Return(nil, actions.ErrCategoryNotFound)
 
 	id := uuid.Must(uuid.NewV4())
 	h := NewUpdateCategoryHandler(mockOp, &mockCategoryReader{})
 	out, err := h.handle(context.Background(), &UpdateCategoryInput{
-		Path: UpdateCategoryPath{ID: id.String()},
+		ID:   id.String(),
 		Body: UpdateCategoryBody{Name: ptrString("New Name")},
 	})
 	assert.Nil(t, out)
@@ -95,13 +98,13 @@ func TestUpdateCategoryHandler_ParentMustBeParent(t *testing.T) {
 	mockOp := &operator.MockIProcessor{}
 	mockOp.EXPECT().
 		Process(mock.Anything, mock.Anything).
-		Return(actions.ErrSpecifiedCategoryParentIsNotParent)
+		Return(nil, actions.ErrSpecifiedCategoryParentIsNotParent)
 
 	id := uuid.Must(uuid.NewV4())
 	parentID := uuid.Must(uuid.NewV4()).String()
 	h := NewUpdateCategoryHandler(mockOp, &mockCategoryReader{})
 	out, err := h.handle(context.Background(), &UpdateCategoryInput{
-		Path: UpdateCategoryPath{ID: id.String()},
+		ID:   id.String(),
 		Body: UpdateCategoryBody{ParentCategoryID: &parentID},
 	})
 	assert.Nil(t, out)
@@ -115,3 +118,25 @@ func TestUpdateCategoryHandler_ParentMustBeParent(t *testing.T) {
 func ptrString(s string) *string {
 	return &s
 }
+
+func TestUpdateCategoryItem_Action_MonthlyBudget(t *testing.T) {
+	id := uuid.Must(uuid.NewV4()).String()
+	budget := "250.00"
+	action, err := UpdateCategoryItem{ID: id, UpdateCategoryBody: UpdateCategoryBody{MonthlyBudget: &budget}}.Action()
+	require.NoError(t, err)
+	uc := action.(*actions.UpdateCategory)
+	require.NotNil(t, uc.MonthlyBudget)
+	assert.Equal(t, "250", uc.MonthlyBudget.String())
+	assert.False(t, uc.ClearMonthlyBudget)
+
+	empty := ""
+	action, err = UpdateCategoryItem{ID: id, UpdateCategoryBody: UpdateCategoryBody{MonthlyBudget: &empty}}.Action()
+	require.NoError(t, err)
+	uc = action.(*actions.UpdateCategory)
+	assert.Nil(t, uc.MonthlyBudget)
+	assert.True(t, uc.ClearMonthlyBudget)
+
+	invalid := "lots"
+	_, err = UpdateCategoryItem{ID: id, UpdateCategoryBody: UpdateCategoryBody{MonthlyBudget: &invalid}}.Action()
+	assert.Error(t, err)
+}
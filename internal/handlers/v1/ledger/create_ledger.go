@@ -0,0 +1,72 @@
+package ledger
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+)
+
+// CreateLedgerBody is the request body for creating a ledger.
+type CreateLedgerBody struct {
+	Name string `json:"name" required:"true" minLength:"1" maxLength:"255" doc:"Ledger name"`
+}
+
+// CreateLedgerInput is the Huma input for creating a ledger.
+type CreateLedgerInput struct {
+	idempotent.Header
+	Body CreateLedgerBody
+}
+
+// CreateLedgerOutput is the Huma output for creating a ledger.
+type CreateLedgerOutput struct {
+	Status int `json:"status" doc:"HTTP status"`
+	Body   Ledger
+}
+
+// CreateLedgerHandler handles POST /v1/ledgers.
+type CreateLedgerHandler struct {
+	Operator operator.IProcessor
+}
+
+// NewCreateLedgerHandler creates a new CreateLedgerHandler.
+func NewCreateLedgerHandler(op operator.IProcessor) *CreateLedgerHandler {
+	return &CreateLedgerHandler{Operator: op}
+}
+
+// Register registers the create ledger endpoint with the Huma API.
+func (h *CreateLedgerHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-ledger",
+		Method:      http.MethodPost,
+		Path:        "/v1/ledgers",
+		Summary:     "Create ledger",
+		Description: "Creates an empty ledger owned by the caller.",
+		Tags:        []string{"Ledgers"},
+	}, h.handle)
+}
+
+func (h *CreateLedgerHandler) handle(ctx context.Context, input *CreateLedgerInput) (*CreateLedgerOutput, error) {
+	p, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	action := &actions.CreateLedger{Name: input.Body.Name, OwnerID: p.UserID}
+	scopedBody := struct {
+		UserID string `json:"userID"`
+		CreateLedgerBody
+	}{p.UserID.String(), input.Body}
+	return idempotent.Process(ctx, h.Operator, input.Header, "create-ledger", scopedBody, action,
+		func(result any) *CreateLedgerOutput {
+			return &CreateLedgerOutput{Status: http.StatusCreated, Body: toAPILedger(result.(*ledger.UserLedger))}
+		},
+		func(err error) huma.StatusError {
+			return huma.NewError(http.StatusInternalServerError, "failed to create ledger", err)
+		})
+}
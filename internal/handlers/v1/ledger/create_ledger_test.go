@@ -0,0 +1,51 @@
+package ledger
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+)
+
+func TestHTTP_CreateLedger_Success(t *testing.T) {
+	principal := &auth.Principal{UserID: uuid.Must(uuid.NewV4())}
+	created := &ledger.UserLedger{Ledger: ledger.Ledger{ID: uuid.Must(uuid.NewV4()), Name: "Home"}, Role: ledger.RoleOwner}
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, &actions.CreateLedger{Name: "Home", OwnerID: principal.UserID}).
+		Return(created, nil)
+
+	_, api := humatest.New(t)
+	authenticateAs(api, principal)
+	NewCreateLedgerHandler(mockOp).Register(api)
+	resp := api.Post("/v1/ledgers", CreateLedgerBody{Name: "Home"})
+
+	require.Equal(t, http.StatusCreated, resp.Code)
+	var body Ledger
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, created.ID.String(), body.ID)
+	assert.Equal(t, "owner", body.Role)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_CreateLedger_EmptyName(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+
+	_, api := humatest.New(t)
+	authenticateAs(api, &auth.Principal{UserID: uuid.Must(uuid.NewV4())})
+	NewCreateLedgerHandler(mockOp).Register(api)
+	resp := api.Post("/v1/ledgers", CreateLedgerBody{})
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	mockOp.AssertNotCalled(t, "Process", mock.Anything, mock.Anything)
+}
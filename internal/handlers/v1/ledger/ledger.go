@@ -0,0 +1,110 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+// Ledger is the API response model for a ledger the caller is a member of.
+type Ledger struct {
+	ID        string `json:"id" doc:"Ledger UUID, sent as the X-Ledger-ID header to work in it"`
+	Name      string `json:"name" doc:"Ledger name"`
+	Role      string `json:"role" doc:"The caller's role: viewer, editor or owner"`
+	CreatedAt string `json:"createdAt" doc:"RFC3339 creation timestamp"`
+}
+
+// Member is the API response model for a ledger member.
+type Member struct {
+	UserID    string `json:"userID" doc:"User UUID"`
+	Username  string `json:"username" doc:"Member's username"`
+	Role      string `json:"role" doc:"viewer, editor or owner"`
+	CreatedAt string `json:"createdAt" doc:"RFC3339 time the user joined"`
+}
+
+// ledgerReader is the interface for reading ledgers and their members.
+type ledgerReader interface {
+	ListForUser(ctx context.Context, userID uuid.UUID) ([]*ledger.UserLedger, error)
+	GetMembership(ctx context.Context, ledgerID uuid.UUID, userID uuid.UUID) (*ledger.Membership, error)
+	ListMembers(ctx context.Context, ledgerID uuid.UUID) ([]*ledger.Member, error)
+}
+
+// userReader is the interface for looking up the users named in member
+// paths.
+type userReader interface {
+	GetByUsername(ctx context.Context, username string) (*user.User, error)
+}
+
+func toAPILedger(l *ledger.UserLedger) Ledger {
+	return Ledger{
+		ID:        l.ID.String(),
+		Name:      l.Name,
+		Role:      l.Role.String(),
+		CreatedAt: l.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toAPIMember(m *ledger.Member) Member {
+	return Member{
+		UserID:    m.UserID.String(),
+		Username:  m.Username,
+		Role:      m.Role.String(),
+		CreatedAt: m.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// principal returns the authenticated user, or a 401 when the request
+// reached the handler without one.
+func principal(ctx context.Context) (*auth.Principal, error) {
+	p := auth.FromContext(ctx)
+	if p == nil {
+		return nil, huma.NewError(http.StatusUnauthorized, "authentication required")
+	}
+	return p, nil
+}
+
+func parseLedgerID(id string) (uuid.UUID, error) {
+	parsed, err := uuid.FromString(id)
+	if err != nil {
+		return uuid.Nil, huma.NewError(http.StatusBadRequest, "invalid ledger id", err)
+	}
+	return parsed, nil
+}
+
+// findUser resolves a member path's username, or returns a 404 when there
+// is no such user.
+func findUser(ctx context.Context, users userReader, username string) (*user.User, error) {
+	u, err := users.GetByUsername(ctx, username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, huma.NewError(http.StatusNotFound, "user not found", err)
+	}
+	if err != nil {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to get user", err)
+	}
+	return u, nil
+}
+
+// mapMemberError converts an error from changing a ledger's members into an
+// API error.
+func mapMemberError(msg string) func(error) huma.StatusError {
+	return func(err error) huma.StatusError {
+		switch {
+		case errors.Is(err, actions.ErrLedgerMemberNotFound):
+			return huma.NewError(http.StatusNotFound, "ledger member not found", err)
+		case errors.Is(err, actions.ErrLastOwner):
+			return huma.NewError(http.StatusConflict, "ledger must keep at least one owner", err)
+		default:
+			return huma.NewError(http.StatusInternalServerError, msg, err)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+type mockLedgerReader struct {
+	mock.Mock
+}
+
+func (m *mockLedgerReader) ListForUser(ctx context.Context, userID uuid.UUID) ([]*ledger.UserLedger, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ledger.UserLedger), args.Error(1)
+}
+
+func (m *mockLedgerReader) GetMembership(ctx context.Context, ledgerID uuid.UUID, userID uuid.UUID) (*ledger.Membership, error) {
+	args := m.Called(ctx, ledgerID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ledger.Membership), args.Error(1)
+}
+
+func (m *mockLedgerReader) ListMembers(ctx context.Context, ledgerID uuid.UUID) ([]*ledger.Member, error) {
+	args := m.Called(ctx, ledgerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ledger.Member), args.Error(1)
+}
+
+type mockUserReader struct {
+	mock.Mock
+}
+
+func (m *mockUserReader) GetByUsername(ctx context.Context, username string) (*user.User, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+// authenticateAs stands in for the auth middleware, attaching principal to
+// every request made through api.
+func authenticateAs(api humatest.TestAPI, principal *auth.Principal) {
+	api.UseMiddleware(func(ctx huma.Context, next func(huma.Context)) {
+		next(huma.WithContext(ctx, auth.WithPrincipal(ctx.Context(), principal)))
+	})
+}
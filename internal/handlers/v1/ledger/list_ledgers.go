@@ -0,0 +1,58 @@
+package ledger
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// ListLedgersResponseBody is the response body for listing ledgers.
+type ListLedgersResponseBody struct {
+	Ledgers []Ledger `json:"ledgers" doc:"Ledgers the caller is a member of, in the order they joined"`
+}
+
+// ListLedgersOutput is the Huma output for listing ledgers.
+type ListLedgersOutput struct {
+	Body ListLedgersResponseBody
+}
+
+// ListLedgersHandler handles GET /v1/ledgers.
+type ListLedgersHandler struct {
+	LedgerReader ledgerReader
+}
+
+// NewListLedgersHandler creates a new ListLedgersHandler.
+func NewListLedgersHandler(reader ledgerReader) *ListLedgersHandler {
+	return &ListLedgersHandler{LedgerReader: reader}
+}
+
+// Register registers the list ledgers endpoint with the Huma API.
+func (h *ListLedgersHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-ledgers",
+		Method:      http.MethodGet,
+		Path:        "/v1/ledgers",
+		Summary:     "List ledgers",
+		Description: "Returns the ledgers the caller is a member of, with their role in each.",
+		Tags:        []string{"Ledgers"},
+	}, h.handle)
+}
+
+func (h *ListLedgersHandler) handle(ctx context.Context, _ *struct{}) (*ListLedgersOutput, error) {
+	p, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ledgers, err := h.LedgerReader.ListForUser(ctx, p.UserID)
+	if err != nil {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to list ledgers", err)
+	}
+
+	body := ListLedgersResponseBody{Ledgers: make([]Ledger, len(ledgers))}
+	for i, l := range ledgers {
+		body.Ledgers[i] = toAPILedger(l)
+	}
+	return &ListLedgersOutput{Body: body}, nil
+}
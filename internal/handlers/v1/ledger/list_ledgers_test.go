@@ -0,0 +1,50 @@
+package ledger
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+)
+
+func TestHTTP_ListLedgers_Success(t *testing.T) {
+	principal := &auth.Principal{UserID: uuid.Must(uuid.NewV4())}
+	home := &ledger.UserLedger{
+		Ledger: ledger.Ledger{ID: uuid.Must(uuid.NewV4()), Name: "Home", CreatedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+		Role:   ledger.RoleEditor,
+	}
+	reader := &mockLedgerReader{}
+	reader.On("ListForUser", mock.Anything, principal.UserID).Return([]*ledger.UserLedger{home}, nil)
+
+	_, api := humatest.New(t)
+	authenticateAs(api, principal)
+	NewListLedgersHandler(reader).Register(api)
+	resp := api.Get("/v1/ledgers")
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	var body ListLedgersResponseBody
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, []Ledger{{ID: home.ID.String(), Name: "Home", Role: "editor", CreatedAt: "2026-01-02T03:04:05Z"}}, body.Ledgers)
+}
+
+func TestHTTP_ListLedgers_StorageError(t *testing.T) {
+	reader := &mockLedgerReader{}
+	reader.On("ListForUser", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+	_, api := humatest.New(t)
+	authenticateAs(api, &auth.Principal{UserID: uuid.Must(uuid.NewV4())})
+	NewListLedgersHandler(reader).Register(api)
+	resp := api.Get("/v1/ledgers")
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
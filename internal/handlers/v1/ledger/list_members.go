@@ -0,0 +1,78 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// ListMembersInput is the Huma input for listing a ledger's members.
+type ListMembersInput struct {
+	ID string `path:"id" doc:"Ledger UUID"`
+}
+
+// ListMembersResponseBody is the response body for listing a ledger's
+// members.
+type ListMembersResponseBody struct {
+	Members []Member `json:"members" doc:"The ledger's members, in the order they joined"`
+}
+
+// ListMembersOutput is the Huma output for listing a ledger's members.
+type ListMembersOutput struct {
+	Body ListMembersResponseBody
+}
+
+// ListMembersHandler handles GET /v1/ledgers/{id}/members.
+type ListMembersHandler struct {
+	LedgerReader ledgerReader
+}
+
+// NewListMembersHandler creates a new ListMembersHandler.
+func NewListMembersHandler(reader ledgerReader) *ListMembersHandler {
+	return &ListMembersHandler{LedgerReader: reader}
+}
+
+// Register registers the list members endpoint with the Huma API.
+func (h *ListMembersHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-ledger-members",
+		Method:      http.MethodGet,
+		Path:        "/v1/ledgers/{id}/members",
+		Summary:     "List ledger members",
+		Description: "Returns the members of a ledger the caller belongs to.",
+		Tags:        []string{"Ledgers"},
+	}, h.handle)
+}
+
+func (h *ListMembersHandler) handle(ctx context.Context, input *ListMembersInput) (*ListMembersOutput, error) {
+	p, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ledgerID, err := parseLedgerID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = h.LedgerReader.GetMembership(ctx, ledgerID, p.UserID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, huma.NewError(http.StatusNotFound, "ledger not found", err)
+	}
+	if err != nil {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to get ledger", err)
+	}
+
+	members, err := h.LedgerReader.ListMembers(ctx, ledgerID)
+	if err != nil {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to list ledger members", err)
+	}
+
+	body := ListMembersResponseBody{Members: make([]Member, len(members))}
+	for i, member := range members {
+		body.Members[i] = toAPIMember(member)
+	}
+	return &ListMembersOutput{Body: body}, nil
+}
@@ -0,0 +1,65 @@
+package ledger
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+)
+
+func newListMembersTestAPI(t *testing.T, reader ledgerReader, principal *auth.Principal) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	authenticateAs(api, principal)
+	NewListMembersHandler(reader).Register(api)
+	return api
+}
+
+func TestHTTP_ListMembers_Success(t *testing.T) {
+	principal := &auth.Principal{UserID: uuid.Must(uuid.NewV4())}
+	ledgerID := uuid.Must(uuid.NewV4())
+	member := &ledger.Member{
+		Membership: ledger.Membership{LedgerID: ledgerID, UserID: principal.UserID, Role: ledger.RoleViewer},
+		Username:   "sam",
+	}
+	reader := &mockLedgerReader{}
+	reader.On("GetMembership", mock.Anything, ledgerID, principal.UserID).Return(&member.Membership, nil)
+	reader.On("ListMembers", mock.Anything, ledgerID).Return([]*ledger.Member{member}, nil)
+
+	resp := newListMembersTestAPI(t, reader, principal).Get("/v1/ledgers/" + ledgerID.String() + "/members")
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	var body ListMembersResponseBody
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Members, 1)
+	assert.Equal(t, "sam", body.Members[0].Username)
+	assert.Equal(t, "viewer", body.Members[0].Role)
+}
+
+func TestHTTP_ListMembers_NotAMember(t *testing.T) {
+	reader := &mockLedgerReader{}
+	reader.On("GetMembership", mock.Anything, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+
+	resp := newListMembersTestAPI(t, reader, &auth.Principal{UserID: uuid.Must(uuid.NewV4())}).
+		Get("/v1/ledgers/" + uuid.Must(uuid.NewV4()).String() + "/members")
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	reader.AssertNotCalled(t, "ListMembers", mock.Anything, mock.Anything)
+}
+
+func TestHTTP_ListMembers_InvalidID(t *testing.T) {
+	reader := &mockLedgerReader{}
+
+	resp := newListMembersTestAPI(t, reader, &auth.Principal{}).Get("/v1/ledgers/not-a-uuid/members")
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
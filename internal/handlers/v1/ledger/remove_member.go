@@ -0,0 +1,64 @@
+package ledger
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+)
+
+// RemoveMemberInput is the Huma input for removing a ledger member.
+type RemoveMemberInput struct {
+	idempotent.Header
+	ID       string `path:"id" doc:"Ledger UUID"`
+	Username string `path:"username" doc:"Username of the member"`
+}
+
+// RemoveMemberOutput is the Huma output for removing a ledger member.
+type RemoveMemberOutput struct {
+	Status int `json:"status" doc:"HTTP status"`
+}
+
+// RemoveMemberHandler handles DELETE /v1/ledgers/{id}/members/{username}.
+type RemoveMemberHandler struct {
+	Operator   operator.IProcessor
+	UserReader userReader
+}
+
+// NewRemoveMemberHandler creates a new RemoveMemberHandler.
+func NewRemoveMemberHandler(op operator.IProcessor, users userReader) *RemoveMemberHandler {
+	return &RemoveMemberHandler{Operator: op, UserReader: users}
+}
+
+// Register registers the remove member endpoint with the Huma API.
+func (h *RemoveMemberHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "remove-ledger-member",
+		Method:      http.MethodDelete,
+		Path:        "/v1/ledgers/{id}/members/{username}",
+		Summary:     "Remove ledger member",
+		Description: "Removes a member from the ledger. Owners may remove anyone and any member may remove themselves, " +
+			"but the last owner can't leave.",
+		Tags: []string{"Ledgers"},
+	}, h.handle)
+}
+
+func (h *RemoveMemberHandler) handle(ctx context.Context, input *RemoveMemberInput) (*RemoveMemberOutput, error) {
+	member, err := findUser(ctx, h.UserReader, input.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	scopedBody := struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	}{input.ID, input.Username}
+	return idempotent.Process(ctx, h.Operator, input.Header, "remove-ledger-member", scopedBody,
+		&actions.RemoveLedgerMember{UserID: member.ID},
+		func(any) *RemoveMemberOutput { return &RemoveMemberOutput{Status: http.StatusNoContent} },
+		mapMemberError("failed to remove ledger member"))
+}
@@ -0,0 +1,53 @@
+package ledger
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+func newRemoveMemberTestAPI(t *testing.T, op operator.IProcessor, users userReader, principal *auth.Principal) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	authenticateAs(api, principal)
+	NewRemoveMemberHandler(op, users).Register(api)
+	return api
+}
+
+func TestHTTP_RemoveMember_Success(t *testing.T) {
+	principal := &auth.Principal{UserID: uuid.Must(uuid.NewV4())}
+	ledgerID := uuid.Must(uuid.NewV4())
+	alex := &user.User{ID: uuid.Must(uuid.NewV4()), Username: "alex"}
+	users := &mockUserReader{}
+	users.On("GetByUsername", mock.Anything, "alex").Return(alex, nil)
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, &actions.RemoveLedgerMember{UserID: alex.ID}).
+		Return(nil, nil)
+
+	resp := newRemoveMemberTestAPI(t, mockOp, users, principal).Delete("/v1/ledgers/" + ledgerID.String() + "/members/alex")
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_RemoveMember_NotFound(t *testing.T) {
+	users := &mockUserReader{}
+	users.On("GetByUsername", mock.Anything, "alex").Return(&user.User{ID: uuid.Must(uuid.NewV4()), Username: "alex"}, nil)
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, actions.ErrLedgerMemberNotFound)
+
+	resp := newRemoveMemberTestAPI(t, mockOp, users, &auth.Principal{UserID: uuid.Must(uuid.NewV4())}).
+		Delete("/v1/ledgers/" + uuid.Must(uuid.NewV4()).String() + "/members/alex")
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
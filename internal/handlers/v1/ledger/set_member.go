@@ -0,0 +1,82 @@
+package ledger
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+)
+
+// SetMemberBody is the request body for adding a ledger member or changing
+// their role.
+type SetMemberBody struct {
+	Role string `json:"role" required:"true" enum:"viewer,editor,owner" doc:"viewer may read, editor may also write and owner may also manage members"`
+}
+
+// SetMemberInput is the Huma input for adding a ledger member or changing
+// their role.
+type SetMemberInput struct {
+	idempotent.Header
+	ID       string `path:"id" doc:"Ledger UUID"`
+	Username string `path:"username" doc:"Username of the member"`
+	Body     SetMemberBody
+}
+
+// SetMemberOutput is the Huma output for adding a ledger member or changing
+// their role.
+type SetMemberOutput struct {
+	Body Member
+}
+
+// SetMemberHandler handles PUT /v1/ledgers/{id}/members/{username}.
+type SetMemberHandler struct {
+	Operator   operator.IProcessor
+	UserReader userReader
+}
+
+// NewSetMemberHandler creates a new SetMemberHandler.
+func NewSetMemberHandler(op operator.IProcessor, users userReader) *SetMemberHandler {
+	return &SetMemberHandler{Operator: op, UserReader: users}
+}
+
+// Register registers the set member endpoint with the Huma API.
+func (h *SetMemberHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "set-ledger-member",
+		Method:      http.MethodPut,
+		Path:        "/v1/ledgers/{id}/members/{username}",
+		Summary:     "Set ledger member",
+		Description: "Adds a user to the ledger with the given role, or changes the role of an existing member. " +
+			"Only owners may manage members, and the last owner can't be demoted.",
+		Tags: []string{"Ledgers"},
+	}, h.handle)
+}
+
+func (h *SetMemberHandler) handle(ctx context.Context, input *SetMemberInput) (*SetMemberOutput, error) {
+	role, ok := ledger.ParseRole(input.Body.Role)
+	if !ok {
+		return nil, huma.NewError(http.StatusBadRequest, "invalid role")
+	}
+	member, err := findUser(ctx, h.UserReader, input.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	action := &actions.SetLedgerMember{UserID: member.ID, Role: role}
+	scopedBody := struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+		SetMemberBody
+	}{input.ID, input.Username, input.Body}
+	return idempotent.Process(ctx, h.Operator, input.Header, "set-ledger-member", scopedBody, action,
+		func(result any) *SetMemberOutput {
+			membership := result.(*ledger.Membership)
+			return &SetMemberOutput{Body: toAPIMember(&ledger.Member{Membership: *membership, Username: member.Username})}
+		},
+		mapMemberError("failed to set ledger member"))
+}
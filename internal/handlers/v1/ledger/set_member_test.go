@@ -0,0 +1,97 @@
+package ledger
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+func newSetMemberTestAPI(t *testing.T, op operator.IProcessor, users userReader, principal *auth.Principal) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	authenticateAs(api, principal)
+	NewSetMemberHandler(op, users).Register(api)
+	return api
+}
+
+func TestHTTP_SetMember_Success(t *testing.T) {
+	principal := &auth.Principal{UserID: uuid.Must(uuid.NewV4())}
+	ledgerID := uuid.Must(uuid.NewV4())
+	alex := &user.User{ID: uuid.Must(uuid.NewV4()), Username: "alex"}
+	users := &mockUserReader{}
+	users.On("GetByUsername", mock.Anything, "alex").Return(alex, nil)
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, &actions.SetLedgerMember{UserID: alex.ID, Role: ledger.RoleEditor}).
+		Return(&ledger.Membership{UserID: alex.ID, Role: ledger.RoleEditor}, nil)
+
+	resp := newSetMemberTestAPI(t, mockOp, users, principal).
+		Put("/v1/ledgers/"+ledgerID.String()+"/members/alex", SetMemberBody{Role: "editor"})
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	var body Member
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, alex.ID.String(), body.UserID)
+	assert.Equal(t, "alex", body.Username)
+	assert.Equal(t, "editor", body.Role)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_SetMember_UnknownUser(t *testing.T) {
+	users := &mockUserReader{}
+	users.On("GetByUsername", mock.Anything, "nobody").Return(nil, sql.ErrNoRows)
+	mockOp := &operator.MockIProcessor{}
+
+	resp := newSetMemberTestAPI(t, mockOp, users, &auth.Principal{UserID: uuid.Must(uuid.NewV4())}).
+		Put("/v1/ledgers/"+uuid.Must(uuid.NewV4()).String()+"/members/nobody", SetMemberBody{Role: "viewer"})
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	mockOp.AssertNotCalled(t, "Process", mock.Anything, mock.Anything)
+}
+
+func TestHTTP_SetMember_InvalidRole(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+
+	resp := newSetMemberTestAPI(t, mockOp, &mockUserReader{}, &auth.Principal{UserID: uuid.Must(uuid.NewV4())}).
+		Put("/v1/ledgers/"+uuid.Must(uuid.NewV4()).String()+"/members/alex", SetMemberBody{Role: "admin"})
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	mockOp.AssertNotCalled(t, "Process", mock.Anything, mock.Anything)
+}
+
+func TestHTTP_SetMember_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"not an owner", operator.ErrPermissionDenied, http.StatusForbidden},
+		{"last owner", actions.ErrLastOwner, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &mockUserReader{}
+			users.On("GetByUsername", mock.Anything, "alex").Return(&user.User{ID: uuid.Must(uuid.NewV4()), Username: "alex"}, nil)
+			mockOp := &operator.MockIProcessor{}
+			mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, tt.err)
+
+			resp := newSetMemberTestAPI(t, mockOp, users, &auth.Principal{UserID: uuid.Must(uuid.NewV4())}).
+				Put("/v1/ledgers/"+uuid.Must(uuid.NewV4()).String()+"/members/alex", SetMemberBody{Role: "viewer"})
+
+			assert.Equal(t, tt.want, resp.Code)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package status
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 
@@ -8,6 +9,11 @@ import (
 	"github.com/carson-networks/budget-server/internal/operator"
 )
 
+// Response is the body of GET /status.
+type Response struct {
+	Operator operator.Stats `json:"operator"`
+}
+
 type Handler struct {
 	Operator *operator.OperatorDelegator
 }
@@ -22,6 +28,12 @@ func (h *Handler) Handler(w http.ResponseWriter, req *http.Request, logData *log
 		return errors.New("status: method not GET")
 	}
 
+	if h.Operator == nil {
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	return nil
+	return json.NewEncoder(w).Encode(Response{Operator: h.Operator.Stats()})
 }
@@ -1,13 +1,16 @@
 package status
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/carson-networks/budget-server/internal/logging"
+	"github.com/carson-networks/budget-server/internal/operator"
 )
 
 func createTestLogData() *logging.LogData {
@@ -39,3 +42,18 @@ func TestHandler_BadMethod(t *testing.T) {
 	res := w.Result()
 	assert.Equal(t, 400, res.StatusCode)
 }
+
+func TestHandler_ReportsOperatorStats(t *testing.T) {
+	op := operator.NewOperatorDelegator(&operator.MockIStorage{}, 2, operator.DefaultQueueCapacity)
+	statusHandler := NewHandler(op)
+	req := httptest.NewRequest(http.MethodGet, "/status", nil)
+	w := httptest.NewRecorder()
+
+	err := statusHandler.Handler(w, req, createTestLogData())
+	require.NoError(t, err)
+
+	var body Response
+	require.NoError(t, json.NewDecoder(w.Result().Body).Decode(&body))
+	assert.Equal(t, 0, body.Operator.QueueDepth)
+	assert.Equal(t, 1000, body.Operator.QueueCapacity)
+}
@@ -0,0 +1,156 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/carson-networks/budget-server/internal/logging"
+	"github.com/carson-networks/budget-server/internal/operator"
+)
+
+// checkTimeout bounds each check so a hung dependency fails the probe
+// rather than holding it open.
+const checkTimeout = 2 * time.Second
+
+const (
+	statusOK          = "ok"
+	statusUnavailable = "unavailable"
+)
+
+// Check reports whether one dependency is usable; nil means healthy.
+type Check func(ctx context.Context) error
+
+// ComponentHealth is one check's result.
+type ComponentHealth struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// HealthResponse is the body of GET /healthz and GET /readyz.
+type HealthResponse struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentHealth `json:"components"`
+}
+
+// HealthHandler runs its checks concurrently and answers 200 when all
+// pass, 503 otherwise, with each component's result.
+type HealthHandler struct {
+	checks map[string]Check
+}
+
+func NewHealthHandler(checks map[string]Check) HealthHandler {
+	return HealthHandler{checks: checks}
+}
+
+func (h *HealthHandler) Handler(w http.ResponseWriter, req *http.Request, logData *logging.LogData) error {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return errors.New("health: method not GET")
+	}
+
+	resp := HealthResponse{Status: statusOK, Components: make(map[string]ComponentHealth, len(h.checks))}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, check := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(req.Context(), checkTimeout)
+			defer cancel()
+			component := ComponentHealth{Status: statusOK}
+			if err := check(ctx); err != nil {
+				component = ComponentHealth{Status: statusUnavailable, Error: err.Error()}
+			}
+			mu.Lock()
+			resp.Components[name] = component
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	var failed []string
+	for name, component := range resp.Components {
+		if component.Status != statusOK {
+			failed = append(failed, name)
+		}
+	}
+	code := http.StatusOK
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		resp.Status = statusUnavailable
+		code = http.StatusServiceUnavailable
+		logData.AddData("failed", failed)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	return json.NewEncoder(w).Encode(resp)
+}
+
+type pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// DatabaseCheck passes while the database accepts connections.
+func DatabaseCheck(db pinger) Check {
+	return db.Ping
+}
+
+type migrationVersioner interface {
+	MigrationVersion(ctx context.Context) (version uint, dirty bool, err error)
+}
+
+// MigrationCheck passes while the database schema is at the version this
+// binary was built against.
+func MigrationCheck(db migrationVersioner, expected uint) Check {
+	return func(ctx context.Context) error {
+		version, dirty, err := db.MigrationVersion(ctx)
+		if err != nil {
+			return fmt.Errorf("read schema version: %w", err)
+		}
+		if dirty {
+			return fmt.Errorf("migration to version %d failed part way", version)
+		}
+		if version != expected {
+			return fmt.Errorf("schema is at version %d, expected %d", version, expected)
+		}
+		return nil
+	}
+}
+
+type statsSource interface {
+	Stats() operator.Stats
+}
+
+// WorkersCheck passes while every operator worker is running.
+func WorkersCheck(op statsSource) Check {
+	return func(context.Context) error {
+		stats := op.Stats()
+		if stats.WorkersRunning < stats.Workers {
+			return fmt.Errorf("%d of %d operator workers running", stats.WorkersRunning, stats.Workers)
+		}
+		return nil
+	}
+}
+
+// QueueCheck passes while every operator worker is running and the queue
+// has room for more writes.
+func QueueCheck(op statsSource) Check {
+	workers := WorkersCheck(op)
+	return func(ctx context.Context) error {
+		if err := workers(ctx); err != nil {
+			return err
+		}
+		if stats := op.Stats(); stats.QueueDepth >= stats.QueueCapacity {
+			return fmt.Errorf("operator queue is full (%d items)", stats.QueueDepth)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,135 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/operator"
+)
+
+func serveHealth(t *testing.T, handler HealthHandler, method string) (int, HealthResponse) {
+	t.Helper()
+	req := httptest.NewRequest(method, "/readyz", nil)
+	w := httptest.NewRecorder()
+
+	require.NoError(t, handler.Handler(w, req, createTestLogData()))
+
+	var body HealthResponse
+	require.NoError(t, json.NewDecoder(w.Result().Body).Decode(&body))
+	return w.Code, body
+}
+
+func passing(context.Context) error { return nil }
+
+func TestHealthHandler_AllPass(t *testing.T) {
+	handler := NewHealthHandler(map[string]Check{"database": passing, "operator": passing})
+
+	code, body := serveHealth(t, handler, http.MethodGet)
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, HealthResponse{
+		Status: "ok",
+		Components: map[string]ComponentHealth{
+			"database": {Status: "ok"},
+			"operator": {Status: "ok"},
+		},
+	}, body)
+}
+
+func TestHealthHandler_OneFails(t *testing.T) {
+	handler := NewHealthHandler(map[string]Check{
+		"database": func(context.Context) error { return errors.New("connection refused") },
+		"operator": passing,
+	})
+
+	code, body := serveHealth(t, handler, http.MethodGet)
+
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "unavailable", body.Status)
+	assert.Equal(t, ComponentHealth{Status: "unavailable", Error: "connection refused"}, body.Components["database"])
+	assert.Equal(t, ComponentHealth{Status: "ok"}, body.Components["operator"])
+}
+
+func TestHealthHandler_BadMethod(t *testing.T) {
+	handler := NewHealthHandler(map[string]Check{"operator": passing})
+	req := httptest.NewRequest(http.MethodPost, "/healthz", nil)
+	w := httptest.NewRecorder()
+
+	err := handler.Handler(w, req, createTestLogData())
+
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+type fakeVersioner struct {
+	version uint
+	dirty   bool
+	err     error
+}
+
+func (f fakeVersioner) MigrationVersion(context.Context) (uint, bool, error) {
+	return f.version, f.dirty, f.err
+}
+
+func TestMigrationCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      fakeVersioner
+		wantErr bool
+	}{
+		{"current", fakeVersioner{version: 12}, false},
+		{"behind", fakeVersioner{version: 11}, true},
+		{"ahead", fakeVersioner{version: 13}, true},
+		{"dirty", fakeVersioner{version: 12, dirty: true}, true},
+		{"unreadable", fakeVersioner{err: errors.New("relation does not exist")}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := MigrationCheck(tt.db, 12)(context.Background())
+
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+type fakeStats operator.Stats
+
+func (f fakeStats) Stats() operator.Stats { return operator.Stats(f) }
+
+func TestOperatorChecks(t *testing.T) {
+	tests := []struct {
+		name      string
+		stats     fakeStats
+		wantLive  bool
+		wantReady bool
+	}{
+		{"healthy", fakeStats{Workers: 4, WorkersRunning: 4, QueueDepth: 10, QueueCapacity: 1000}, true, true},
+		{"worker exited", fakeStats{Workers: 4, WorkersRunning: 3, QueueCapacity: 1000}, false, false},
+		{"queue full", fakeStats{Workers: 4, WorkersRunning: 4, QueueDepth: 1000, QueueCapacity: 1000}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantLive, WorkersCheck(tt.stats)(context.Background()) == nil)
+			assert.Equal(t, tt.wantReady, QueueCheck(tt.stats)(context.Background()) == nil)
+		})
+	}
+}
+
+func TestWorkersCheck_Delegator(t *testing.T) {
+	op := operator.NewOperatorDelegator(&operator.MockIStorage{}, 2, operator.DefaultQueueCapacity)
+	check := WorkersCheck(op)
+	assert.Error(t, check(context.Background()))
+
+	op.Start()
+	assert.NoError(t, check(context.Background()))
+
+	require.NoError(t, op.Stop(context.Background()))
+	assert.Error(t, check(context.Background()))
+}
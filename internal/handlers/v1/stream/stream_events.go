@@ -0,0 +1,220 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/logging"
+	"github.com/carson-networks/budget-server/internal/requestctx"
+)
+
+const (
+	// subscriberBuffer is how many live events a client may fall behind by
+	// before the broker drops it; the client then reconnects and replays.
+	subscriberBuffer  = 256
+	heartbeatInterval = 15 * time.Second
+	// writeTimeout bounds each write, replacing the server's WriteTimeout
+	// which would otherwise end every stream after 30 seconds.
+	writeTimeout = 10 * time.Second
+)
+
+// StreamEventsInput is the Huma input for the event stream.
+type StreamEventsInput struct {
+	LastEventID string `header:"Last-Event-ID" doc:"ID of the last event received; later events are replayed before live ones"`
+	Types       string `query:"types" doc:"Comma-separated event types to stream; omit for every event"`
+	// Token and LedgerID are read by the auth and ledger middleware; they
+	// are declared here to document them.
+	Token    string `query:"token" doc:"Stream token from POST /v1/auth/stream-tokens, in place of the Authorization header"`
+	LedgerID string `query:"ledgerID" doc:"Ledger to stream, in place of the X-Ledger-ID header"`
+}
+
+// StreamEventsHandler handles GET /v1/events/stream.
+type StreamEventsHandler struct {
+	Broker  *events.Broker
+	History events.History
+
+	heartbeat time.Duration
+}
+
+// NewStreamEventsHandler creates a new StreamEventsHandler.
+func NewStreamEventsHandler(broker *events.Broker, history events.History) *StreamEventsHandler {
+	return &StreamEventsHandler{Broker: broker, History: history, heartbeat: heartbeatInterval}
+}
+
+// Register registers the event stream endpoint with the Huma API.
+func (h *StreamEventsHandler) Register(api huma.API) {
+	eventSchema := api.OpenAPI().Components.Schemas.Schema(reflect.TypeOf(events.Event{}), true, "Event")
+	huma.Register(api, huma.Operation{
+		OperationID: "stream-events",
+		Method:      http.MethodGet,
+		Path:        "/v1/events/stream",
+		Summary:     "Stream events",
+		Description: "Server-sent events stream of the ledger's domain events, pushed shortly after their write commits. Each message's id is the event ID, " +
+			"its event name is the event type and its data is the event as JSON. Reconnecting with Last-Event-ID " +
+			"replays the events missed since. Browsers, whose EventSource can't send headers, pass a stream token and the ledger " +
+			"as the token and ledgerID query parameters instead.",
+		Tags: []string{"Events"},
+		Responses: map[string]*huma.Response{
+			"200": {
+				Description: "Event stream",
+				Content:     map[string]*huma.MediaType{"text/event-stream": {Schema: eventSchema}},
+			},
+		},
+	}, h.handle)
+}
+
+func (h *StreamEventsHandler) handle(ctx context.Context, input *StreamEventsInput) (*huma.StreamResponse, error) {
+	replay := input.LastEventID != ""
+	var after int64
+	if replay {
+		parsed, err := strconv.ParseInt(input.LastEventID, 10, 64)
+		if err != nil || parsed < 0 {
+			return nil, huma.NewError(http.StatusBadRequest, "invalid Last-Event-ID", err)
+		}
+		after = parsed
+	}
+
+	types, err := parseTypes(input.Types)
+	if err != nil {
+		return nil, err
+	}
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, huma.NewError(http.StatusBadRequest, "no ledger selected", err)
+	}
+
+	return &huma.StreamResponse{
+		Body: func(hctx huma.Context) {
+			hctx.SetHeader("Content-Type", "text/event-stream")
+			hctx.SetHeader("Cache-Control", "no-cache")
+			err := h.stream(hctx.Context(), newEventWriter(hctx.BodyWriter(), ledgerID.String(), types), ledgerID, replay, after)
+			if logData := logging.GetLogData(ctx); logData != nil && err != nil {
+				logData.AddData("streamError", err.Error())
+			}
+		},
+	}, nil
+}
+
+// stream writes events until the client goes away. Subscribing before
+// replaying means no event is missed between the two; live events the
+// replay already covered are skipped by ID.
+func (h *StreamEventsHandler) stream(ctx context.Context, w *eventWriter, ledgerID uuid.UUID, replay bool, after int64) error {
+	live, unsubscribe := h.Broker.Subscribe(subscriberBuffer)
+	defer unsubscribe()
+
+	if err := w.comment("connected"); err != nil {
+		return err
+	}
+
+	last := after
+	if replay {
+		var err error
+		if last, err = events.Replay(ctx, h.History, ledgerID, after, w.event); err != nil {
+			return err
+		}
+	}
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			if err := w.comment("keepalive"); err != nil {
+				return err
+			}
+		case event, ok := <-live:
+			if !ok {
+				// Dropped for falling behind; the client reconnects with
+				// Last-Event-ID and catches up from the outbox.
+				return nil
+			}
+			if event.ID <= last {
+				continue
+			}
+			last = event.ID
+			if err := w.event(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// parseTypes parses the comma-separated types filter. A nil result means
+// every type.
+func parseTypes(raw string) ([]events.Type, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	var types []events.Type
+	for name := range strings.SplitSeq(raw, ",") {
+		eventType := events.Type(strings.TrimSpace(name))
+		if !slices.Contains(events.Types, eventType) {
+			return nil, huma.NewError(http.StatusBadRequest, "unknown event type "+string(eventType))
+		}
+		types = append(types, eventType)
+	}
+	return types, nil
+}
+
+// eventWriter writes server-sent event frames for one ledger's events,
+// flushing after each one.
+type eventWriter struct {
+	w        io.Writer
+	rc       *http.ResponseController
+	ledgerID string
+	types    []events.Type
+}
+
+func newEventWriter(w io.Writer, ledgerID string, types []events.Type) *eventWriter {
+	ew := &eventWriter{w: w, ledgerID: ledgerID, types: types}
+	if rw, ok := w.(http.ResponseWriter); ok {
+		ew.rc = http.NewResponseController(rw)
+	}
+	return ew
+}
+
+func (w *eventWriter) event(event events.Event) error {
+	if event.LedgerID != w.ledgerID {
+		return nil
+	}
+	if w.types != nil && !slices.Contains(w.types, event.Type) {
+		return nil
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return w.write(fmt.Appendf(nil, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data))
+}
+
+func (w *eventWriter) comment(text string) error {
+	return w.write([]byte(": " + text + "\n\n"))
+}
+
+func (w *eventWriter) write(frame []byte) error {
+	if w.rc != nil {
+		// Not every writer supports deadlines; the server's then applies.
+		_ = w.rc.SetWriteDeadline(time.Now().Add(writeTimeout))
+	}
+	if _, err := w.w.Write(frame); err != nil {
+		return err
+	}
+	if w.rc != nil {
+		return w.rc.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package stream
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage/outbox"
+)
+
+// testLedger is the ledger every test stream is opened in.
+var testLedger = uuid.Must(uuid.FromString("550e8400-e29b-41d4-a716-446655440099"))
+
+// memoryHistory is an in-memory events.History.
+type memoryHistory []*outbox.Event
+
+func (m memoryHistory) ListAfterInLedger(_ context.Context, ledgerID uuid.UUID, after int64, through int64, limit int) ([]*outbox.Event, error) {
+	var result []*outbox.Event
+	for _, event := range m {
+		if *event.LedgerID == ledgerID && event.ID > after && event.ID <= through && len(result) < limit {
+			result = append(result, event)
+		}
+	}
+	return result, nil
+}
+
+// Horizon reports no transactions running, so every event has settled.
+func (m memoryHistory) Horizon(context.Context) (outbox.Horizon, error) {
+	return outbox.Horizon{MaxID: int64(len(m)), XMin: 1, XMax: 1}, nil
+}
+
+func newHistory(types ...events.Type) memoryHistory {
+	history := make(memoryHistory, len(types))
+	for i, eventType := range types {
+		history[i] = &outbox.Event{
+			ID:          int64(i + 1),
+			Type:        string(eventType),
+			AggregateID: uuid.Must(uuid.NewV4()),
+			LedgerID:    &testLedger,
+			Payload:     []byte(`{}`),
+		}
+	}
+	return history
+}
+
+// newAPI returns a test API whose requests are in testLedger.
+func newAPI(t *testing.T) (http.Handler, humatest.TestAPI) {
+	handler, api := humatest.New(t)
+	api.UseMiddleware(func(ctx huma.Context, next func(huma.Context)) {
+		next(huma.WithContext(ctx, requestctx.WithLedgerID(ctx.Context(), testLedger)))
+	})
+	return handler, api
+}
+
+// openStream starts a server for h and opens the stream with the given
+// headers, returning a reader positioned after the "connected" comment.
+func openStream(t *testing.T, h *StreamEventsHandler, path string, headers map[string]string) *bufio.Reader {
+	t.Helper()
+	handler, api := newAPI(t)
+	h.Register(api)
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+	require.NoError(t, err)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	r := bufio.NewReader(resp.Body)
+	assert.Equal(t, ": connected\n", readFrame(t, r))
+	return r
+}
+
+// readFrame reads up to the blank line that ends an SSE frame.
+func readFrame(t *testing.T, r *bufio.Reader) string {
+	t.Helper()
+	var frame strings.Builder
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		if line == "\n" {
+			return frame.String()
+		}
+		frame.WriteString(line)
+	}
+}
+
+func frameID(frame string) string {
+	for line := range strings.SplitSeq(frame, "\n") {
+		if id, ok := strings.CutPrefix(line, "id: "); ok {
+			return id
+		}
+	}
+	return ""
+}
+
+func TestHTTP_StreamEvents_LiveEvents(t *testing.T) {
+	broker := events.NewBroker()
+	r := openStream(t, NewStreamEventsHandler(broker, memoryHistory{}), "/v1/events/stream", nil)
+
+	require.NoError(t, broker.Publish(context.Background(), []events.Event{{ID: 7, Type: events.AccountBalanceChanged, LedgerID: testLedger.String()}}))
+
+	frame := readFrame(t, r)
+	assert.Equal(t, "7", frameID(frame))
+	assert.Contains(t, frame, "event: AccountBalanceChanged\n")
+	assert.Contains(t, frame, `data: {"id":7,"type":"AccountBalanceChanged"`)
+}
+
+func TestHTTP_StreamEvents_ReplaysAfterLastEventID(t *testing.T) {
+	broker := events.NewBroker()
+	history := newHistory(events.AccountCreated, events.TransactionCreated, events.AccountBalanceChanged)
+	r := openStream(t, NewStreamEventsHandler(broker, history), "/v1/events/stream",
+		map[string]string{"Last-Event-ID": "1"})
+
+	assert.Equal(t, "2", frameID(readFrame(t, r)))
+	assert.Equal(t, "3", frameID(readFrame(t, r)))
+
+	// Event 3 was already replayed; only 4 is new.
+	require.NoError(t, broker.Publish(context.Background(), []events.Event{
+		{ID: 3, Type: events.AccountBalanceChanged, LedgerID: testLedger.String()},
+		{ID: 4, Type: events.CategoryUpdated, LedgerID: testLedger.String()},
+	}))
+	assert.Equal(t, "4", frameID(readFrame(t, r)))
+}
+
+func TestHTTP_StreamEvents_FiltersTypes(t *testing.T) {
+	broker := events.NewBroker()
+	history := newHistory(events.AccountCreated, events.CategoryUpdated)
+	r := openStream(t, NewStreamEventsHandler(broker, history), "/v1/events/stream?types=CategoryUpdated,TransactionCreated",
+		map[string]string{"Last-Event-ID": "0"})
+
+	assert.Equal(t, "2", frameID(readFrame(t, r)))
+
+	require.NoError(t, broker.Publish(context.Background(), []events.Event{
+		{ID: 3, Type: events.AccountBalanceChanged, LedgerID: testLedger.String()},
+		{ID: 4, Type: events.TransactionCreated, LedgerID: testLedger.String()},
+	}))
+	assert.Equal(t, "4", frameID(readFrame(t, r)))
+}
+
+func TestHTTP_StreamEvents_SkipsOtherLedgers(t *testing.T) {
+	broker := events.NewBroker()
+	history := newHistory(events.AccountCreated, events.CategoryUpdated)
+	otherLedger := uuid.Must(uuid.NewV4())
+	history[0].LedgerID = &otherLedger
+	r := openStream(t, NewStreamEventsHandler(broker, history), "/v1/events/stream",
+		map[string]string{"Last-Event-ID": "0"})
+
+	assert.Equal(t, "2", frameID(readFrame(t, r)))
+
+	require.NoError(t, broker.Publish(context.Background(), []events.Event{
+		{ID: 3, Type: events.AccountCreated, LedgerID: otherLedger.String()},
+		{ID: 4, Type: events.AccountCreated},
+		{ID: 5, Type: events.AccountCreated, LedgerID: testLedger.String()},
+	}))
+	assert.Equal(t, "5", frameID(readFrame(t, r)))
+}
+
+func TestHTTP_StreamEvents_Heartbeat(t *testing.T) {
+	h := NewStreamEventsHandler(events.NewBroker(), memoryHistory{})
+	h.heartbeat = 10 * time.Millisecond
+	r := openStream(t, h, "/v1/events/stream", nil)
+
+	assert.Equal(t, ": keepalive\n", readFrame(t, r))
+}
+
+func TestHTTP_StreamEvents_InvalidLastEventID(t *testing.T) {
+	_, api := newAPI(t)
+	NewStreamEventsHandler(events.NewBroker(), memoryHistory{}).Register(api)
+
+	resp := api.Get("/v1/events/stream", "Last-Event-ID: abc")
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestHTTP_StreamEvents_UnknownType(t *testing.T) {
+	_, api := newAPI(t)
+	NewStreamEventsHandler(events.NewBroker(), memoryHistory{}).Register(api)
+
+	resp := api.Get("/v1/events/stream?types=Nope")
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
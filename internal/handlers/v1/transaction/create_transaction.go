@@ -10,27 +10,33 @@ import (
 	"github.com/gofrs/uuid/v5"
 	"github.com/shopspring/decimal"
 
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
 	"github.com/carson-networks/budget-server/internal/operator"
 	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/transaction"
 )
 
 // CreateTransactionBody is the request body for creating a transaction.
 type CreateTransactionBody struct {
 	AccountID       string `json:"accountID" required:"true" doc:"Account UUID"`
 	CategoryID      string `json:"categoryID" required:"true" doc:"Category UUID"`
-	Amount          string `json:"amount" required:"true" doc:"Decimal amount"`
+	Amount          string `json:"amount" required:"true" doc:"Signed decimal amount: negative for expenses, positive for income; reversed when isRefund is set"`
 	TransactionName string `json:"transactionName" required:"true" doc:"Name of the transaction"`
 	TransactionDate string `json:"transactionDate" doc:"RFC3339 transaction date, defaults to now"`
+	IsRefund        bool   `json:"isRefund,omitempty" doc:"Marks a refund or return, which reverses the category's usual sign"`
 }
 
 // CreateTransactionInput is the Huma input for creating a transaction.
 type CreateTransactionInput struct {
+	idempotent.Header
 	Body CreateTransactionBody
 }
 
 // CreateTransactionOutput is the Huma output for creating a transaction.
 type CreateTransactionOutput struct {
-	Status int `json:"status" doc:"HTTP status"`
+	Status   int    `json:"status" doc:"HTTP status"`
+	Location string `header:"Location" doc:"URL of the created transaction"`
+	Body     Transaction
 }
 
 // CreateTransactionHandler handles POST /v1/transaction.
@@ -50,28 +56,47 @@ func (h *CreateTransactionHandler) Register(api huma.API) {
 		Method:      http.MethodPost,
 		Path:        "/v1/transaction",
 		Summary:     "Create transaction",
-		Description: "Creates a new transaction.",
+		Description: "Creates a new transaction and returns it.",
 		Tags:        []string{"Transactions"},
 	}, h.handle)
 }
 
 func (h *CreateTransactionHandler) handle(ctx context.Context, input *CreateTransactionInput) (*CreateTransactionOutput, error) {
-	accountID, err := uuid.FromString(input.Body.AccountID)
+	action, err := input.Body.Action()
+	if err != nil {
+		return nil, err
+	}
+
+	return idempotent.Process(ctx, h.Operator, input.Header, "create-transaction", input.Body, action,
+		func(result any) *CreateTransactionOutput {
+			created := input.Body.Result(result).(Transaction)
+			return &CreateTransactionOutput{
+				Status:   http.StatusCreated,
+				Location: "/v1/transaction/" + created.ID,
+				Body:     created,
+			}
+		},
+		input.Body.MapError)
+}
+
+// Action converts the request body into the operator action.
+func (b CreateTransactionBody) Action() (actions.IAction, error) {
+	accountID, err := uuid.FromString(b.AccountID)
 	if err != nil {
 		return nil, huma.NewError(http.StatusBadRequest, "invalid accountID", err)
 	}
-	categoryID, err := uuid.FromString(input.Body.CategoryID)
+	categoryID, err := uuid.FromString(b.CategoryID)
 	if err != nil {
 		return nil, huma.NewError(http.StatusBadRequest, "invalid categoryID", err)
 	}
-	amount, err := decimal.NewFromString(input.Body.Amount)
+	amount, err := decimal.NewFromString(b.Amount)
 	if err != nil {
 		return nil, huma.NewError(http.StatusBadRequest, "invalid amount", err)
 	}
 
 	var transactionDate time.Time
-	if input.Body.TransactionDate != "" {
-		transactionDate, err = time.Parse(time.RFC3339, input.Body.TransactionDate)
+	if b.TransactionDate != "" {
+		transactionDate, err = time.Parse(time.RFC3339, b.TransactionDate)
 		if err != nil {
 			return nil, huma.NewError(http.StatusBadRequest, "invalid transactionDate", err)
 		}
@@ -79,28 +104,35 @@ func (h *CreateTransactionHandler) handle(ctx context.Context, input *CreateTran
 		transactionDate = time.Now()
 	}
 
-	action := &actions.CreateTransaction{
+	return &actions.CreateTransaction{
 		AccountID:       accountID,
 		CategoryID:      categoryID,
 		Amount:          amount,
-		TransactionName: input.Body.TransactionName,
+		TransactionName: b.TransactionName,
 		TransactionDate: transactionDate,
-	}
+		IsRefund:        b.IsRefund,
+	}, nil
+}
 
-	if err := h.Operator.Process(ctx, action); err != nil {
-		switch {
-		case errors.Is(err, actions.ErrCategoryNotFoundForTransaction):
-			return nil, huma.NewError(http.StatusNotFound, "Category not found", err)
-		case errors.Is(err, actions.ErrCategoryDisabled):
-			return nil, huma.NewError(http.StatusBadRequest, "Category is disabled", err)
-		case errors.Is(err, actions.ErrCategoryIsParent):
-			return nil, huma.NewError(http.StatusBadRequest, "Category is a parent; use a child category", err)
-		case errors.Is(err, actions.ErrAccountNotFound):
-			return nil, huma.NewError(http.StatusNotFound, "Account not found", err)
-		default:
-			return nil, huma.NewError(http.StatusInternalServerError, "failed to create transaction", err)
-		}
-	}
+// Result converts the action's result into the API model.
+func (b CreateTransactionBody) Result(result any) any {
+	return toAPITransaction(result.(*transaction.Transaction))
+}
 
-	return &CreateTransactionOutput{Status: http.StatusCreated}, nil
+// MapError converts an error from processing the action into an API error.
+func (b CreateTransactionBody) MapError(err error) huma.StatusError {
+	switch {
+	case errors.Is(err, actions.ErrCategoryNotFoundForTransaction):
+		return huma.NewError(http.StatusNotFound, "Category not found", err)
+	case errors.Is(err, actions.ErrCategoryDisabled):
+		return huma.NewError(http.StatusBadRequest, "Category is disabled", err)
+	case errors.Is(err, actions.ErrCategoryIsParent):
+		return huma.NewError(http.StatusBadRequest, "Category is a parent; use a child category", err)
+	case errors.Is(err, actions.ErrAmountSignMismatch):
+		return huma.NewError(http.StatusBadRequest, "Amount sign does not match category type; expenses are negative and income positive, reversed for refunds", err)
+	case errors.Is(err, actions.ErrAccountNotFound):
+		return huma.NewError(http.StatusNotFound, "Account not found", err)
+	default:
+		return huma.NewError(http.StatusInternalServerError, "failed to create transaction", err)
+	}
 }
@@ -1,6 +1,9 @@
 package transaction
 
 import (
+	"context"
+	"database/sql"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"testing"
@@ -11,9 +14,13 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/carson-networks/budget-server/internal/operator"
 	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/category"
+	"github.com/carson-networks/budget-server/internal/storage/transaction"
 )
 
 func newCreateTransactionTestAPI(t *testing.T, op operator.IProcessor) humatest.TestAPI {
@@ -27,6 +34,14 @@ func TestHTTP_CreateTransaction_SuccessWithExplicitDate(t *testing.T) {
 	accountID := uuid.Must(uuid.NewV4())
 	categoryID := uuid.Must(uuid.NewV4())
 	txnDate := time.Date(2025, 3, 5, 12, 0, 0, 0, time.UTC)
+	created := &transaction.Transaction{
+		ID:              uuid.Must(uuid.NewV4()),
+		AccountID:       accountID,
+		CategoryID:      categoryID,
+		Amount:          decimal.NewFromInt(-50),
+		TransactionName: "Groceries",
+		TransactionDate: txnDate,
+	}
 
 	mockOp := &operator.MockIProcessor{}
 	mockOp.EXPECT().
@@ -39,7 +54,7 @@ func TestHTTP_CreateTransaction_SuccessWithExplicitDate(t *testing.T) {
 				ct.TransactionName == "Groceries" &&
 				ct.TransactionDate.Equal(txnDate)
 		})).
-		Return(nil)
+		Return(created, nil)
 
 	resp := newCreateTransactionTestAPI(t, mockOp).Post("/v1/transaction", CreateTransactionBody{
 		AccountID:       accountID.String(),
@@ -49,7 +64,12 @@ func TestHTTP_CreateTransaction_SuccessWithExplicitDate(t *testing.T) {
 		TransactionDate: txnDate.Format(time.RFC3339),
 	})
 
-	assert.Equal(t, http.StatusCreated, resp.Code)
+	require.Equal(t, http.StatusCreated, resp.Code)
+	assert.Equal(t, "/v1/transaction/"+created.ID.String(), resp.Header().Get("Location"))
+	var body Transaction
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, created.ID.String(), body.ID)
+	assert.Equal(t, "-50", body.Amount)
 	mockOp.AssertExpectations(t)
 }
 
@@ -115,7 +135,7 @@ func TestHTTP_CreateTransaction_ProcessReturnsError(t *testing.T) {
 	mockOp := &operator.MockIProcessor{}
 	mockOp.EXPECT().
 		Process(mock.Anything, mock.Anything).
-		Return(processErr)
+		Return(nil, processErr)
 
 	resp := newCreateTransactionTestAPI(t, mockOp).Post("/v1/transaction", CreateTransactionBody{
 		AccountID:       uuid.Must(uuid.NewV4()).String(),
@@ -132,7 +152,7 @@ func TestHTTP_CreateTransaction_CategoryNotFound(t *testing.T) {
 	mockOp := &operator.MockIProcessor{}
 	mockOp.EXPECT().
 		Process(mock.Anything, mock.Anything).
-		Return(actions.ErrCategoryNotFoundForTransaction)
+		Return(nil, actions.ErrCategoryNotFoundForTransaction)
 
 	resp := newCreateTransactionTestAPI(t, mockOp).Post("/v1/transaction", CreateTransactionBody{
 		AccountID:       uuid.Must(uuid.NewV4()).String(),
@@ -149,7 +169,7 @@ func TestHTTP_CreateTransaction_CategoryDisabled(t *testing.T) {
 	mockOp := &operator.MockIProcessor{}
 	mockOp.EXPECT().
 		Process(mock.Anything, mock.Anything).
-		Return(actions.ErrCategoryDisabled)
+		Return(nil, actions.ErrCategoryDisabled)
 
 	resp := newCreateTransactionTestAPI(t, mockOp).Post("/v1/transaction", CreateTransactionBody{
 		AccountID:       uuid.Must(uuid.NewV4()).String(),
@@ -166,7 +186,7 @@ func TestHTTP_CreateTransaction_CategoryIsParent(t *testing.T) {
 	mockOp := &operator.MockIProcessor{}
 	mockOp.EXPECT().
 		Process(mock.Anything, mock.Anything).
-		Return(actions.ErrCategoryIsParent)
+		Return(nil, actions.ErrCategoryIsParent)
 
 	resp := newCreateTransactionTestAPI(t, mockOp).Post("/v1/transaction", CreateTransactionBody{
 		AccountID:       uuid.Must(uuid.NewV4()).String(),
@@ -178,3 +198,81 @@ func TestHTTP_CreateTransaction_CategoryIsParent(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, resp.Code)
 	mockOp.AssertExpectations(t)
 }
+
+func TestHTTP_CreateTransaction_AmountSignMismatch(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			ct, ok := a.(*actions.CreateTransaction)
+			return ok && ct.IsRefund
+		})).
+		Return(nil, actions.ErrAmountSignMismatch)
+
+	resp := newCreateTransactionTestAPI(t, mockOp).Post("/v1/transaction", CreateTransactionBody{
+		AccountID:       uuid.Must(uuid.NewV4()).String(),
+		CategoryID:      uuid.Must(uuid.NewV4()).String(),
+		Amount:          "-10",
+		TransactionName: "Test",
+		IsRefund:        true,
+	})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_CreateTransaction_IdempotencyKeyHeader(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			wrapped, ok := a.(*actions.Idempotent)
+			if !ok || wrapped.Key != "retry-123" {
+				return false
+			}
+			_, ok = wrapped.Action.(*actions.CreateTransaction)
+			return ok
+		})).
+		Run(func(_ context.Context, a actions.IAction) {
+			_, _ = a.(*actions.Idempotent).Response(&transaction.Transaction{ID: uuid.Must(uuid.NewV4())})
+		}).
+		Return(nil, nil)
+
+	resp := newCreateTransactionTestAPI(t, mockOp).Post("/v1/transaction", "Idempotency-Key: retry-123", CreateTransactionBody{
+		AccountID:       uuid.Must(uuid.NewV4()).String(),
+		CategoryID:      uuid.Must(uuid.NewV4()).String(),
+		Amount:          "-10",
+		TransactionName: "Test",
+	})
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_CreateTransaction_ForeignAccount(t *testing.T) {
+	categoryID := uuid.Must(uuid.NewV4())
+	foreignAccountID := uuid.Must(uuid.NewV4())
+	// Storage finds no account with another ledger's ID, as it only looks
+	// in the request's ledger.
+	wt := storage.NewWriterForTest()
+	wt.Category.(*storage.MockICategoryWriter).EXPECT().
+		GetByID(mock.Anything, categoryID).
+		Return(&category.Category{ID: categoryID, CategoryType: category.CatergoryType_Expense}, nil)
+	wt.Account.(*storage.MockIAccountWriter).EXPECT().
+		FindByIDForUpdate(mock.Anything, foreignAccountID).
+		Return(nil, sql.ErrNoRows)
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, a actions.IAction) (any, error) {
+			return a.Perform(ctx, wt)
+		})
+
+	resp := newCreateTransactionTestAPI(t, mockOp).Post("/v1/transaction", CreateTransactionBody{
+		AccountID:       foreignAccountID.String(),
+		CategoryID:      categoryID.String(),
+		Amount:          "-10",
+		TransactionName: "Test",
+	})
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	wt.Transaction.(*storage.MockITransactionWriter).AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,66 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/storage/transaction"
+)
+
+// GetTransactionInput is the Huma input for fetching a transaction.
+type GetTransactionInput struct {
+	ID string `path:"id" doc:"Transaction UUID"`
+}
+
+// GetTransactionOutput is the Huma output for fetching a transaction.
+type GetTransactionOutput struct {
+	Body Transaction
+}
+
+type transactionFinder interface {
+	FindByID(ctx context.Context, id uuid.UUID) (*transaction.Transaction, error)
+}
+
+// GetTransactionHandler handles GET /v1/transaction/{id}.
+type GetTransactionHandler struct {
+	TransactionReader transactionFinder
+}
+
+// NewGetTransactionHandler creates a new GetTransactionHandler.
+func NewGetTransactionHandler(reader transactionFinder) *GetTransactionHandler {
+	return &GetTransactionHandler{TransactionReader: reader}
+}
+
+// Register registers the get transaction endpoint with the Huma API.
+func (h *GetTransactionHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-transaction",
+		Method:      http.MethodGet,
+		Path:        "/v1/transaction/{id}",
+		Summary:     "Get transaction",
+		Description: "Returns a single transaction by ID.",
+		Tags:        []string{"Transactions"},
+	}, h.handle)
+}
+
+func (h *GetTransactionHandler) handle(ctx context.Context, input *GetTransactionInput) (*GetTransactionOutput, error) {
+	id, err := uuid.FromString(input.ID)
+	if err != nil {
+		return nil, huma.NewError(http.StatusBadRequest, "invalid transaction ID", err)
+	}
+
+	tx, err := h.TransactionReader.FindByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, huma.NewError(http.StatusNotFound, "transaction not found", err)
+		}
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to get transaction", err)
+	}
+
+	return &GetTransactionOutput{Body: toAPITransaction(tx)}, nil
+}
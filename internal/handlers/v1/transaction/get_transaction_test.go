@@ -0,0 +1,50 @@
+package transaction
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage/transaction"
+)
+
+func newGetTransactionTestAPI(t *testing.T, reader transactionFinder) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	NewGetTransactionHandler(reader).Register(api)
+	return api
+}
+
+func TestHTTP_GetTransaction_Success(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockReader := &mockTransactionReader{}
+	mockReader.On("FindByID", mock.Anything, id).
+		Return(&transaction.Transaction{ID: id, Amount: decimal.NewFromInt(-25), TransactionName: "Coffee"}, nil)
+
+	resp := newGetTransactionTestAPI(t, mockReader).Get("/v1/transaction/" + id.String())
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	var body Transaction
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, id.String(), body.ID)
+	assert.Equal(t, "-25", body.Amount)
+	mockReader.AssertExpectations(t)
+}
+
+func TestHTTP_GetTransaction_NotFound(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockReader := &mockTransactionReader{}
+	mockReader.On("FindByID", mock.Anything, id).Return(nil, sql.ErrNoRows)
+
+	resp := newGetTransactionTestAPI(t, mockReader).Get("/v1/transaction/" + id.String())
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
@@ -6,22 +6,33 @@ import (
 	"time"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
 
 	"github.com/carson-networks/budget-server/internal/logging"
+	"github.com/carson-networks/budget-server/internal/pagination"
 	"github.com/carson-networks/budget-server/internal/storage/transaction"
 )
 
-// ListTransactionsCursor represents a pagination cursor in request and response bodies.
-// It bundles position, limit, and maxCreationTime so subsequent pages use consistent parameters.
-type ListTransactionsCursor struct {
-	Position        int    `json:"position" minimum:"0" doc:"Numeric offset position for the next page"`
-	Limit           int    `json:"limit" minimum:"1" maximum:"100" doc:"Page size used for this cursor"`
-	MaxCreationTime string `json:"maxCreationTime" format:"date-time" doc:"Upper bound on created_at locked in from the first page"`
-}
+// transactionsCursorScope binds transaction list cursors so they cannot be replayed against other listings.
+const transactionsCursorScope = "transactions"
 
 // ListTransactionsBody is the request body for listing transactions.
+// A cursor is only valid with the same filters it was issued for.
 type ListTransactionsBody struct {
-	Cursor *ListTransactionsCursor `json:"cursor,omitempty" doc:"Cursor from a previous response to fetch the next page"`
+	Cursor     string `json:"cursor,omitempty" doc:"Opaque cursor from a previous response to fetch the next page"`
+	Limit      int    `json:"limit,omitempty" minimum:"0" maximum:"100" doc:"Page size, default 20; ignored when a cursor is given"`
+	AccountID  string `json:"accountID,omitempty" format:"uuid" doc:"Only return transactions on this account"`
+	CategoryID string `json:"categoryID,omitempty" format:"uuid" doc:"Only return transactions in this category"`
+	FromDate   string `json:"fromDate,omitempty" format:"date-time" doc:"Only return transactions dated at or after this time"`
+	ToDate     string `json:"toDate,omitempty" format:"date-time" doc:"Only return transactions dated before this time"`
+}
+
+// listTransactionsFilter is the part of the request a cursor is bound to.
+type listTransactionsFilter struct {
+	AccountID  string `json:"accountID"`
+	CategoryID string `json:"categoryID"`
+	FromDate   string `json:"fromDate"`
+	ToDate     string `json:"toDate"`
 }
 
 // ListTransactionsInput is the Huma input for listing transactions.
@@ -31,8 +42,8 @@ type ListTransactionsInput struct {
 
 // ListTransactionsResponseBody is the response body for listing transactions.
 type ListTransactionsResponseBody struct {
-	Transactions []Transaction           `json:"transactions" doc:"Page of transactions"`
-	NextCursor   *ListTransactionsCursor `json:"nextCursor,omitempty" doc:"Cursor to fetch the next page, absent on the last page"`
+	Transactions []Transaction `json:"transactions" doc:"Page of transactions"`
+	NextCursor   string        `json:"nextCursor,omitempty" doc:"Opaque cursor to fetch the next page, absent on the last page"`
 }
 
 // ListTransactionsOutput is the Huma output for listing transactions.
@@ -48,11 +59,12 @@ type transactionReader interface {
 // ListTransactionsHandler handles POST /v1/transaction/list.
 type ListTransactionsHandler struct {
 	TransactionReader transactionReader
+	Cursors           *pagination.Codec
 }
 
 // NewListTransactionsHandler creates a new ListTransactionsHandler.
-func NewListTransactionsHandler(reader transactionReader) *ListTransactionsHandler {
-	return &ListTransactionsHandler{TransactionReader: reader}
+func NewListTransactionsHandler(reader transactionReader, cursors *pagination.Codec) *ListTransactionsHandler {
+	return &ListTransactionsHandler{TransactionReader: reader, Cursors: cursors}
 }
 
 // Register registers the list transactions endpoint with the Huma API.
@@ -62,46 +74,71 @@ func (h *ListTransactionsHandler) Register(api huma.API) {
 		Method:      http.MethodPost,
 		Path:        "/v1/transaction/list",
 		Summary:     "List transactions",
-		Description: "Returns a paginated list of transactions using cursor-based pagination.",
+		Description: "Returns a paginated list of transactions, newest first, using keyset cursors.",
 		Tags:        []string{"Transactions"},
 	}, h.handle)
 }
 
-// parseListTransactionsInput parses and validates the API input.
-// When a cursor is provided, limit and maxCreationTime come from it.
-// Without a cursor, the reader uses its default limit.
-func parseListTransactionsInput(input *ListTransactionsInput) (*transaction.TransactionFilter, error) {
-	limit := 20
-	offset := 0
-	var maxCreationTime *time.Time
-
-	if input.Body.Cursor != nil {
-		if input.Body.Cursor.Position < 0 {
-			return nil, huma.NewError(http.StatusBadRequest, "cursor position must be non-negative")
+// parseListTransactionsInput parses and validates the API input and returns
+// the storage filter along with the hash cursors for it are bound to.
+// When a cursor is provided, the limit and starting position come from it.
+func (h *ListTransactionsHandler) parseListTransactionsInput(input *ListTransactionsInput) (*transaction.TransactionFilter, string, error) {
+	filter := &transaction.TransactionFilter{
+		Limit: pagination.Limit(input.Body.Limit),
+	}
+	if input.Body.AccountID != "" {
+		accountID, err := uuid.FromString(input.Body.AccountID)
+		if err != nil {
+			return nil, "", huma.NewError(http.StatusBadRequest, "invalid accountID", err)
 		}
-		offset = input.Body.Cursor.Position
-		if input.Body.Cursor.Limit > 0 {
-			limit = input.Body.Cursor.Limit
+		filter.AccountID = &accountID
+	}
+	if input.Body.CategoryID != "" {
+		categoryID, err := uuid.FromString(input.Body.CategoryID)
+		if err != nil {
+			return nil, "", huma.NewError(http.StatusBadRequest, "invalid categoryID", err)
 		}
-		if input.Body.Cursor.MaxCreationTime != "" {
-			parsed, parseErr := time.Parse(time.RFC3339, input.Body.Cursor.MaxCreationTime)
-			if parseErr != nil {
-				return nil, huma.NewError(http.StatusBadRequest, "invalid cursor maxCreationTime", parseErr)
-			}
-			maxCreationTime = &parsed
+		filter.CategoryID = &categoryID
+	}
+	if input.Body.FromDate != "" {
+		fromDate, err := time.Parse(time.RFC3339, input.Body.FromDate)
+		if err != nil {
+			return nil, "", huma.NewError(http.StatusBadRequest, "invalid fromDate", err)
+		}
+		filter.FromDate = &fromDate
+	}
+	if input.Body.ToDate != "" {
+		toDate, err := time.Parse(time.RFC3339, input.Body.ToDate)
+		if err != nil {
+			return nil, "", huma.NewError(http.StatusBadRequest, "invalid toDate", err)
 		}
+		filter.ToDate = &toDate
 	}
 
-	return &transaction.TransactionFilter{
-		Limit:           limit,
-		Offset:          offset,
-		MaxCreationTime: maxCreationTime,
-	}, nil
+	filterHash, err := pagination.FilterHash(listTransactionsFilter{
+		AccountID:  input.Body.AccountID,
+		CategoryID: input.Body.CategoryID,
+		FromDate:   input.Body.FromDate,
+		ToDate:     input.Body.ToDate,
+	})
+	if err != nil {
+		return nil, "", huma.NewError(http.StatusInternalServerError, "failed to hash filter", err)
+	}
+
+	if input.Body.Cursor != "" {
+		cursor, err := pagination.Decode[pagination.TimeKey](h.Cursors, transactionsCursorScope, filterHash, input.Body.Cursor)
+		if err != nil {
+			return nil, "", huma.NewError(http.StatusBadRequest, "invalid cursor", err)
+		}
+		filter.Limit = cursor.Limit
+		filter.After = &cursor.After
+	}
+	return filter, filterHash, nil
 }
 
 func (h *ListTransactionsHandler) handle(ctx context.Context, input *ListTransactionsInput) (*ListTransactionsOutput, error) {
 	logData := logging.GetLogData(ctx)
-	filter, err := parseListTransactionsInput(input)
+	filter, filterHash, err := h.parseListTransactionsInput(input)
 	if err != nil {
 		return nil, err
 	}
@@ -132,24 +169,28 @@ func (h *ListTransactionsHandler) handle(ctx context.Context, input *ListTransac
 	}
 
 	for i, tx := range transactions {
-		resp.Transactions[i] = Transaction{
-			ID:              tx.ID.String(),
-			AccountID:       tx.AccountID.String(),
-			CategoryID:      tx.CategoryID.String(),
-			Amount:          tx.Amount.String(),
-			TransactionName: tx.TransactionName,
-			TransactionDate: tx.TransactionDate.Format(time.RFC3339),
-			CreatedAt:       tx.CreatedAt.Format(time.RFC3339),
-		}
+		resp.Transactions[i] = toAPITransaction(tx)
 	}
 
 	if result.NextCursor != nil {
-		resp.NextCursor = &ListTransactionsCursor{
-			Position:        result.NextCursor.Position,
-			Limit:           result.NextCursor.Limit,
-			MaxCreationTime: result.NextCursor.MaxCreationTime.Format(time.RFC3339),
+		resp.NextCursor, err = pagination.Encode(h.Cursors, transactionsCursorScope, filterHash, result.NextCursor)
+		if err != nil {
+			return nil, huma.NewError(http.StatusInternalServerError, "failed to encode cursor", err)
 		}
 	}
 
 	return &ListTransactionsOutput{Body: resp}, nil
 }
+
+func toAPITransaction(tx *transaction.Transaction) Transaction {
+	return Transaction{
+		ID:              tx.ID.String(),
+		AccountID:       tx.AccountID.String(),
+		CategoryID:      tx.CategoryID.String(),
+		Amount:          tx.Amount.String(),
+		TransactionName: tx.TransactionName,
+		TransactionDate: tx.TransactionDate.Format(time.RFC3339),
+		CreatedAt:       tx.CreatedAt.Format(time.RFC3339),
+		IsRefund:        tx.IsRefund,
+	}
+}
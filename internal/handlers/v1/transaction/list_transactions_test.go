@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/carson-networks/budget-server/internal/pagination"
 	"github.com/carson-networks/budget-server/internal/storage/transaction"
 )
 
@@ -27,82 +28,128 @@ func (m *mockTransactionReader) List(ctx context.Context, filter *transaction.Tr
 	return result, args.Error(1)
 }
 
+func (m *mockTransactionReader) FindByID(ctx context.Context, id uuid.UUID) (*transaction.Transaction, error) {
+	args := m.Called(ctx, id)
+	result, _ := args.Get(0).(*transaction.Transaction)
+	return result, args.Error(1)
+}
+
+var testCursors = pagination.NewCodec([]byte("test-key"))
+
 func newListTestAPI(t *testing.T, reader transactionReader) humatest.TestAPI {
 	t.Helper()
 	_, api := humatest.New(t)
-	NewListTransactionsHandler(reader).Register(api)
+	NewListTransactionsHandler(reader, testCursors).Register(api)
 	return api
 }
 
+// encodeTestCursor encodes a cursor bound to the given filter, or to the
+// unfiltered listing when filter is nil.
+func encodeTestCursor(t *testing.T, cursor *pagination.Cursor[pagination.TimeKey], filter *listTransactionsFilter) string {
+	t.Helper()
+	token, err := pagination.Encode(testCursors, transactionsCursorScope, testFilterHash(t, filter), cursor)
+	assert.NoError(t, err)
+	return token
+}
+
+func testFilterHash(t *testing.T, filter *listTransactionsFilter) string {
+	t.Helper()
+	if filter == nil {
+		filter = &listTransactionsFilter{}
+	}
+	hash, err := pagination.FilterHash(*filter)
+	assert.NoError(t, err)
+	return hash
+}
+
 // -- parseListTransactionsInput unit tests --
 
 func TestParseListTransactionsInput_NoCursor(t *testing.T) {
+	h := NewListTransactionsHandler(nil, testCursors)
 	input := &ListTransactionsInput{
 		Body: ListTransactionsBody{},
 	}
 
-	filter, err := parseListTransactionsInput(input)
+	filter, _, err := h.parseListTransactionsInput(input)
 	assert.NoError(t, err)
 	assert.NotNil(t, filter)
 	assert.Equal(t, 20, filter.Limit)
-	assert.Equal(t, 0, filter.Offset)
-	assert.Nil(t, filter.MaxCreationTime)
+	assert.Nil(t, filter.After)
 }
 
 func TestParseListTransactionsInput_WithCursor(t *testing.T) {
-	cursorMaxTime := "2025-06-15T08:00:00Z"
+	h := NewListTransactionsHandler(nil, testCursors)
+	after := pagination.TimeKey{
+		CreatedAt: time.Date(2025, 6, 15, 8, 0, 0, 0, time.UTC),
+		ID:        uuid.Must(uuid.NewV4()),
+	}
 
 	input := &ListTransactionsInput{
 		Body: ListTransactionsBody{
-			Cursor: &ListTransactionsCursor{
-				Position:        40,
-				Limit:           10,
-				MaxCreationTime: cursorMaxTime,
-			},
+			Cursor: encodeTestCursor(t, &pagination.Cursor[pagination.TimeKey]{After: after, Limit: 10}, nil),
+			Limit:  50,
 		},
 	}
 
-	filter, err := parseListTransactionsInput(input)
+	filter, _, err := h.parseListTransactionsInput(input)
 	assert.NoError(t, err)
-
-	expectedMax, _ := time.Parse(time.RFC3339, cursorMaxTime)
 	assert.NotNil(t, filter)
-	assert.Equal(t, 40, filter.Offset)
 	assert.Equal(t, 10, filter.Limit)
-	assert.NotNil(t, filter.MaxCreationTime)
-	assert.True(t, filter.MaxCreationTime.Equal(expectedMax))
+	assert.NotNil(t, filter.After)
+	assert.Equal(t, after.ID, filter.After.ID)
+	assert.True(t, filter.After.CreatedAt.Equal(after.CreatedAt))
 }
 
-func TestParseListTransactionsInput_InvalidCursorMaxCreationTime(t *testing.T) {
+func TestParseListTransactionsInput_InvalidCursor(t *testing.T) {
+	h := NewListTransactionsHandler(nil, testCursors)
 	input := &ListTransactionsInput{
 		Body: ListTransactionsBody{
-			Cursor: &ListTransactionsCursor{
-				Position:        0,
-				Limit:           10,
-				MaxCreationTime: "not-a-date",
-			},
+			Cursor: "not-a-cursor",
 		},
 	}
 
-	_, err := parseListTransactionsInput(input)
+	_, _, err := h.parseListTransactionsInput(input)
 	assert.Error(t, err)
 }
 
-func TestParseListTransactionsInput_CursorPositionZero(t *testing.T) {
-	input := &ListTransactionsInput{
+func TestParseListTransactionsInput_CursorFromOtherScope(t *testing.T) {
+	h := NewListTransactionsHandler(nil, testCursors)
+	token, err := pagination.Encode(testCursors, "accounts", testFilterHash(t, nil), &pagination.Cursor[pagination.TimeKey]{Limit: 10})
+	assert.NoError(t, err)
+
+	_, _, err = h.parseListTransactionsInput(&ListTransactionsInput{
+		Body: ListTransactionsBody{Cursor: token},
+	})
+	assert.Error(t, err)
+}
+
+func TestParseListTransactionsInput_Filters(t *testing.T) {
+	h := NewListTransactionsHandler(nil, testCursors)
+	accountID := uuid.Must(uuid.NewV4())
+	categoryID := uuid.Must(uuid.NewV4())
+
+	filter, filterHash, err := h.parseListTransactionsInput(&ListTransactionsInput{
 		Body: ListTransactionsBody{
-			Cursor: &ListTransactionsCursor{
-				Position:        0,
-				Limit:           20,
-				MaxCreationTime: "2025-06-01T12:00:00Z",
-			},
+			AccountID:  accountID.String(),
+			CategoryID: categoryID.String(),
+			FromDate:   "2025-06-01T00:00:00Z",
+			ToDate:     "2025-07-01T00:00:00Z",
 		},
-	}
-
-	filter, err := parseListTransactionsInput(input)
+	})
 	assert.NoError(t, err)
-	assert.NotNil(t, filter)
-	assert.Equal(t, 0, filter.Offset)
+	assert.Equal(t, accountID, *filter.AccountID)
+	assert.Equal(t, categoryID, *filter.CategoryID)
+	assert.True(t, filter.FromDate.Equal(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, filter.ToDate.Equal(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)))
+	assert.NotEqual(t, testFilterHash(t, nil), filterHash)
+}
+
+func TestParseListTransactionsInput_InvalidAccountID(t *testing.T) {
+	h := NewListTransactionsHandler(nil, testCursors)
+	_, _, err := h.parseListTransactionsInput(&ListTransactionsInput{
+		Body: ListTransactionsBody{AccountID: "not-a-uuid"},
+	})
+	assert.Error(t, err)
 }
 
 // -- HTTP integration tests --
@@ -135,7 +182,7 @@ func TestHTTP_ListTransactions_SinglePage(t *testing.T) {
 	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
 	assert.Len(t, body.Transactions, 1)
 	assert.Equal(t, txID.String(), body.Transactions[0].ID)
-	assert.Nil(t, body.NextCursor)
+	assert.Empty(t, body.NextCursor)
 	mockReader.AssertExpectations(t)
 }
 
@@ -168,10 +215,9 @@ func TestHTTP_ListTransactions_MultiplePages(t *testing.T) {
 	mockReader.On("List", mock.Anything, mock.Anything).
 		Return(&transaction.TransactionListResult{
 			Transactions: txs,
-			NextCursor: &transaction.TransactionCursor{
-				Position:        svcDefaultLimit,
-				Limit:           svcDefaultLimit,
-				MaxCreationTime: now,
+			NextCursor: &pagination.Cursor[pagination.TimeKey]{
+				After: pagination.TimeKey{CreatedAt: now, ID: txs[1].ID},
+				Limit: svcDefaultLimit,
 			},
 		}, nil)
 
@@ -181,41 +227,43 @@ func TestHTTP_ListTransactions_MultiplePages(t *testing.T) {
 	var body ListTransactionsResponseBody
 	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
 	assert.Len(t, body.Transactions, 2)
-	assert.NotNil(t, body.NextCursor)
-	assert.Equal(t, svcDefaultLimit, body.NextCursor.Position)
-	assert.Equal(t, svcDefaultLimit, body.NextCursor.Limit)
-	assert.Equal(t, now.Format(time.RFC3339), body.NextCursor.MaxCreationTime)
+	assert.NotEmpty(t, body.NextCursor)
+
+	cursor, err := pagination.Decode[pagination.TimeKey](testCursors, transactionsCursorScope, testFilterHash(t, nil), body.NextCursor)
+	assert.NoError(t, err)
+	assert.Equal(t, svcDefaultLimit, cursor.Limit)
+	assert.Equal(t, txs[1].ID, cursor.After.ID)
+	assert.True(t, cursor.After.CreatedAt.Equal(now))
 	mockReader.AssertExpectations(t)
 }
 
 func TestHTTP_ListTransactions_WithCursor(t *testing.T) {
-	maxTime := time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC)
+	after := pagination.TimeKey{
+		CreatedAt: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+		ID:        uuid.Must(uuid.NewV4()),
+	}
 
 	mockReader := new(mockTransactionReader)
 	mockReader.On("List", mock.Anything, mock.MatchedBy(func(f *transaction.TransactionFilter) bool {
 		return f != nil &&
-			f.Offset == 40 &&
 			f.Limit == 10 &&
-			f.MaxCreationTime != nil &&
-			f.MaxCreationTime.Equal(maxTime)
+			f.After != nil &&
+			f.After.ID == after.ID &&
+			f.After.CreatedAt.Equal(after.CreatedAt)
 	})).Return(&transaction.TransactionListResult{
 		Transactions: nil,
 		NextCursor:   nil,
 	}, nil)
 
 	resp := newListTestAPI(t, mockReader).Post("/v1/transaction/list", ListTransactionsBody{
-		Cursor: &ListTransactionsCursor{
-			Position:        40,
-			Limit:           10,
-			MaxCreationTime: maxTime.Format(time.RFC3339),
-		},
+		Cursor: encodeTestCursor(t, &pagination.Cursor[pagination.TimeKey]{After: after, Limit: 10}, nil),
 	})
 
 	assert.Equal(t, http.StatusOK, resp.Code)
 	var body ListTransactionsResponseBody
 	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
 	assert.Empty(t, body.Transactions)
-	assert.Nil(t, body.NextCursor)
+	assert.Empty(t, body.NextCursor)
 	mockReader.AssertExpectations(t)
 }
 
@@ -233,7 +281,7 @@ func TestHTTP_ListTransactions_NoResults(t *testing.T) {
 	var body ListTransactionsResponseBody
 	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
 	assert.Empty(t, body.Transactions)
-	assert.Nil(t, body.NextCursor)
+	assert.Empty(t, body.NextCursor)
 	mockReader.AssertExpectations(t)
 }
 
@@ -248,17 +296,46 @@ func TestHTTP_ListTransactions_ServiceError(t *testing.T) {
 	mockReader.AssertExpectations(t)
 }
 
-func TestHTTP_ListTransactions_InvalidCursorMaxCreationTime(t *testing.T) {
+func TestHTTP_ListTransactions_InvalidCursor(t *testing.T) {
 	mockReader := new(mockTransactionReader)
 
 	resp := newListTestAPI(t, mockReader).Post("/v1/transaction/list", ListTransactionsBody{
-		Cursor: &ListTransactionsCursor{
-			Position:        0,
-			Limit:           10,
-			MaxCreationTime: "not-a-date",
-		},
+		Cursor: "tampered.cursor",
 	})
 
-	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
 	mockReader.AssertNotCalled(t, "List")
 }
+
+func TestHTTP_ListTransactions_CursorFromOtherFilter(t *testing.T) {
+	mockReader := new(mockTransactionReader)
+	issuedFor := &listTransactionsFilter{AccountID: uuid.Must(uuid.NewV4()).String()}
+	token := encodeTestCursor(t, &pagination.Cursor[pagination.TimeKey]{Limit: 10}, issuedFor)
+
+	resp := newListTestAPI(t, mockReader).Post("/v1/transaction/list", ListTransactionsBody{
+		Cursor:    token,
+		AccountID: uuid.Must(uuid.NewV4()).String(),
+	})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockReader.AssertNotCalled(t, "List")
+}
+
+func TestHTTP_ListTransactions_CursorWithSameFilter(t *testing.T) {
+	accountID := uuid.Must(uuid.NewV4())
+	issuedFor := &listTransactionsFilter{AccountID: accountID.String()}
+	token := encodeTestCursor(t, &pagination.Cursor[pagination.TimeKey]{Limit: 10}, issuedFor)
+
+	mockReader := new(mockTransactionReader)
+	mockReader.On("List", mock.Anything, mock.MatchedBy(func(f *transaction.TransactionFilter) bool {
+		return f.AccountID != nil && *f.AccountID == accountID && f.Limit == 10
+	})).Return(&transaction.TransactionListResult{}, nil)
+
+	resp := newListTestAPI(t, mockReader).Post("/v1/transaction/list", ListTransactionsBody{
+		Cursor:    token,
+		AccountID: accountID.String(),
+	})
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockReader.AssertExpectations(t)
+}
@@ -10,4 +10,5 @@ type Transaction struct {
 	TransactionName string `json:"transactionName" doc:"Name of the transaction"`
 	TransactionDate string `json:"transactionDate" doc:"RFC3339 transaction date"`
 	CreatedAt       string `json:"createdAt" doc:"RFC3339 creation timestamp"`
+	IsRefund        bool   `json:"isRefund" doc:"Whether the transaction reverses earlier activity in its category"`
 }
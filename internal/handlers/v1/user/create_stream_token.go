@@ -0,0 +1,88 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+// StreamTokenResponseBody is the response body for creating a stream token.
+type StreamTokenResponseBody struct {
+	Token     string `json:"token" doc:"Stream token, sent as the token query parameter of GET /v1/events/stream"`
+	ExpiresAt string `json:"expiresAt" doc:"RFC3339 time after which the token can no longer open a stream"`
+}
+
+// CreateStreamTokenInput is the Huma input for creating a stream token. It
+// takes no Idempotency-Key: replaying the response would mean storing the
+// token.
+type CreateStreamTokenInput struct{}
+
+// CreateStreamTokenOutput is the Huma output for creating a stream token.
+type CreateStreamTokenOutput struct {
+	Status int `json:"status" doc:"HTTP status"`
+	Body   StreamTokenResponseBody
+}
+
+// CreateStreamTokenHandler handles POST /v1/auth/stream-tokens.
+type CreateStreamTokenHandler struct {
+	Operator operator.IProcessor
+	now      func() time.Time
+}
+
+// NewCreateStreamTokenHandler creates a new CreateStreamTokenHandler.
+func NewCreateStreamTokenHandler(op operator.IProcessor) *CreateStreamTokenHandler {
+	return &CreateStreamTokenHandler{Operator: op, now: time.Now}
+}
+
+// Register registers the create stream token endpoint with the Huma API.
+func (h *CreateStreamTokenHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-stream-token",
+		Method:      http.MethodPost,
+		Path:        "/v1/auth/stream-tokens",
+		Summary:     "Create stream token",
+		Description: "Creates a read-only token that expires after 5 minutes, for opening GET /v1/events/stream from a browser, " +
+			"whose EventSource can't send an Authorization header. The token is accepted nowhere else.",
+		Tags: []string{"Auth"},
+	}, h.handle)
+}
+
+func (h *CreateStreamTokenHandler) handle(ctx context.Context, _ *CreateStreamTokenInput) (*CreateStreamTokenOutput, error) {
+	p, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, hash, err := auth.NewToken()
+	if err != nil {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to generate token", err)
+	}
+	expiresAt := h.now().Add(auth.StreamTokenTTL)
+	action := &actions.CreateToken{
+		UserID:    p.UserID,
+		Name:      "stream",
+		Kind:      user.TokenKindStream,
+		Scope:     user.ScopeRead,
+		Hash:      hash,
+		ExpiresAt: &expiresAt,
+	}
+
+	return idempotent.Process(ctx, h.Operator, idempotent.Header{}, "create-stream-token", nil, action,
+		func(any) *CreateStreamTokenOutput {
+			return &CreateStreamTokenOutput{
+				Status: http.StatusCreated,
+				Body:   StreamTokenResponseBody{Token: token, ExpiresAt: expiresAt.Format(time.RFC3339)},
+			}
+		},
+		func(err error) huma.StatusError {
+			return huma.NewError(http.StatusInternalServerError, "failed to create stream token", err)
+		})
+}
@@ -0,0 +1,67 @@
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+func newCreateStreamTokenTestAPI(t *testing.T, op operator.IProcessor, principal *auth.Principal) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	authenticateAs(api, principal)
+	h := NewCreateStreamTokenHandler(op)
+	h.now = func() time.Time { return tokenNow }
+	h.Register(api)
+	return api
+}
+
+func TestHTTP_CreateStreamToken_Success(t *testing.T) {
+	principal := &auth.Principal{UserID: uuid.Must(uuid.NewV4()), Scope: user.ScopeWrite}
+
+	var issued *actions.CreateToken
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			issued, _ = a.(*actions.CreateToken)
+			return issued != nil
+		})).
+		Return(&user.Token{ID: uuid.Must(uuid.NewV4())}, nil)
+
+	resp := newCreateStreamTokenTestAPI(t, mockOp, principal).Post("/v1/auth/stream-tokens")
+
+	require.Equal(t, http.StatusCreated, resp.Code)
+	var body StreamTokenResponseBody
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "2026-03-01T12:05:00Z", body.ExpiresAt)
+	assert.Equal(t, auth.HashToken(body.Token), issued.Hash)
+	assert.Equal(t, principal.UserID, issued.UserID)
+	assert.Equal(t, user.TokenKindStream, issued.Kind)
+	assert.Equal(t, user.ScopeRead, issued.Scope)
+	require.NotNil(t, issued.ExpiresAt)
+	assert.Equal(t, tokenNow.Add(auth.StreamTokenTTL), *issued.ExpiresAt)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_CreateStreamToken_Unauthenticated(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	_, api := humatest.New(t)
+	NewCreateStreamTokenHandler(mockOp).Register(api)
+
+	resp := api.Post("/v1/auth/stream-tokens")
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	mockOp.AssertNotCalled(t, "Process", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,98 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+// CreateTokenBody is the request body for creating a personal token.
+type CreateTokenBody struct {
+	Name      string     `json:"name" required:"true" minLength:"1" maxLength:"100" doc:"Label for the token, e.g. what uses it"`
+	Scope     string     `json:"scope" required:"true" enum:"read,write" doc:"read tokens may only make GET requests"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty" doc:"RFC3339 expiry; omit for a token that lasts until revoked"`
+}
+
+// CreatedToken is a newly created personal token along with its secret.
+type CreatedToken struct {
+	Token
+	Secret string `json:"token" doc:"The token, sent as \"Authorization: Bearer <token>\"; only returned on creation"`
+}
+
+// CreateTokenInput is the Huma input for creating a personal token. It takes
+// no Idempotency-Key: replaying the response would mean storing the token.
+type CreateTokenInput struct {
+	Body CreateTokenBody
+}
+
+// CreateTokenOutput is the Huma output for creating a personal token.
+type CreateTokenOutput struct {
+	Status int `json:"status" doc:"HTTP status"`
+	Body   CreatedToken
+}
+
+// CreateTokenHandler handles POST /v1/auth/tokens.
+type CreateTokenHandler struct {
+	Operator operator.IProcessor
+	now      func() time.Time
+}
+
+// NewCreateTokenHandler creates a new CreateTokenHandler.
+func NewCreateTokenHandler(op operator.IProcessor) *CreateTokenHandler {
+	return &CreateTokenHandler{Operator: op, now: time.Now}
+}
+
+// Register registers the create token endpoint with the Huma API.
+func (h *CreateTokenHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-token",
+		Method:      http.MethodPost,
+		Path:        "/v1/auth/tokens",
+		Summary:     "Create API token",
+		Description: "Creates a long-lived personal API token for scripts and integrations.",
+		Tags:        []string{"Auth"},
+	}, h.handle)
+}
+
+func (h *CreateTokenHandler) handle(ctx context.Context, input *CreateTokenInput) (*CreateTokenOutput, error) {
+	p, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if input.Body.ExpiresAt != nil && !input.Body.ExpiresAt.After(h.now()) {
+		return nil, huma.NewError(http.StatusBadRequest, "expiresAt must be in the future")
+	}
+
+	secret, hash, err := auth.NewToken()
+	if err != nil {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to generate token", err)
+	}
+	action := &actions.CreateToken{
+		UserID:    p.UserID,
+		Name:      input.Body.Name,
+		Kind:      user.TokenKindPersonal,
+		Scope:     user.Scope(input.Body.Scope),
+		Hash:      hash,
+		ExpiresAt: input.Body.ExpiresAt,
+	}
+
+	return idempotent.Process(ctx, h.Operator, idempotent.Header{}, "create-token", nil, action,
+		func(result any) *CreateTokenOutput {
+			created := result.(*user.Token)
+			return &CreateTokenOutput{
+				Status: http.StatusCreated,
+				Body:   CreatedToken{Token: toAPIToken(created), Secret: secret},
+			}
+		},
+		func(err error) huma.StatusError {
+			return huma.NewError(http.StatusInternalServerError, "failed to create token", err)
+		})
+}
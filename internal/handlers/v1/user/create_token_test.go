@@ -0,0 +1,87 @@
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+var tokenNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newCreateTokenTestAPI(t *testing.T, op operator.IProcessor, principal *auth.Principal) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	authenticateAs(api, principal)
+	h := NewCreateTokenHandler(op)
+	h.now = func() time.Time { return tokenNow }
+	h.Register(api)
+	return api
+}
+
+func TestHTTP_CreateToken_Success(t *testing.T) {
+	principal := &auth.Principal{UserID: uuid.Must(uuid.NewV4()), Scope: user.ScopeWrite}
+	expiresAt := tokenNow.Add(30 * 24 * time.Hour)
+	created := &user.Token{ID: uuid.Must(uuid.NewV4()), Name: "dashboard", Scope: user.ScopeRead, ExpiresAt: &expiresAt, CreatedAt: tokenNow}
+
+	var issued *actions.CreateToken
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			issued, _ = a.(*actions.CreateToken)
+			return issued != nil
+		})).
+		Return(created, nil)
+
+	resp := newCreateTokenTestAPI(t, mockOp, principal).Post("/v1/auth/tokens", CreateTokenBody{
+		Name:      "dashboard",
+		Scope:     "read",
+		ExpiresAt: &expiresAt,
+	})
+
+	require.Equal(t, http.StatusCreated, resp.Code)
+	var body CreatedToken
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, created.ID.String(), body.ID)
+	assert.Equal(t, "read", body.Scope)
+	assert.Equal(t, auth.HashToken(body.Secret), issued.Hash)
+	assert.Equal(t, principal.UserID, issued.UserID)
+	assert.Equal(t, user.TokenKindPersonal, issued.Kind)
+	assert.Equal(t, user.ScopeRead, issued.Scope)
+	require.NotNil(t, issued.ExpiresAt)
+	assert.True(t, expiresAt.Equal(*issued.ExpiresAt))
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_CreateToken_Invalid(t *testing.T) {
+	past := tokenNow.Add(-time.Hour)
+	tests := []struct {
+		name       string
+		body       CreateTokenBody
+		wantStatus int
+	}{
+		{"unknown scope", CreateTokenBody{Name: "x", Scope: "admin"}, http.StatusUnprocessableEntity},
+		{"expiry in the past", CreateTokenBody{Name: "x", Scope: "read", ExpiresAt: &past}, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOp := &operator.MockIProcessor{}
+
+			resp := newCreateTokenTestAPI(t, mockOp, &auth.Principal{Scope: user.ScopeWrite}).Post("/v1/auth/tokens", tt.body)
+
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			mockOp.AssertNotCalled(t, "Process", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+// CreateUserBody is the request body for creating a user.
+type CreateUserBody struct {
+	Username string `json:"username" required:"true" minLength:"1" maxLength:"64" doc:"Name the user signs in with"`
+	// bcrypt ignores everything past 72 bytes.
+	Password string `json:"password" required:"true" minLength:"12" maxLength:"72" doc:"Password the user signs in with"`
+}
+
+// CreateUserInput is the Huma input for creating a user.
+type CreateUserInput struct {
+	idempotent.Header
+	Body CreateUserBody
+}
+
+// CreateUserOutput is the Huma output for creating a user.
+type CreateUserOutput struct {
+	Status int `json:"status" doc:"HTTP status"`
+	Body   User
+}
+
+// CreateUserHandler handles POST /v1/users.
+type CreateUserHandler struct {
+	Operator operator.IProcessor
+}
+
+// NewCreateUserHandler creates a new CreateUserHandler.
+func NewCreateUserHandler(op operator.IProcessor) *CreateUserHandler {
+	return &CreateUserHandler{Operator: op}
+}
+
+// Register registers the create user endpoint with the Huma API.
+func (h *CreateUserHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-user",
+		Method:      http.MethodPost,
+		Path:        "/v1/users",
+		Summary:     "Create user",
+		Description: "Creates a user who can sign in with POST /v1/auth/login. " +
+			"Needs a write token, except for the first user of a new install, which is created without one.",
+		Tags: []string{"Auth"},
+	}, h.handle)
+}
+
+func (h *CreateUserHandler) handle(ctx context.Context, input *CreateUserInput) (*CreateUserOutput, error) {
+	hash, err := auth.HashPassword(input.Body.Password)
+	if err != nil {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to hash password", err)
+	}
+	action := &actions.CreateUser{
+		Username:     input.Body.Username,
+		PasswordHash: hash,
+		FirstUser:    auth.FromContext(ctx) == nil,
+	}
+
+	// The request hash covers the password too, so a retry with another
+	// password is a conflict rather than a replay. Only its digest is stored.
+	return idempotent.Process(ctx, h.Operator, input.Header, "create-user", input.Body, action,
+		func(result any) *CreateUserOutput {
+			return &CreateUserOutput{Status: http.StatusCreated, Body: toAPIUser(result.(*user.User))}
+		},
+		func(err error) huma.StatusError {
+			switch {
+			case errors.Is(err, actions.ErrUsersExist):
+				return huma.NewError(http.StatusUnauthorized, "authentication required")
+			case errors.Is(err, user.ErrUsernameTaken):
+				return huma.NewError(http.StatusConflict, "username is taken", err)
+			default:
+				return huma.NewError(http.StatusInternalServerError, "failed to create user", err)
+			}
+		})
+}
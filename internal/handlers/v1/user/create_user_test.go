@@ -0,0 +1,118 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+func newCreateUserTestAPI(t *testing.T, op operator.IProcessor, principal *auth.Principal) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	if principal != nil {
+		authenticateAs(api, principal)
+	}
+	NewCreateUserHandler(op).Register(api)
+	return api
+}
+
+func TestHTTP_CreateUser_FirstUser(t *testing.T) {
+	created := &user.User{ID: uuid.Must(uuid.NewV4()), Username: "sam"}
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			cu, ok := a.(*actions.CreateUser)
+			return ok &&
+				cu.Username == "sam" &&
+				cu.FirstUser &&
+				bcrypt.CompareHashAndPassword([]byte(cu.PasswordHash), []byte("correct horse battery")) == nil
+		})).
+		Return(created, nil)
+
+	resp := newCreateUserTestAPI(t, mockOp, nil).Post("/v1/users", CreateUserBody{Username: "sam", Password: "correct horse battery"})
+
+	require.Equal(t, http.StatusCreated, resp.Code)
+	var body User
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, created.ID.String(), body.ID)
+	assert.Equal(t, "sam", body.Username)
+	assert.NotContains(t, resp.Body.String(), "password")
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_CreateUser_Authenticated(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			cu, ok := a.(*actions.CreateUser)
+			return ok && !cu.FirstUser
+		})).
+		Return(&user.User{ID: uuid.Must(uuid.NewV4()), Username: "alex"}, nil)
+
+	resp := newCreateUserTestAPI(t, mockOp, &auth.Principal{Username: "sam", Scope: user.ScopeWrite}).
+		Post("/v1/users", CreateUserBody{Username: "alex", Password: "correct horse battery"})
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_CreateUser_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"users exist", actions.ErrUsersExist, http.StatusUnauthorized},
+		{"username taken", user.ErrUsernameTaken, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOp := &operator.MockIProcessor{}
+			mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, tt.err)
+
+			resp := newCreateUserTestAPI(t, mockOp, nil).Post("/v1/users", CreateUserBody{Username: "sam", Password: "correct horse battery"})
+
+			assert.Equal(t, tt.wantStatus, resp.Code)
+		})
+	}
+}
+
+func TestHTTP_CreateUser_ShortPassword(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+
+	resp := newCreateUserTestAPI(t, mockOp, nil).Post("/v1/users", CreateUserBody{Username: "sam", Password: "short"})
+
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	mockOp.AssertNotCalled(t, "Process", mock.Anything, mock.Anything)
+}
+
+func TestHTTP_CreateUser_RequestHashCoversPassword(t *testing.T) {
+	var hashes [][]byte
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, a actions.IAction) {
+			hashes = append(hashes, a.(*actions.Idempotent).RequestHash)
+		}).
+		Return(&user.User{ID: uuid.Must(uuid.NewV4()), Username: "sam"}, nil)
+	api := newCreateUserTestAPI(t, mockOp, nil)
+
+	api.Post("/v1/users", "Idempotency-Key: signup-1", CreateUserBody{Username: "sam", Password: "correct horse battery"})
+	api.Post("/v1/users", "Idempotency-Key: signup-1", CreateUserBody{Username: "sam", Password: "another long password"})
+
+	require.Len(t, hashes, 2)
+	assert.NotEqual(t, hashes[0], hashes[1])
+}
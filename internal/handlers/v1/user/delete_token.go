@@ -0,0 +1,76 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+)
+
+// DeleteTokenInput is the Huma input for revoking a token.
+type DeleteTokenInput struct {
+	idempotent.Header
+	ID string `path:"id" doc:"Token UUID"`
+}
+
+// DeleteTokenOutput is the Huma output for revoking a token.
+type DeleteTokenOutput struct {
+	Status int `json:"status" doc:"HTTP status"`
+}
+
+// DeleteTokenHandler handles DELETE /v1/auth/tokens/{id}.
+type DeleteTokenHandler struct {
+	Operator operator.IProcessor
+}
+
+// NewDeleteTokenHandler creates a new DeleteTokenHandler.
+func NewDeleteTokenHandler(op operator.IProcessor) *DeleteTokenHandler {
+	return &DeleteTokenHandler{Operator: op}
+}
+
+// Register registers the delete token endpoint with the Huma API.
+func (h *DeleteTokenHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-token",
+		Method:      http.MethodDelete,
+		Path:        "/v1/auth/tokens/{id}",
+		Summary:     "Revoke API token",
+		Description: "Revokes one of the caller's tokens.",
+		Tags:        []string{"Auth"},
+	}, h.handle)
+}
+
+func (h *DeleteTokenHandler) handle(ctx context.Context, input *DeleteTokenInput) (*DeleteTokenOutput, error) {
+	p, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.FromString(input.ID)
+	if err != nil {
+		return nil, huma.NewError(http.StatusBadRequest, "invalid token id", err)
+	}
+
+	scopedBody := struct {
+		ID string `json:"id"`
+	}{input.ID}
+	return idempotent.Process(ctx, h.Operator, input.Header, "delete-token", scopedBody,
+		&actions.RevokeToken{UserID: p.UserID, TokenID: id},
+		func(any) *DeleteTokenOutput { return &DeleteTokenOutput{Status: http.StatusNoContent} },
+		mapTokenError("failed to revoke token"))
+}
+
+// mapTokenError converts an error from revoking a token into an API error.
+func mapTokenError(msg string) func(error) huma.StatusError {
+	return func(err error) huma.StatusError {
+		if errors.Is(err, actions.ErrTokenNotFound) {
+			return huma.NewError(http.StatusNotFound, "token not found", err)
+		}
+		return huma.NewError(http.StatusInternalServerError, msg, err)
+	}
+}
@@ -0,0 +1,55 @@
+package user
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+)
+
+func newDeleteTokenTestAPI(t *testing.T, op operator.IProcessor, principal *auth.Principal) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	authenticateAs(api, principal)
+	NewDeleteTokenHandler(op).Register(api)
+	return api
+}
+
+func TestHTTP_DeleteToken_Success(t *testing.T) {
+	principal := &auth.Principal{UserID: uuid.Must(uuid.NewV4())}
+	id := uuid.Must(uuid.NewV4())
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, &actions.RevokeToken{UserID: principal.UserID, TokenID: id}).
+		Return(nil, nil)
+
+	resp := newDeleteTokenTestAPI(t, mockOp, principal).Delete("/v1/auth/tokens/" + id.String())
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_DeleteToken_NotFound(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, actions.ErrTokenNotFound)
+
+	resp := newDeleteTokenTestAPI(t, mockOp, &auth.Principal{UserID: uuid.Must(uuid.NewV4())}).Delete("/v1/auth/tokens/" + uuid.Must(uuid.NewV4()).String())
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func TestHTTP_DeleteToken_InvalidID(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+
+	resp := newDeleteTokenTestAPI(t, mockOp, &auth.Principal{}).Delete("/v1/auth/tokens/not-a-uuid")
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockOp.AssertNotCalled(t, "Process", mock.Anything, mock.Anything)
+}
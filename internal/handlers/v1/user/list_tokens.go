@@ -0,0 +1,60 @@
+package user
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+// ListTokensResponseBody is the response body for listing personal tokens.
+type ListTokensResponseBody struct {
+	Tokens []Token `json:"tokens" doc:"The caller's personal API tokens, oldest first"`
+}
+
+// ListTokensOutput is the Huma output for listing personal tokens.
+type ListTokensOutput struct {
+	Body ListTokensResponseBody
+}
+
+// ListTokensHandler handles GET /v1/auth/tokens.
+type ListTokensHandler struct {
+	UserReader userReader
+}
+
+// NewListTokensHandler creates a new ListTokensHandler.
+func NewListTokensHandler(reader userReader) *ListTokensHandler {
+	return &ListTokensHandler{UserReader: reader}
+}
+
+// Register registers the list tokens endpoint with the Huma API.
+func (h *ListTokensHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-tokens",
+		Method:      http.MethodGet,
+		Path:        "/v1/auth/tokens",
+		Summary:     "List API tokens",
+		Description: "Returns the caller's personal API tokens. Session tokens from login are not listed.",
+		Tags:        []string{"Auth"},
+	}, h.handle)
+}
+
+func (h *ListTokensHandler) handle(ctx context.Context, _ *struct{}) (*ListTokensOutput, error) {
+	p, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := h.UserReader.ListTokens(ctx, p.UserID, user.TokenKindPersonal)
+	if err != nil {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to list tokens", err)
+	}
+
+	body := ListTokensResponseBody{Tokens: make([]Token, len(tokens))}
+	for i, token := range tokens {
+		body.Tokens[i] = toAPIToken(token)
+	}
+	return &ListTokensOutput{Body: body}, nil
+}
@@ -0,0 +1,48 @@
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+func newListTokensTestAPI(t *testing.T, reader userReader, principal *auth.Principal) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	authenticateAs(api, principal)
+	NewListTokensHandler(reader).Register(api)
+	return api
+}
+
+func TestHTTP_ListTokens_Success(t *testing.T) {
+	principal := &auth.Principal{UserID: uuid.Must(uuid.NewV4()), Scope: user.ScopeRead}
+	created := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	expires := created.Add(90 * 24 * time.Hour)
+	tokens := []*user.Token{
+		{ID: uuid.Must(uuid.NewV4()), Name: "sync", Kind: user.TokenKindPersonal, Scope: user.ScopeWrite, CreatedAt: created},
+		{ID: uuid.Must(uuid.NewV4()), Name: "dashboard", Kind: user.TokenKindPersonal, Scope: user.ScopeRead, ExpiresAt: &expires, CreatedAt: created},
+	}
+	reader := &mockUserReader{}
+	reader.On("ListTokens", mock.Anything, principal.UserID, user.TokenKindPersonal).Return(tokens, nil)
+
+	resp := newListTokensTestAPI(t, reader, principal).Get("/v1/auth/tokens")
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	var body ListTokensResponseBody
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Tokens, 2)
+	assert.Equal(t, Token{ID: tokens[0].ID.String(), Name: "sync", Scope: "write", CreatedAt: "2026-03-01T12:00:00Z"}, body.Tokens[0])
+	require.NotNil(t, body.Tokens[1].ExpiresAt)
+	assert.Equal(t, "2026-05-30T12:00:00Z", *body.Tokens[1].ExpiresAt)
+	reader.AssertExpectations(t)
+}
@@ -0,0 +1,96 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+// LoginBody is the request body for signing in.
+type LoginBody struct {
+	Username string `json:"username" required:"true" doc:"Name the user signs in with"`
+	Password string `json:"password" required:"true" doc:"The user's password"`
+}
+
+// LoginResponseBody is the response body for signing in.
+type LoginResponseBody struct {
+	Token     string `json:"token" doc:"Session token, sent as \"Authorization: Bearer <token>\""`
+	ExpiresAt string `json:"expiresAt" doc:"RFC3339 time the token expires"`
+}
+
+// LoginInput is the Huma input for signing in. It takes no Idempotency-Key:
+// replaying a login would mean storing the token it issued.
+type LoginInput struct {
+	Body LoginBody
+}
+
+// LoginOutput is the Huma output for signing in.
+type LoginOutput struct {
+	Body LoginResponseBody
+}
+
+// LoginHandler handles POST /v1/auth/login.
+type LoginHandler struct {
+	Operator   operator.IProcessor
+	UserReader userReader
+	now        func() time.Time
+}
+
+// NewLoginHandler creates a new LoginHandler.
+func NewLoginHandler(op operator.IProcessor, reader userReader) *LoginHandler {
+	return &LoginHandler{Operator: op, UserReader: reader, now: time.Now}
+}
+
+// Register registers the login endpoint with the Huma API.
+func (h *LoginHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "login",
+		Method:      http.MethodPost,
+		Path:        "/v1/auth/login",
+		Summary:     "Log in",
+		Description: "Exchanges a username and password for a session token with write scope that expires after 24 hours.",
+		Tags:        []string{"Auth"},
+	}, h.handle)
+}
+
+func (h *LoginHandler) handle(ctx context.Context, input *LoginInput) (*LoginOutput, error) {
+	u, err := h.UserReader.GetByUsername(ctx, input.Body.Username)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to get user", err)
+	}
+	if !auth.CheckPassword(u, input.Body.Password) {
+		return nil, huma.NewError(http.StatusUnauthorized, "invalid username or password")
+	}
+
+	token, hash, err := auth.NewToken()
+	if err != nil {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to generate token", err)
+	}
+	expiresAt := h.now().Add(auth.SessionTTL)
+	action := &actions.CreateToken{
+		UserID:    u.ID,
+		Name:      "login",
+		Kind:      user.TokenKindSession,
+		Scope:     user.ScopeWrite,
+		Hash:      hash,
+		ExpiresAt: &expiresAt,
+	}
+
+	return idempotent.Process(ctx, h.Operator, idempotent.Header{}, "login", nil, action,
+		func(any) *LoginOutput {
+			return &LoginOutput{Body: LoginResponseBody{Token: token, ExpiresAt: expiresAt.Format(time.RFC3339)}}
+		},
+		func(err error) huma.StatusError {
+			return huma.NewError(http.StatusInternalServerError, "failed to create session", err)
+		})
+}
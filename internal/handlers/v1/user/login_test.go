@@ -0,0 +1,80 @@
+package user
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+var loginNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newLoginTestAPI(t *testing.T, op operator.IProcessor, reader userReader) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	h := NewLoginHandler(op, reader)
+	h.now = func() time.Time { return loginNow }
+	h.Register(api)
+	return api
+}
+
+func TestHTTP_Login_Success(t *testing.T) {
+	hash, err := auth.HashPassword("correct horse battery")
+	require.NoError(t, err)
+	sam := &user.User{ID: uuid.Must(uuid.NewV4()), Username: "sam", PasswordHash: hash}
+	reader := &mockUserReader{}
+	reader.On("GetByUsername", mock.Anything, "sam").Return(sam, nil)
+
+	var issued *actions.CreateToken
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			issued, _ = a.(*actions.CreateToken)
+			return issued != nil
+		})).
+		Return(&user.Token{ID: uuid.Must(uuid.NewV4())}, nil)
+
+	resp := newLoginTestAPI(t, mockOp, reader).Post("/v1/auth/login", LoginBody{Username: "sam", Password: "correct horse battery"})
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	var body LoginResponseBody
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "2026-03-02T12:00:00Z", body.ExpiresAt)
+	assert.Equal(t, auth.HashToken(body.Token), issued.Hash)
+	assert.Equal(t, sam.ID, issued.UserID)
+	assert.Equal(t, user.TokenKindSession, issued.Kind)
+	assert.Equal(t, user.ScopeWrite, issued.Scope)
+	require.NotNil(t, issued.ExpiresAt)
+	assert.Equal(t, loginNow.Add(auth.SessionTTL), *issued.ExpiresAt)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_Login_Rejected(t *testing.T) {
+	hash, err := auth.HashPassword("correct horse battery")
+	require.NoError(t, err)
+	reader := &mockUserReader{}
+	reader.On("GetByUsername", mock.Anything, "sam").Return(&user.User{Username: "sam", PasswordHash: hash}, nil)
+	reader.On("GetByUsername", mock.Anything, "nobody").Return(nil, sql.ErrNoRows)
+	mockOp := &operator.MockIProcessor{}
+	api := newLoginTestAPI(t, mockOp, reader)
+
+	resp := api.Post("/v1/auth/login", LoginBody{Username: "sam", Password: "wrong horse battery"})
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	resp = api.Post("/v1/auth/login", LoginBody{Username: "nobody", Password: "correct horse battery"})
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	mockOp.AssertNotCalled(t, "Process", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,51 @@
+package user
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+)
+
+// LogoutOutput is the Huma output for signing out.
+type LogoutOutput struct {
+	Status int `json:"status" doc:"HTTP status"`
+}
+
+// LogoutHandler handles POST /v1/auth/logout.
+type LogoutHandler struct {
+	Operator operator.IProcessor
+}
+
+// NewLogoutHandler creates a new LogoutHandler.
+func NewLogoutHandler(op operator.IProcessor) *LogoutHandler {
+	return &LogoutHandler{Operator: op}
+}
+
+// Register registers the logout endpoint with the Huma API.
+func (h *LogoutHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "logout",
+		Method:      http.MethodPost,
+		Path:        "/v1/auth/logout",
+		Summary:     "Log out",
+		Description: "Revokes the token the request was made with.",
+		Tags:        []string{"Auth"},
+	}, h.handle)
+}
+
+func (h *LogoutHandler) handle(ctx context.Context, _ *struct{}) (*LogoutOutput, error) {
+	p, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	action := &actions.RevokeToken{UserID: p.UserID, TokenID: p.TokenID}
+	return idempotent.Process(ctx, h.Operator, idempotent.Header{}, "logout", nil, action,
+		func(any) *LogoutOutput { return &LogoutOutput{Status: http.StatusNoContent} },
+		mapTokenError("failed to log out"))
+}
@@ -0,0 +1,47 @@
+package user
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+)
+
+func newLogoutTestAPI(t *testing.T, op operator.IProcessor, principal *auth.Principal) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	if principal != nil {
+		authenticateAs(api, principal)
+	}
+	NewLogoutHandler(op).Register(api)
+	return api
+}
+
+func TestHTTP_Logout_RevokesCurrentToken(t *testing.T) {
+	principal := &auth.Principal{UserID: uuid.Must(uuid.NewV4()), TokenID: uuid.Must(uuid.NewV4())}
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, &actions.RevokeToken{UserID: principal.UserID, TokenID: principal.TokenID}).
+		Return(nil, nil)
+
+	resp := newLogoutTestAPI(t, mockOp, principal).Post("/v1/auth/logout")
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_Logout_Unauthenticated(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+
+	resp := newLogoutTestAPI(t, mockOp, nil).Post("/v1/auth/logout")
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	mockOp.AssertNotCalled(t, "Process", mock.Anything, mock.Anything)
+}
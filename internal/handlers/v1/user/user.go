@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+// User is the API response model for a user.
+type User struct {
+	ID        string `json:"id" doc:"User UUID"`
+	Username  string `json:"username" doc:"Name the user signs in with"`
+	CreatedAt string `json:"createdAt" doc:"RFC3339 creation timestamp"`
+}
+
+// Token is the API response model for a personal API token. The token
+// itself is only returned when it is created.
+type Token struct {
+	ID        string  `json:"id" doc:"Token UUID"`
+	Name      string  `json:"name" doc:"Label the token was created with"`
+	Scope     string  `json:"scope" doc:"read or write"`
+	ExpiresAt *string `json:"expiresAt,omitempty" doc:"RFC3339 expiry; absent when the token lasts until revoked"`
+	CreatedAt string  `json:"createdAt" doc:"RFC3339 creation timestamp"`
+}
+
+// userReader is the interface for reading users and their tokens.
+type userReader interface {
+	GetByUsername(ctx context.Context, username string) (*user.User, error)
+	ListTokens(ctx context.Context, userID uuid.UUID, kind user.TokenKind) ([]*user.Token, error)
+}
+
+func toAPIUser(u *user.User) User {
+	return User{
+		ID:        u.ID.String(),
+		Username:  u.Username,
+		CreatedAt: u.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toAPIToken(t *user.Token) Token {
+	token := Token{
+		ID:        t.ID.String(),
+		Name:      t.Name,
+		Scope:     string(t.Scope),
+		CreatedAt: t.CreatedAt.Format(time.RFC3339),
+	}
+	if t.ExpiresAt != nil {
+		expiresAt := t.ExpiresAt.Format(time.RFC3339)
+		token.ExpiresAt = &expiresAt
+	}
+	return token
+}
+
+// principal returns the authenticated user, or a 401 when the request
+// reached the handler without one.
+func principal(ctx context.Context) (*auth.Principal, error) {
+	p := auth.FromContext(ctx)
+	if p == nil {
+		return nil, huma.NewError(http.StatusUnauthorized, "authentication required")
+	}
+	return p, nil
+}
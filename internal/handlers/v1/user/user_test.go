@@ -0,0 +1,41 @@
+package user
+
+import (
+	"context"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/carson-networks/budget-server/internal/auth"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+type mockUserReader struct {
+	mock.Mock
+}
+
+func (m *mockUserReader) GetByUsername(ctx context.Context, username string) (*user.User, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *mockUserReader) ListTokens(ctx context.Context, userID uuid.UUID, kind user.TokenKind) ([]*user.Token, error) {
+	args := m.Called(ctx, userID, kind)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*user.Token), args.Error(1)
+}
+
+// authenticateAs stands in for the auth middleware, attaching principal to
+// every request made through api.
+func authenticateAs(api humatest.TestAPI, principal *auth.Principal) {
+	api.UseMiddleware(func(ctx huma.Context, next func(huma.Context)) {
+		next(huma.WithContext(ctx, auth.WithPrincipal(ctx.Context(), principal)))
+	})
+}
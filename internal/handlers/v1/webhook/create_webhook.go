@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+// CreateWebhookBody is the request body for creating a webhook.
+type CreateWebhookBody struct {
+	URL        string   `json:"url" required:"true" doc:"URL events are POSTed to; loopback, private and link-local addresses are refused unless the server allows them"`
+	EventTypes []string `json:"eventTypes,omitempty" doc:"Event types to deliver; omit for every event"`
+	Secret     string   `json:"secret,omitempty" minLength:"16" doc:"HMAC-SHA256 signing secret; generated when omitted"`
+}
+
+// CreatedWebhook is a newly created webhook along with its signing secret.
+type CreatedWebhook struct {
+	Webhook
+	Secret string `json:"secret" doc:"HMAC-SHA256 signing secret; only returned on creation"`
+}
+
+// CreateWebhookInput is the Huma input for creating a webhook.
+type CreateWebhookInput struct {
+	idempotent.Header
+	Body CreateWebhookBody
+}
+
+// CreateWebhookOutput is the Huma output for creating a webhook.
+type CreateWebhookOutput struct {
+	Status   int    `json:"status" doc:"HTTP status"`
+	Location string `header:"Location" doc:"URL of the created webhook"`
+	Body     CreatedWebhook
+}
+
+// CreateWebhookHandler handles POST /v1/webhooks.
+type CreateWebhookHandler struct {
+	Operator operator.IProcessor
+}
+
+// NewCreateWebhookHandler creates a new CreateWebhookHandler.
+func NewCreateWebhookHandler(op operator.IProcessor) *CreateWebhookHandler {
+	return &CreateWebhookHandler{Operator: op}
+}
+
+// Register registers the create webhook endpoint with the Huma API.
+func (h *CreateWebhookHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "create-webhook",
+		Method:      http.MethodPost,
+		Path:        "/v1/webhooks",
+		Summary:     "Create webhook",
+		Description: "Subscribes a URL to domain events. Each delivery is a POST of one event, signed in the X-Budget-Signature header.",
+		Tags:        []string{"Webhooks"},
+	}, h.handle)
+}
+
+func (h *CreateWebhookHandler) handle(ctx context.Context, input *CreateWebhookInput) (*CreateWebhookOutput, error) {
+	action, err := input.Body.Action()
+	if err != nil {
+		return nil, err
+	}
+
+	return idempotent.Process(ctx, h.Operator, input.Header, "create-webhook", input.Body, action,
+		func(result any) *CreateWebhookOutput {
+			created := result.(*webhook.Webhook)
+			return &CreateWebhookOutput{
+				Status:   http.StatusCreated,
+				Location: "/v1/webhooks/" + created.ID.String(),
+				Body:     CreatedWebhook{Webhook: toAPIWebhook(created), Secret: created.Secret},
+			}
+		},
+		func(err error) huma.StatusError {
+			return huma.NewError(http.StatusInternalServerError, "failed to create webhook", err)
+		})
+}
+
+// Action converts the request body into the operator action.
+func (b CreateWebhookBody) Action() (actions.IAction, error) {
+	if err := validateURL(b.URL); err != nil {
+		return nil, err
+	}
+	if err := validateEventTypes(b.EventTypes); err != nil {
+		return nil, err
+	}
+
+	secret := b.Secret
+	if secret == "" {
+		generated, err := newSecret()
+		if err != nil {
+			return nil, huma.NewError(http.StatusInternalServerError, "failed to generate secret", err)
+		}
+		secret = generated
+	}
+	return &actions.CreateWebhook{URL: b.URL, Secret: secret, EventTypes: b.EventTypes}, nil
+}
+
+func newSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+func newCreateWebhookTestAPI(t *testing.T, op operator.IProcessor) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	NewCreateWebhookHandler(op).Register(api)
+	return api
+}
+
+func TestHTTP_CreateWebhook_Success(t *testing.T) {
+	created := &webhook.Webhook{
+		ID:         uuid.Must(uuid.NewV4()),
+		URL:        "https://example.com/hook",
+		Secret:     "0123456789abcdef",
+		EventTypes: []string{"TransactionCreated"},
+	}
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			cw, ok := a.(*actions.CreateWebhook)
+			return ok &&
+				cw.URL == created.URL &&
+				cw.Secret == created.Secret &&
+				assert.ObjectsAreEqual(created.EventTypes, cw.EventTypes)
+		})).
+		Return(created, nil)
+
+	resp := newCreateWebhookTestAPI(t, mockOp).Post("/v1/webhooks", CreateWebhookBody{
+		URL:        created.URL,
+		EventTypes: created.EventTypes,
+		Secret:     created.Secret,
+	})
+
+	require.Equal(t, http.StatusCreated, resp.Code)
+	assert.Equal(t, "/v1/webhooks/"+created.ID.String(), resp.Header().Get("Location"))
+	var body CreatedWebhook
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, created.ID.String(), body.ID)
+	assert.Equal(t, created.Secret, body.Secret)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_CreateWebhook_GeneratesSecret(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			cw, ok := a.(*actions.CreateWebhook)
+			return ok && len(cw.Secret) == 64
+		})).
+		Return(&webhook.Webhook{ID: uuid.Must(uuid.NewV4())}, nil)
+
+	resp := newCreateWebhookTestAPI(t, mockOp).Post("/v1/webhooks", CreateWebhookBody{URL: "http://localhost:9000/hook"})
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_CreateWebhook_InvalidURL(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+
+	resp := newCreateWebhookTestAPI(t, mockOp).Post("/v1/webhooks", CreateWebhookBody{URL: "ftp://example.com"})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockOp.AssertNotCalled(t, "Process", mock.Anything, mock.Anything)
+}
+
+func TestHTTP_CreateWebhook_UnknownEventType(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+
+	resp := newCreateWebhookTestAPI(t, mockOp).Post("/v1/webhooks", CreateWebhookBody{
+		URL:        "https://example.com/hook",
+		EventTypes: []string{"AccountExploded"},
+	})
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockOp.AssertNotCalled(t, "Process", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+)
+
+// DeleteWebhookInput is the Huma input for deleting a webhook.
+type DeleteWebhookInput struct {
+	idempotent.Header
+	ID string `path:"id" doc:"Webhook UUID"`
+}
+
+// DeleteWebhookOutput is the Huma output for deleting a webhook.
+type DeleteWebhookOutput struct {
+	Status int `json:"status" doc:"HTTP status"`
+}
+
+// DeleteWebhookHandler handles DELETE /v1/webhooks/{id}.
+type DeleteWebhookHandler struct {
+	Operator operator.IProcessor
+}
+
+// NewDeleteWebhookHandler creates a new DeleteWebhookHandler.
+func NewDeleteWebhookHandler(op operator.IProcessor) *DeleteWebhookHandler {
+	return &DeleteWebhookHandler{Operator: op}
+}
+
+// Register registers the delete webhook endpoint with the Huma API.
+func (h *DeleteWebhookHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-webhook",
+		Method:      http.MethodDelete,
+		Path:        "/v1/webhooks/{id}",
+		Summary:     "Delete webhook",
+		Description: "Deletes a webhook subscription, its queued deliveries and its delivery log.",
+		Tags:        []string{"Webhooks"},
+	}, h.handle)
+}
+
+func (h *DeleteWebhookHandler) handle(ctx context.Context, input *DeleteWebhookInput) (*DeleteWebhookOutput, error) {
+	id, err := parseWebhookID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	scopedBody := struct {
+		ID string `json:"id"`
+	}{input.ID}
+	return idempotent.Process(ctx, h.Operator, input.Header, "delete-webhook", scopedBody, &actions.DeleteWebhook{ID: id},
+		func(any) *DeleteWebhookOutput { return &DeleteWebhookOutput{Status: http.StatusNoContent} },
+		mapWebhookError("failed to delete webhook"))
+}
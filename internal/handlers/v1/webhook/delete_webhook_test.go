@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+)
+
+func newDeleteWebhookTestAPI(t *testing.T, op operator.IProcessor) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	NewDeleteWebhookHandler(op).Register(api)
+	return api
+}
+
+func TestHTTP_DeleteWebhook_Success(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			dw, ok := a.(*actions.DeleteWebhook)
+			return ok && dw.ID == id
+		})).
+		Return(nil, nil)
+
+	resp := newDeleteWebhookTestAPI(t, mockOp).Delete("/v1/webhooks/" + id.String())
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	mockOp.AssertExpectations(t)
+}
+
+func TestHTTP_DeleteWebhook_NotFound(t *testing.T) {
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, actions.ErrWebhookNotFound)
+
+	resp := newDeleteWebhookTestAPI(t, mockOp).Delete("/v1/webhooks/" + uuid.Must(uuid.NewV4()).String())
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
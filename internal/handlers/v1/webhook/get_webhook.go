@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// GetWebhookInput is the Huma input for fetching a webhook.
+type GetWebhookInput struct {
+	ID string `path:"id" doc:"Webhook UUID"`
+}
+
+// GetWebhookOutput is the Huma output for fetching a webhook.
+type GetWebhookOutput struct {
+	Body Webhook
+}
+
+// GetWebhookHandler handles GET /v1/webhooks/{id}.
+type GetWebhookHandler struct {
+	WebhookReader webhookReader
+}
+
+// NewGetWebhookHandler creates a new GetWebhookHandler.
+func NewGetWebhookHandler(reader webhookReader) *GetWebhookHandler {
+	return &GetWebhookHandler{WebhookReader: reader}
+}
+
+// Register registers the get webhook endpoint with the Huma API.
+func (h *GetWebhookHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "get-webhook",
+		Method:      http.MethodGet,
+		Path:        "/v1/webhooks/{id}",
+		Summary:     "Get webhook",
+		Description: "Returns a single webhook subscription by ID.",
+		Tags:        []string{"Webhooks"},
+	}, h.handle)
+}
+
+func (h *GetWebhookHandler) handle(ctx context.Context, input *GetWebhookInput) (*GetWebhookOutput, error) {
+	id, err := parseWebhookID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	hook, err := h.WebhookReader.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, huma.NewError(http.StatusNotFound, "webhook not found", err)
+		}
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to get webhook", err)
+	}
+
+	return &GetWebhookOutput{Body: toAPIWebhook(hook)}, nil
+}
@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+func newGetWebhookTestAPI(t *testing.T, reader webhookReader) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	NewGetWebhookHandler(reader).Register(api)
+	return api
+}
+
+func TestHTTP_GetWebhook_Success(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockReader := &mockWebhookReader{}
+	mockReader.On("GetByID", mock.Anything, id).
+		Return(&webhook.Webhook{ID: id, URL: "https://example.com/hook", EventTypes: []string{"AccountCreated"}}, nil)
+
+	resp := newGetWebhookTestAPI(t, mockReader).Get("/v1/webhooks/" + id.String())
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	var body Webhook
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, id.String(), body.ID)
+	assert.Equal(t, []string{"AccountCreated"}, body.EventTypes)
+	mockReader.AssertExpectations(t)
+}
+
+func TestHTTP_GetWebhook_NotFound(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockReader := &mockWebhookReader{}
+	mockReader.On("GetByID", mock.Anything, id).Return(nil, sql.ErrNoRows)
+
+	resp := newGetWebhookTestAPI(t, mockReader).Get("/v1/webhooks/" + id.String())
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func TestHTTP_GetWebhook_InvalidID(t *testing.T) {
+	resp := newGetWebhookTestAPI(t, &mockWebhookReader{}).Get("/v1/webhooks/not-a-uuid")
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
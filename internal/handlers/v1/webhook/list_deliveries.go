@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/pagination"
+)
+
+// ListDeliveriesInput is the Huma input for a webhook's delivery log.
+type ListDeliveriesInput struct {
+	ID    string `path:"id" doc:"Webhook UUID"`
+	Limit int    `query:"limit" minimum:"1" maximum:"100" doc:"Number of deliveries, default 20"`
+}
+
+// ListDeliveriesResponseBody is the response body for a webhook's delivery log.
+type ListDeliveriesResponseBody struct {
+	Deliveries []Delivery `json:"deliveries" doc:"Most recent deliveries, newest first"`
+}
+
+// ListDeliveriesOutput is the Huma output for a webhook's delivery log.
+type ListDeliveriesOutput struct {
+	Body ListDeliveriesResponseBody
+}
+
+// ListDeliveriesHandler handles GET /v1/webhooks/{id}/deliveries.
+type ListDeliveriesHandler struct {
+	WebhookReader webhookReader
+}
+
+// NewListDeliveriesHandler creates a new ListDeliveriesHandler.
+func NewListDeliveriesHandler(reader webhookReader) *ListDeliveriesHandler {
+	return &ListDeliveriesHandler{WebhookReader: reader}
+}
+
+// Register registers the list deliveries endpoint with the Huma API.
+func (h *ListDeliveriesHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-webhook-deliveries",
+		Method:      http.MethodGet,
+		Path:        "/v1/webhooks/{id}/deliveries",
+		Summary:     "List webhook deliveries",
+		Description: "Returns the webhook's most recent deliveries, including pending retries and test deliveries.",
+		Tags:        []string{"Webhooks"},
+	}, h.handle)
+}
+
+func (h *ListDeliveriesHandler) handle(ctx context.Context, input *ListDeliveriesInput) (*ListDeliveriesOutput, error) {
+	id, err := parseWebhookID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.WebhookReader.GetByID(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, huma.NewError(http.StatusNotFound, "webhook not found", err)
+		}
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to get webhook", err)
+	}
+
+	deliveries, err := h.WebhookReader.ListDeliveries(ctx, id, pagination.Limit(input.Limit))
+	if err != nil {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to list deliveries", err)
+	}
+
+	body := ListDeliveriesResponseBody{Deliveries: make([]Delivery, len(deliveries))}
+	for i, delivery := range deliveries {
+		body.Deliveries[i] = toAPIDelivery(delivery)
+	}
+	return &ListDeliveriesOutput{Body: body}, nil
+}
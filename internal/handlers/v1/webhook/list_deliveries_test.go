@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/pagination"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+func newListDeliveriesTestAPI(t *testing.T, reader webhookReader) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	NewListDeliveriesHandler(reader).Register(api)
+	return api
+}
+
+func TestHTTP_ListDeliveries_Success(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	status := http.StatusBadGateway
+	lastError := "unexpected status 502"
+	mockReader := &mockWebhookReader{}
+	mockReader.On("GetByID", mock.Anything, id).Return(&webhook.Webhook{ID: id}, nil)
+	mockReader.On("ListDeliveries", mock.Anything, id, pagination.DefaultLimit).Return([]*webhook.Delivery{{
+		ID:             uuid.Must(uuid.NewV4()),
+		WebhookID:      id,
+		EventType:      "AccountCreated",
+		Status:         webhook.DeliveryStatusPending,
+		Attempts:       2,
+		ResponseStatus: &status,
+		LastError:      &lastError,
+	}}, nil)
+
+	resp := newListDeliveriesTestAPI(t, mockReader).Get("/v1/webhooks/" + id.String() + "/deliveries")
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	var body ListDeliveriesResponseBody
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Deliveries, 1)
+	assert.Equal(t, "pending", body.Deliveries[0].Status)
+	assert.Equal(t, 2, body.Deliveries[0].Attempts)
+	assert.Equal(t, &status, body.Deliveries[0].ResponseStatus)
+	mockReader.AssertExpectations(t)
+}
+
+func TestHTTP_ListDeliveries_WebhookNotFound(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockReader := &mockWebhookReader{}
+	mockReader.On("GetByID", mock.Anything, id).Return(nil, sql.ErrNoRows)
+
+	resp := newListDeliveriesTestAPI(t, mockReader).Get("/v1/webhooks/" + id.String() + "/deliveries")
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	mockReader.AssertNotCalled(t, "ListDeliveries", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+)
+
+// ListWebhooksResponseBody is the response body for listing webhooks.
+type ListWebhooksResponseBody struct {
+	Webhooks []Webhook `json:"webhooks" doc:"Every webhook, oldest first"`
+}
+
+// ListWebhooksOutput is the Huma output for listing webhooks.
+type ListWebhooksOutput struct {
+	Body ListWebhooksResponseBody
+}
+
+// ListWebhooksHandler handles GET /v1/webhooks.
+type ListWebhooksHandler struct {
+	WebhookReader webhookReader
+}
+
+// NewListWebhooksHandler creates a new ListWebhooksHandler.
+func NewListWebhooksHandler(reader webhookReader) *ListWebhooksHandler {
+	return &ListWebhooksHandler{WebhookReader: reader}
+}
+
+// Register registers the list webhooks endpoint with the Huma API.
+func (h *ListWebhooksHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "list-webhooks",
+		Method:      http.MethodGet,
+		Path:        "/v1/webhooks",
+		Summary:     "List webhooks",
+		Description: "Returns every webhook subscription.",
+		Tags:        []string{"Webhooks"},
+	}, h.handle)
+}
+
+func (h *ListWebhooksHandler) handle(ctx context.Context, _ *struct{}) (*ListWebhooksOutput, error) {
+	hooks, err := h.WebhookReader.List(ctx)
+	if err != nil {
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to list webhooks", err)
+	}
+
+	body := ListWebhooksResponseBody{Webhooks: make([]Webhook, len(hooks))}
+	for i, hook := range hooks {
+		body.Webhooks[i] = toAPIWebhook(hook)
+	}
+	return &ListWebhooksOutput{Body: body}, nil
+}
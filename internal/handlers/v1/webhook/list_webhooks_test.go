@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+type mockWebhookReader struct {
+	mock.Mock
+}
+
+func (m *mockWebhookReader) List(ctx context.Context) ([]*webhook.Webhook, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*webhook.Webhook), args.Error(1)
+}
+
+func (m *mockWebhookReader) GetByID(ctx context.Context, id uuid.UUID) (*webhook.Webhook, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*webhook.Webhook), args.Error(1)
+}
+
+func (m *mockWebhookReader) ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]*webhook.Delivery, error) {
+	args := m.Called(ctx, webhookID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*webhook.Delivery), args.Error(1)
+}
+
+func newListWebhooksTestAPI(t *testing.T, reader webhookReader) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	NewListWebhooksHandler(reader).Register(api)
+	return api
+}
+
+func TestHTTP_ListWebhooks_OmitsSecret(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockReader := &mockWebhookReader{}
+	mockReader.On("List", mock.Anything).Return([]*webhook.Webhook{{
+		ID:        id,
+		URL:       "https://example.com/hook",
+		Secret:    "top-secret-value",
+		CreatedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}}, nil)
+
+	resp := newListWebhooksTestAPI(t, mockReader).Get("/v1/webhooks")
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.NotContains(t, resp.Body.String(), "top-secret-value")
+	var body ListWebhooksResponseBody
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Webhooks, 1)
+	assert.Equal(t, id.String(), body.Webhooks[0].ID)
+	assert.Equal(t, []string{}, body.Webhooks[0].EventTypes)
+	assert.Equal(t, "2026-01-02T03:04:05Z", body.Webhooks[0].CreatedAt)
+	mockReader.AssertExpectations(t)
+}
+
+func TestHTTP_ListWebhooks_ReaderError(t *testing.T) {
+	mockReader := &mockWebhookReader{}
+	mockReader.On("List", mock.Anything).Return(nil, errors.New("db down"))
+
+	resp := newListWebhooksTestAPI(t, mockReader).Get("/v1/webhooks")
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
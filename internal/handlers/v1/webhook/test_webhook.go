@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+// TestWebhookInput is the Huma input for test-firing a webhook.
+type TestWebhookInput struct {
+	ID string `path:"id" doc:"Webhook UUID"`
+}
+
+// TestWebhookOutput is the Huma output for test-firing a webhook.
+type TestWebhookOutput struct {
+	Body Delivery
+}
+
+// firer sends a test delivery to a webhook.
+type firer interface {
+	Fire(ctx context.Context, id uuid.UUID) (*webhook.Delivery, error)
+}
+
+// TestWebhookHandler handles POST /v1/webhooks/{id}/test.
+type TestWebhookHandler struct {
+	Firer firer
+}
+
+// NewTestWebhookHandler creates a new TestWebhookHandler.
+func NewTestWebhookHandler(f firer) *TestWebhookHandler {
+	return &TestWebhookHandler{Firer: f}
+}
+
+// Register registers the test webhook endpoint with the Huma API.
+func (h *TestWebhookHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "test-webhook",
+		Method:      http.MethodPost,
+		Path:        "/v1/webhooks/{id}/test",
+		Summary:     "Test webhook",
+		Description: "Sends a signed WebhookTest event to the webhook now and returns the delivery. Test deliveries are logged but never retried.",
+		Tags:        []string{"Webhooks"},
+	}, h.handle)
+}
+
+func (h *TestWebhookHandler) handle(ctx context.Context, input *TestWebhookInput) (*TestWebhookOutput, error) {
+	id, err := parseWebhookID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := h.Firer.Fire(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, huma.NewError(http.StatusNotFound, "webhook not found", err)
+		}
+		return nil, huma.NewError(http.StatusInternalServerError, "failed to send test delivery", err)
+	}
+	return &TestWebhookOutput{Body: toAPIDelivery(delivery)}, nil
+}
@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2/humatest"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+type stubFirer struct {
+	delivery *webhook.Delivery
+	err      error
+	fired    []uuid.UUID
+}
+
+func (s *stubFirer) Fire(_ context.Context, id uuid.UUID) (*webhook.Delivery, error) {
+	s.fired = append(s.fired, id)
+	return s.delivery, s.err
+}
+
+func newTestWebhookTestAPI(t *testing.T, f firer) humatest.TestAPI {
+	t.Helper()
+	_, api := humatest.New(t)
+	NewTestWebhookHandler(f).Register(api)
+	return api
+}
+
+func TestHTTP_TestWebhook_ReturnsDelivery(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	status := http.StatusOK
+	f := &stubFirer{delivery: &webhook.Delivery{
+		ID:             uuid.Must(uuid.NewV4()),
+		WebhookID:      id,
+		EventType:      "WebhookTest",
+		Status:         webhook.DeliveryStatusSucceeded,
+		Attempts:       1,
+		ResponseStatus: &status,
+	}}
+
+	resp := newTestWebhookTestAPI(t, f).Post("/v1/webhooks/" + id.String() + "/test")
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	var body Delivery
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "succeeded", body.Status)
+	assert.Equal(t, "WebhookTest", body.EventType)
+	assert.Equal(t, []uuid.UUID{id}, f.fired)
+}
+
+func TestHTTP_TestWebhook_NotFound(t *testing.T) {
+	resp := newTestWebhookTestAPI(t, &stubFirer{err: sql.ErrNoRows}).
+		Post("/v1/webhooks/" + uuid.Must(uuid.NewV4()).String() + "/test")
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
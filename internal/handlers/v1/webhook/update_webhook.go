@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+// UpdateWebhookBody is the request body for updating a webhook.
+type UpdateWebhookBody struct {
+	URL        *string   `json:"url,omitempty" doc:"URL events are POSTed to"`
+	EventTypes *[]string `json:"eventTypes,omitempty" doc:"Event types to deliver; empty for every event"`
+	IsDisabled *bool     `json:"isDisabled,omitempty" doc:"Pause or resume deliveries"`
+}
+
+// UpdateWebhookInput is the Huma input for updating a webhook.
+type UpdateWebhookInput struct {
+	idempotent.Header
+	ID   string `path:"id" doc:"Webhook UUID"`
+	Body UpdateWebhookBody
+}
+
+// UpdateWebhookOutput is the Huma output for updating a webhook.
+type UpdateWebhookOutput struct {
+	Body Webhook
+}
+
+// UpdateWebhookHandler handles PATCH /v1/webhooks/{id}.
+type UpdateWebhookHandler struct {
+	Operator operator.IProcessor
+}
+
+// NewUpdateWebhookHandler creates a new UpdateWebhookHandler.
+func NewUpdateWebhookHandler(op operator.IProcessor) *UpdateWebhookHandler {
+	return &UpdateWebhookHandler{Operator: op}
+}
+
+// Register registers the update webhook endpoint with the Huma API.
+func (h *UpdateWebhookHandler) Register(api huma.API) {
+	huma.Register(api, huma.Operation{
+		OperationID: "update-webhook",
+		Method:      http.MethodPatch,
+		Path:        "/v1/webhooks/{id}",
+		Summary:     "Update webhook",
+		Description: "Updates a webhook subscription and returns it.",
+		Tags:        []string{"Webhooks"},
+	}, h.handle)
+}
+
+func (h *UpdateWebhookHandler) handle(ctx context.Context, input *UpdateWebhookInput) (*UpdateWebhookOutput, error) {
+	id, err := parseWebhookID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+	if input.Body.URL != nil {
+		if err := validateURL(*input.Body.URL); err != nil {
+			return nil, err
+		}
+	}
+	if input.Body.EventTypes != nil {
+		if err := validateEventTypes(*input.Body.EventTypes); err != nil {
+			return nil, err
+		}
+	}
+
+	action := &actions.UpdateWebhook{
+		ID:         id,
+		URL:        input.Body.URL,
+		EventTypes: input.Body.EventTypes,
+		IsDisabled: input.Body.IsDisabled,
+	}
+	scopedBody := struct {
+		ID string `json:"id"`
+		UpdateWebhookBody
+	}{input.ID, input.Body}
+	return idempotent.Process(ctx, h.Operator, input.Header, "update-webhook", scopedBody, action,
+		func(result any) *UpdateWebhookOutput {
+			return &UpdateWebhookOutput{Body: toAPIWebhook(result.(*webhook.Webhook))}
+		},
+		mapWebhookError("failed to update webhook"))
+}
+
+// mapWebhookError converts an error from a webhook action into an API error.
+func mapWebhookError(message string) func(error) huma.StatusError {
+	return func(err error) huma.StatusError {
+		if errors.Is(err, actions.ErrWebhookNotFound) {
+			return huma.NewError(http.StatusNotFound, "webhook not found", err)
+		}
+		return huma.NewError(http.StatusInternalServerError, message, err)
+	}
+}
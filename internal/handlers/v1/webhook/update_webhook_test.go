@@ -0,0 +1,68 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+func TestUpdateWebhookHandler_Success(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	disabled := true
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().
+		Process(mock.Anything, mock.MatchedBy(func(a actions.IAction) bool {
+			uw, ok := a.(*actions.UpdateWebhook)
+			return ok && uw.ID == id && uw.URL == nil && uw.EventTypes == nil && uw.IsDisabled != nil && *uw.IsDisabled
+		})).
+		Return(&webhook.Webhook{ID: id, IsDisabled: true}, nil)
+
+	h := NewUpdateWebhookHandler(mockOp)
+	out, err := h.handle(context.Background(), &UpdateWebhookInput{
+		ID:   id.String(),
+		Body: UpdateWebhookBody{IsDisabled: &disabled},
+	})
+	require.NoError(t, err)
+	assert.True(t, out.Body.IsDisabled)
+	mockOp.AssertExpectations(t)
+}
+
+func TestUpdateWebhookHandler_NotFound(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockOp := &operator.MockIProcessor{}
+	mockOp.EXPECT().Process(mock.Anything, mock.Anything).Return(nil, actions.ErrWebhookNotFound)
+
+	h := NewUpdateWebhookHandler(mockOp)
+	_, err := h.handle(context.Background(), &UpdateWebhookInput{ID: id.String()})
+
+	var statusErr huma.StatusError
+	require.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusNotFound, statusErr.GetStatus())
+}
+
+func TestUpdateWebhookHandler_InvalidEventType(t *testing.T) {
+	eventTypes := []string{"NotAnEvent"}
+	mockOp := &operator.MockIProcessor{}
+
+	h := NewUpdateWebhookHandler(mockOp)
+	_, err := h.handle(context.Background(), &UpdateWebhookInput{
+		ID:   uuid.Must(uuid.NewV4()).String(),
+		Body: UpdateWebhookBody{EventTypes: &eventTypes},
+	})
+
+	var statusErr huma.StatusError
+	require.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusBadRequest, statusErr.GetStatus())
+	mockOp.AssertNotCalled(t, "Process", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+// Webhook is the API response model for a webhook subscription. The secret
+// is only returned when the webhook is created.
+type Webhook struct {
+	ID         string   `json:"id" doc:"Webhook UUID"`
+	URL        string   `json:"url" doc:"URL events are POSTed to"`
+	EventTypes []string `json:"eventTypes" doc:"Event types delivered; empty means every event"`
+	IsDisabled bool     `json:"isDisabled" doc:"Whether deliveries are paused"`
+	CreatedAt  string   `json:"createdAt" doc:"RFC3339 creation timestamp"`
+}
+
+// Delivery is the API response model for one entry in a webhook's delivery log.
+type Delivery struct {
+	ID             string  `json:"id" doc:"Delivery UUID, sent as the X-Budget-Delivery header"`
+	EventID        *int64  `json:"eventID,omitempty" doc:"Outbox event delivered; absent for test deliveries"`
+	EventType      string  `json:"eventType" doc:"Type of the delivered event"`
+	Status         string  `json:"status" doc:"pending, succeeded or failed"`
+	Attempts       int     `json:"attempts" doc:"Number of attempts made"`
+	NextAttemptAt  string  `json:"nextAttemptAt" doc:"RFC3339 time of the next attempt while pending"`
+	ResponseStatus *int    `json:"responseStatus,omitempty" doc:"HTTP status of the last attempt"`
+	LastError      *string `json:"lastError,omitempty" doc:"Error from the last failed attempt"`
+	CreatedAt      string  `json:"createdAt" doc:"RFC3339 time the delivery was queued"`
+	UpdatedAt      string  `json:"updatedAt" doc:"RFC3339 time of the last attempt"`
+}
+
+// webhookReader is the interface for reading webhooks and their deliveries.
+type webhookReader interface {
+	List(ctx context.Context) ([]*webhook.Webhook, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*webhook.Webhook, error)
+	ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]*webhook.Delivery, error)
+}
+
+func toAPIWebhook(w *webhook.Webhook) Webhook {
+	eventTypes := w.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	return Webhook{
+		ID:         w.ID.String(),
+		URL:        w.URL,
+		EventTypes: eventTypes,
+		IsDisabled: w.IsDisabled,
+		CreatedAt:  w.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toAPIDelivery(d *webhook.Delivery) Delivery {
+	return Delivery{
+		ID:             d.ID.String(),
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Status:         d.Status.String(),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt.Format(time.RFC3339),
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      d.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func parseWebhookID(id string) (uuid.UUID, error) {
+	parsed, err := uuid.FromString(id)
+	if err != nil {
+		return uuid.Nil, huma.NewError(http.StatusBadRequest, "invalid webhook id", err)
+	}
+	return parsed, nil
+}
+
+// validateURL requires an absolute http or https URL.
+func validateURL(raw string) error {
+	parsed, err := url.Parse(raw)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return huma.NewError(http.StatusBadRequest, "url must be an absolute http or https URL", err)
+	}
+	return nil
+}
+
+// validateEventTypes rejects event types that are never recorded.
+func validateEventTypes(eventTypes []string) error {
+	for _, eventType := range eventTypes {
+		if !slices.Contains(events.Types, events.Type(eventType)) {
+			return huma.NewError(http.StatusBadRequest, "unknown event type "+eventType)
+		}
+	}
+	return nil
+}
@@ -66,6 +66,12 @@ func (l *LogData) AddData(key string, value interface{}) {
 	l.dataItems[key] = value
 }
 
+// Data returns the value added under key, if any.
+func (l *LogData) Data(key string) (interface{}, bool) {
+	value, ok := l.dataItems[key]
+	return value, ok
+}
+
 func (l *LogData) Log() *logrus.Entry {
 	entry := logrus.NewEntry(l.logger)
 
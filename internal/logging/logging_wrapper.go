@@ -2,16 +2,26 @@ package logging
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 )
 
+// OperationKey is the log data key naming the operation a request ran: the
+// Huma operation ID, or the lower-cased name of a LoggingWrapper handler.
+const OperationKey = "operation"
+
 func LoggingWrapper(
 	loggingName string,
 	log *logrus.Logger,
 	handler func(http.ResponseWriter, *http.Request, *LogData) error,
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, req *http.Request) {
+		// Name the operation for the request-wide log line and metrics.
+		if requestLogData := GetLogData(req.Context()); requestLogData != nil {
+			requestLogData.AddData(OperationKey, strings.ToLower(loggingName))
+		}
+
 		logData := NewLogData(log)
 		log.Infof("Handler.%v.Start", loggingName)
 
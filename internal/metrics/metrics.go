@@ -0,0 +1,71 @@
+// Package metrics holds the Prometheus registry served on /metrics and the
+// metrics recorded by the HTTP and operator layers.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "budget"
+
+// Registry is what /metrics serves. Components with state to report, such
+// as the operator queue and the database pool, register collectors on it
+// at startup.
+var Registry = prometheus.NewRegistry()
+
+var (
+	// HTTPRequests counts requests by Huma operation ID (or handler name
+	// for plain routes), method and status code.
+	HTTPRequests = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "HTTP requests by operation, method and status code.",
+	}, []string{"operation", "method", "status"})
+
+	// HTTPDuration observes request latency by operation and method.
+	HTTPDuration = promauto.With(Registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by operation and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "method"})
+
+	// ActionDuration observes how long the operator took to perform and
+	// commit each action, retries included, by action type.
+	ActionDuration = promauto.With(Registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "operator",
+		Name:      "action_duration_seconds",
+		Help:      "Time to perform and commit an action, by action type.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"action"})
+
+	// ActionFailures counts actions that failed or rolled back, by action
+	// type.
+	ActionFailures = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "operator",
+		Name:      "action_failures_total",
+		Help:      "Actions that failed, by action type.",
+	}, []string{"action"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler serves Registry. A collector that fails, such as one querying a
+// database that is down, is left out of the scrape rather than failing it.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{ErrorHandling: promhttp.ContinueOnError})
+}
@@ -0,0 +1,20 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler_ServesRegistry(t *testing.T) {
+	HTTPRequests.WithLabelValues("list-accounts", http.MethodGet, "200").Inc()
+	rec := httptest.NewRecorder()
+
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `budget_http_requests_total{method="GET",operation="list-accounts",status="200"}`)
+	assert.Contains(t, rec.Body.String(), "go_goroutines")
+}
@@ -2,10 +2,134 @@ package actions
 
 import (
 	"context"
+	"database/sql"
+	"reflect"
 
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/events"
 	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
 )
 
 type IAction interface {
-	Perform(ctx context.Context, writer *storage.Writer) error
+	// Perform runs the action inside the writer's transaction and returns its
+	// result, such as the created record, which is handed back to the caller
+	// of Process once the transaction commits. The operator calls Perform
+	// again with a fresh writer when the transaction hits a serialization
+	// failure or deadlock, so it must not carry state over from an earlier
+	// attempt. Actions that change data record what changed with
+	// recordEvent so the event commits or rolls back with the change.
+	Perform(ctx context.Context, writer *storage.Writer) (any, error)
+	// RequiredPermission is what the caller must be allowed to do in the
+	// request's ledger for the operator to accept the action. It may depend
+	// on who the request's actor is, but never on fields the caller set to
+	// say who they are.
+	RequiredPermission(ctx context.Context) Permission
+}
+
+// Permission is what an action needs the caller's role in the request's
+// ledger to allow.
+type Permission int
+
+const (
+	// PermissionNone actions don't act on the request's ledger: they manage
+	// users and tokens, or create ledgers.
+	PermissionNone Permission = iota
+	// PermissionView actions only read the ledger.
+	PermissionView
+	// PermissionEdit actions change the ledger's accounts, categories,
+	// transactions or webhooks.
+	PermissionEdit
+	// PermissionOwn actions manage the ledger itself, such as its members.
+	PermissionOwn
+)
+
+// GrantedBy reports whether a member with role may do what p needs.
+func (p Permission) GrantedBy(role ledger.Role) bool {
+	switch p {
+	case PermissionNone:
+		return true
+	case PermissionView:
+		return role.Allows(ledger.RoleViewer)
+	case PermissionEdit:
+		return role.Allows(ledger.RoleEditor)
+	default:
+		return role.Allows(ledger.RoleOwner)
+	}
+}
+
+func (p Permission) String() string {
+	switch p {
+	case PermissionNone:
+		return "none"
+	case PermissionView:
+		return "view"
+	case PermissionEdit:
+		return "edit"
+	case PermissionOwn:
+		return "own"
+	default:
+		return "unknown"
+	}
+}
+
+// Ordered is implemented by actions that must run in submission order with
+// other actions sharing the same key, such as writes to one account. The
+// operator sends every action with the same key to the same worker; actions
+// with different keys may run in parallel.
+type Ordered interface {
+	OrderingKey() string
+}
+
+// OrderingKey returns the action's ordering key, or "" when it has none.
+func OrderingKey(action IAction) string {
+	if ordered, ok := action.(Ordered); ok {
+		return ordered.OrderingKey()
+	}
+	return ""
+}
+
+// Isolated is implemented by actions that need a stricter isolation level
+// than the database default, typically because they read rows and then
+// write based on what they did not find.
+type Isolated interface {
+	IsolationLevel() sql.IsolationLevel
+}
+
+// IsolationLevel returns the level the action's transaction should use.
+func IsolationLevel(action IAction) sql.IsolationLevel {
+	if isolated, ok := action.(Isolated); ok {
+		return isolated.IsolationLevel()
+	}
+	return sql.LevelDefault
+}
+
+// Named is implemented by actions that wrap another action and are reported
+// under its name.
+type Named interface {
+	ActionName() string
+}
+
+// Name returns the name the action is logged and audited under: its type
+// name, such as "CreateTransaction", unless it implements Named.
+func Name(action IAction) string {
+	if named, ok := action.(Named); ok {
+		return named.ActionName()
+	}
+	t := reflect.TypeOf(action)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// recordEvent appends a domain event to the outbox in the writer's
+// transaction.
+func recordEvent(ctx context.Context, writer *storage.Writer, eventType events.Type, aggregateID uuid.UUID, payload any) error {
+	event, err := events.New(eventType, aggregateID, payload)
+	if err != nil {
+		return err
+	}
+	return writer.Outbox.Append(ctx, event)
 }
@@ -0,0 +1,106 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+	"github.com/carson-networks/budget-server/internal/storage/outbox"
+)
+
+// expectEvents replaces wt's outbox with a mock expecting one event of each
+// given type.
+func expectEvents(wt *storage.Writer, types ...events.Type) *storage.MockIOutboxWriter {
+	mockOutbox := &storage.MockIOutboxWriter{}
+	for _, eventType := range types {
+		mockOutbox.EXPECT().
+			Append(mock.Anything, mock.MatchedBy(func(event *outbox.EventCreate) bool {
+				return event.Type == string(eventType)
+			})).
+			Return(nil).
+			Once()
+	}
+	wt.Outbox = mockOutbox
+	return mockOutbox
+}
+
+func TestRecordEvent_AppendsPayload(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	var appended *outbox.EventCreate
+	mockOutbox := &storage.MockIOutboxWriter{}
+	mockOutbox.EXPECT().
+		Append(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, events ...*outbox.EventCreate) { appended = events[0] }).
+		Return(nil)
+	wt := storage.NewWriterForTest()
+	wt.Outbox = mockOutbox
+
+	err := recordEvent(context.Background(), wt, events.CategoryDeleted, id, events.CategoryRef{ID: id.String()})
+	require.NoError(t, err)
+	assert.Equal(t, string(events.CategoryDeleted), appended.Type)
+	assert.Equal(t, id, appended.AggregateID)
+	assert.JSONEq(t, `{"id":"`+id.String()+`"}`, string(appended.Payload))
+}
+
+func TestRecordEvent_AppendFails(t *testing.T) {
+	appendErr := errors.New("append failed")
+	mockOutbox := &storage.MockIOutboxWriter{}
+	mockOutbox.EXPECT().Append(mock.Anything, mock.Anything).Return(appendErr)
+	wt := storage.NewWriterForTest()
+	wt.Outbox = mockOutbox
+
+	err := recordEvent(context.Background(), wt, events.CategoryDeleted, uuid.Nil, json.RawMessage(`{}`))
+	assert.ErrorIs(t, err, appendErr)
+}
+
+func TestName(t *testing.T) {
+	assert.Equal(t, "CreateTransaction", Name(&CreateTransaction{}))
+	assert.Equal(t, "DeleteCategory", Name(&Idempotent{Action: &DeleteCategory{}}))
+	assert.Equal(t, "Batch", Name(&Batch{Actions: []IAction{&CreateAccount{}}}))
+}
+
+func TestRequiredPermission(t *testing.T) {
+	tests := map[IAction]Permission{
+		&ApplyCategoryTemplate{}: PermissionEdit,
+		&CreateAccount{}:         PermissionEdit,
+		&CreateCategory{}:        PermissionEdit,
+		&CreateTransaction{}:     PermissionEdit,
+		&CreateWebhook{}:         PermissionEdit,
+		&DeleteCategory{}:        PermissionEdit,
+		&DeleteTransaction{}:     PermissionEdit,
+		&DeleteWebhook{}:         PermissionEdit,
+		&MergeCategories{}:       PermissionEdit,
+		&RestoreCategory{}:       PermissionEdit,
+		&UpdateCategory{}:        PermissionEdit,
+		&UpdateWebhook{}:         PermissionEdit,
+		&Undo{}:                  PermissionEdit,
+		&CreateUser{}:            PermissionNone,
+		&CreateToken{}:           PermissionNone,
+		&RevokeToken{}:           PermissionNone,
+		&CreateLedger{}:          PermissionNone,
+		&SetLedgerMember{}:       PermissionOwn,
+	}
+	for action, want := range tests {
+		t.Run(Name(action), func(t *testing.T) {
+			assert.Equal(t, want, action.RequiredPermission(context.Background()))
+		})
+	}
+}
+
+func TestPermission_GrantedBy(t *testing.T) {
+	assert.True(t, PermissionNone.GrantedBy(ledger.RoleViewer))
+	assert.True(t, PermissionView.GrantedBy(ledger.RoleViewer))
+	assert.False(t, PermissionEdit.GrantedBy(ledger.RoleViewer))
+	assert.True(t, PermissionEdit.GrantedBy(ledger.RoleEditor))
+	assert.False(t, PermissionOwn.GrantedBy(ledger.RoleEditor))
+	assert.True(t, PermissionOwn.GrantedBy(ledger.RoleOwner))
+}
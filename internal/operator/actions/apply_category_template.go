@@ -0,0 +1,125 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/carson-networks/budget-server/internal/categorytemplates"
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/category"
+)
+
+var (
+	ErrTemplateGroupNotParent    = errors.New("template group name is used by a non-parent category")
+	ErrTemplateGroupTypeMismatch = errors.New("template group name is used by a parent category of another type")
+)
+
+// ApplyCategoryTemplateResult is the result of ApplyCategoryTemplate.
+type ApplyCategoryTemplateResult struct {
+	Created []*category.Category
+	Skipped int
+}
+
+// ApplyCategoryTemplate creates a template's parent groups and children in a
+// single transaction. Any category whose name already exists is skipped; an
+// existing parent with a group's name and type is reused so missing children
+// are still added beneath it.
+type ApplyCategoryTemplate struct {
+	Template *categorytemplates.Template
+
+	IAction
+}
+
+// Perform returns an *ApplyCategoryTemplateResult.
+func (a *ApplyCategoryTemplate) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	existing, err := writer.Category.ListAll(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*category.Category, len(existing))
+	for _, cat := range existing {
+		if _, ok := byName[cat.Name]; !ok {
+			byName[cat.Name] = cat
+		}
+	}
+
+	result := &ApplyCategoryTemplateResult{Created: []*category.Category{}}
+	for _, group := range a.Template.Groups {
+		categoryType, err := group.CategoryType()
+		if err != nil {
+			return nil, err
+		}
+
+		parent, ok := byName[group.Name]
+		if ok {
+			if !parent.IsParent {
+				return nil, ErrTemplateGroupNotParent
+			}
+			if parent.CategoryType != categoryType {
+				return nil, ErrTemplateGroupTypeMismatch
+			}
+			result.Skipped++
+		} else {
+			created, err := writer.Category.Create(ctx, &category.CategoryCreate{
+				Name:         group.Name,
+				IsParent:     true,
+				CategoryType: categoryType,
+			})
+			if err != nil {
+				return nil, err
+			}
+			parent = created
+			byName[group.Name] = created
+			result.Created = append(result.Created, created)
+		}
+
+		for _, childName := range group.Children {
+			if _, ok := byName[childName]; ok {
+				result.Skipped++
+				continue
+			}
+			created, err := writer.Category.Create(ctx, &category.CategoryCreate{
+				Name:             childName,
+				ParentCategoryID: &parent.ID,
+				CategoryType:     categoryType,
+			})
+			if err != nil {
+				return nil, err
+			}
+			byName[childName] = created
+			result.Created = append(result.Created, created)
+		}
+	}
+
+	for _, created := range result.Created {
+		if err := recordEvent(ctx, writer, events.CategoryCreated, created.ID, events.NewCategory(created)); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (a *ApplyCategoryTemplate) RequiredPermission(context.Context) Permission {
+	return PermissionEdit
+}
+
+// IsolationLevel is serializable because the action skips names it does not
+// find, which a concurrent insert could otherwise make stale.
+func (a *ApplyCategoryTemplate) IsolationLevel() sql.IsolationLevel {
+	return sql.LevelSerializable
+}
+
+// Inverse deletes the created categories, children before their parents.
+func (a *ApplyCategoryTemplate) Inverse(result any) (IAction, error) {
+	applied, ok := result.(*ApplyCategoryTemplateResult)
+	if !ok || len(applied.Created) == 0 {
+		return nil, ErrNotInvertible
+	}
+	deletes := make([]IAction, len(applied.Created))
+	for i, created := range applied.Created {
+		deletes[len(deletes)-1-i] = &DeleteCategory{ID: created.ID}
+	}
+	return &Batch{Actions: deletes}, nil
+}
@@ -0,0 +1,146 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/categorytemplates"
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/category"
+)
+
+var testTemplate = &categorytemplates.Template{
+	Name: "test",
+	Groups: []categorytemplates.Group{
+		{Name: "Income", Type: "income", Children: []string{"Salary"}},
+		{Name: "Food", Type: "expense", Children: []string{"Groceries", "Restaurants"}},
+	},
+}
+
+func TestApplyCategoryTemplate_Perform_EmptyDatabase(t *testing.T) {
+	incomeID := uuid.Must(uuid.NewV4())
+	foodID := uuid.Must(uuid.NewV4())
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().ListAll(mock.Anything, (*category.CategoryFilter)(nil)).Return(nil, nil)
+	mockCat.EXPECT().
+		Create(mock.Anything, &category.CategoryCreate{Name: "Income", IsParent: true, CategoryType: category.CatergoryType_Income}).
+		Return(&category.Category{ID: incomeID, Name: "Income", IsParent: true}, nil)
+	mockCat.EXPECT().
+		Create(mock.Anything, &category.CategoryCreate{Name: "Salary", ParentCategoryID: &incomeID, CategoryType: category.CatergoryType_Income}).
+		Return(&category.Category{ID: uuid.Must(uuid.NewV4()), Name: "Salary", ParentCategoryID: &incomeID}, nil)
+	mockCat.EXPECT().
+		Create(mock.Anything, &category.CategoryCreate{Name: "Food", IsParent: true, CategoryType: category.CatergoryType_Expense}).
+		Return(&category.Category{ID: foodID, Name: "Food", IsParent: true}, nil)
+	mockCat.EXPECT().
+		Create(mock.Anything, &category.CategoryCreate{Name: "Groceries", ParentCategoryID: &foodID, CategoryType: category.CatergoryType_Expense}).
+		Return(&category.Category{ID: uuid.Must(uuid.NewV4()), Name: "Groceries", ParentCategoryID: &foodID}, nil)
+	mockCat.EXPECT().
+		Create(mock.Anything, &category.CategoryCreate{Name: "Restaurants", ParentCategoryID: &foodID, CategoryType: category.CatergoryType_Expense}).
+		Return(&category.Category{ID: uuid.Must(uuid.NewV4()), Name: "Restaurants", ParentCategoryID: &foodID}, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+	mockOutbox := expectEvents(wt, events.CategoryCreated, events.CategoryCreated, events.CategoryCreated, events.CategoryCreated, events.CategoryCreated)
+	action := &ApplyCategoryTemplate{Template: testTemplate}
+
+	result, err := action.Perform(context.Background(), wt)
+	require.NoError(t, err)
+	applied := result.(*ApplyCategoryTemplateResult)
+	assert.Len(t, applied.Created, 5)
+	assert.Equal(t, "Income", applied.Created[0].Name)
+	assert.Equal(t, 0, applied.Skipped)
+	mockCat.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+}
+
+func TestApplyCategoryTemplate_Perform_SkipsExistingNames(t *testing.T) {
+	foodID := uuid.Must(uuid.NewV4())
+	incomeID := uuid.Must(uuid.NewV4())
+	existing := []*category.Category{
+		{ID: foodID, Name: "Food", IsParent: true, CategoryType: category.CatergoryType_Expense},
+		{ID: uuid.Must(uuid.NewV4()), Name: "Groceries", ParentCategoryID: &foodID},
+	}
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().ListAll(mock.Anything, (*category.CategoryFilter)(nil)).Return(existing, nil)
+	mockCat.EXPECT().
+		Create(mock.Anything, &category.CategoryCreate{Name: "Income", IsParent: true, CategoryType: category.CatergoryType_Income}).
+		Return(&category.Category{ID: incomeID, Name: "Income", IsParent: true}, nil)
+	mockCat.EXPECT().
+		Create(mock.Anything, &category.CategoryCreate{Name: "Salary", ParentCategoryID: &incomeID, CategoryType: category.CatergoryType_Income}).
+		Return(&category.Category{ID: uuid.Must(uuid.NewV4()), Name: "Salary", ParentCategoryID: &incomeID}, nil)
+	mockCat.EXPECT().
+		Create(mock.Anything, &category.CategoryCreate{Name: "Restaurants", ParentCategoryID: &foodID, CategoryType: category.CatergoryType_Expense}).
+		Return(&category.Category{ID: uuid.Must(uuid.NewV4()), Name: "Restaurants", ParentCategoryID: &foodID}, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+	mockOutbox := expectEvents(wt, events.CategoryCreated, events.CategoryCreated, events.CategoryCreated)
+	action := &ApplyCategoryTemplate{Template: testTemplate}
+
+	result, err := action.Perform(context.Background(), wt)
+	require.NoError(t, err)
+	applied := result.(*ApplyCategoryTemplateResult)
+	assert.Len(t, applied.Created, 3)
+	assert.Equal(t, 2, applied.Skipped)
+	mockCat.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+}
+
+func TestApplyCategoryTemplate_Perform_GroupNameUsedByLeaf(t *testing.T) {
+	parentID := uuid.Must(uuid.NewV4())
+	existing := []*category.Category{
+		{ID: uuid.Must(uuid.NewV4()), Name: "Income", ParentCategoryID: &parentID},
+	}
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().ListAll(mock.Anything, (*category.CategoryFilter)(nil)).Return(existing, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+	action := &ApplyCategoryTemplate{Template: testTemplate}
+
+	_, err := action.Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrTemplateGroupNotParent)
+	mockCat.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestApplyCategoryTemplate_Perform_GroupNameUsedByOtherType(t *testing.T) {
+	existing := []*category.Category{
+		{ID: uuid.Must(uuid.NewV4()), Name: "Income", IsParent: true, CategoryType: category.CatergoryType_Expense},
+	}
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().ListAll(mock.Anything, (*category.CategoryFilter)(nil)).Return(existing, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+	action := &ApplyCategoryTemplate{Template: testTemplate}
+
+	_, err := action.Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrTemplateGroupTypeMismatch)
+	mockCat.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestApplyCategoryTemplate_Perform_CreateError(t *testing.T) {
+	createErr := errors.New("insert failed")
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().ListAll(mock.Anything, (*category.CategoryFilter)(nil)).Return(nil, nil)
+	mockCat.EXPECT().Create(mock.Anything, mock.Anything).Return(nil, createErr)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+	action := &ApplyCategoryTemplate{Template: testTemplate}
+
+	_, err := action.Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, createErr)
+}
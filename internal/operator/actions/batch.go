@@ -0,0 +1,102 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+)
+
+var ErrBatchEmpty = errors.New("batch has no actions")
+
+// BatchItemError identifies the action that caused a batch to fail.
+type BatchItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchItemError) Error() string {
+	return fmt.Sprintf("batch item %d: %v", e.Index, e.Err)
+}
+
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
+
+// Batch performs Actions in order against the same writer, so they share one
+// database transaction. The first failure stops the batch and is returned as
+// a *BatchItemError; the operator then rolls back every earlier action.
+// Perform returns a []any holding each action's result in order.
+type Batch struct {
+	Actions []IAction
+
+	IAction
+}
+
+func (b *Batch) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	if len(b.Actions) == 0 {
+		return nil, ErrBatchEmpty
+	}
+	results := make([]any, 0, len(b.Actions))
+	for i, action := range b.Actions {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result, err := action.Perform(ctx, writer)
+		if err != nil {
+			return nil, &BatchItemError{Index: i, Err: err}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// OrderingKey returns the key shared by the ordered actions in the batch. A
+// batch whose actions have different keys, such as an import across
+// accounts, has none: no one worker orders all of its keys, so it runs on
+// any worker, and the row locks its actions take keep balances right
+// alongside other writes to those accounts.
+func (b *Batch) OrderingKey() string {
+	shared := ""
+	for _, action := range b.Actions {
+		key := OrderingKey(action)
+		if key == "" {
+			continue
+		}
+		if shared != "" && key != shared {
+			return ""
+		}
+		shared = key
+	}
+	return shared
+}
+
+// IsolationLevel returns the strictest level required by any action in the
+// batch, since they all share one transaction.
+func (b *Batch) IsolationLevel() sql.IsolationLevel {
+	level := sql.LevelDefault
+	for _, action := range b.Actions {
+		level = max(level, IsolationLevel(action))
+	}
+	return level
+}
+
+// RequiredPermission returns the most any action in the batch requires.
+func (b *Batch) RequiredPermission(ctx context.Context) Permission {
+	permission := PermissionNone
+	for _, action := range b.Actions {
+		permission = max(permission, action.RequiredPermission(ctx))
+	}
+	return permission
+}
+
+// Inverse reverses every action in the batch, last action first.
+func (b *Batch) Inverse(result any) (IAction, error) {
+	results, ok := result.([]any)
+	if !ok {
+		return nil, ErrNotInvertible
+	}
+	return inverseAll(b.Actions, results)
+}
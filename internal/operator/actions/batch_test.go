@@ -0,0 +1,96 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+)
+
+func TestBatch_Perform_RunsInOrder(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	var order []int
+	first := &MockIAction{}
+	first.EXPECT().Perform(mock.Anything, wt).Run(func(context.Context, *storage.Writer) { order = append(order, 0) }).Return("first", nil)
+	second := &MockIAction{}
+	second.EXPECT().Perform(mock.Anything, wt).Run(func(context.Context, *storage.Writer) { order = append(order, 1) }).Return(nil, nil)
+
+	result, err := (&Batch{Actions: []IAction{first, second}}).Perform(context.Background(), wt)
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1}, order)
+	assert.Equal(t, []any{"first", nil}, result)
+}
+
+func TestBatch_Perform_StopsAtFirstFailure(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	itemErr := errors.New("item failed")
+	first := &MockIAction{}
+	first.EXPECT().Perform(mock.Anything, wt).Return(nil, nil)
+	second := &MockIAction{}
+	second.EXPECT().Perform(mock.Anything, wt).Return(nil, itemErr)
+	third := &MockIAction{}
+
+	_, err := (&Batch{Actions: []IAction{first, second, third}}).Perform(context.Background(), wt)
+
+	var batchErr *BatchItemError
+	require.ErrorAs(t, err, &batchErr)
+	assert.Equal(t, 1, batchErr.Index)
+	assert.ErrorIs(t, err, itemErr)
+	third.AssertNotCalled(t, "Perform", mock.Anything, mock.Anything)
+}
+
+func TestBatch_Perform_Empty(t *testing.T) {
+	_, err := (&Batch{}).Perform(context.Background(), storage.NewWriterForTest())
+	assert.ErrorIs(t, err, ErrBatchEmpty)
+}
+
+func TestBatch_OrderingKey_FirstOrderedAction(t *testing.T) {
+	accountID := uuid.Must(uuid.NewV4())
+	batch := &Batch{Actions: []IAction{
+		&CreateAccount{Name: "Checking"},
+		&CreateTransaction{AccountID: accountID},
+	}}
+
+	assert.Equal(t, accountID.String(), OrderingKey(batch))
+	assert.Equal(t, accountID.String(), OrderingKey(&Idempotent{Action: batch}))
+	assert.Empty(t, OrderingKey(&CreateAccount{}))
+}
+
+func TestBatch_OrderingKey_MixedKeysHaveNone(t *testing.T) {
+	batch := &Batch{Actions: []IAction{
+		&CreateTransaction{AccountID: uuid.Must(uuid.NewV4())},
+		&CreateAccount{Name: "Checking"},
+		&CreateTransaction{AccountID: uuid.Must(uuid.NewV4())},
+	}}
+
+	assert.Empty(t, OrderingKey(batch))
+}
+
+func TestBatch_IsolationLevel_Strictest(t *testing.T) {
+	batch := &Batch{Actions: []IAction{
+		&CreateAccount{Name: "Checking"},
+		&DeleteCategory{},
+	}}
+
+	assert.Equal(t, sql.LevelSerializable, IsolationLevel(batch))
+	assert.Equal(t, sql.LevelSerializable, IsolationLevel(&Idempotent{Action: batch}))
+	assert.Equal(t, sql.LevelDefault, IsolationLevel(&Batch{Actions: []IAction{&CreateAccount{}}}))
+}
+
+func TestBatch_RequiredPermission_Most(t *testing.T) {
+	batch := &Batch{Actions: []IAction{
+		&CreateLedger{Name: "Home"},
+		&CreateAccount{Name: "Checking"},
+	}}
+
+	assert.Equal(t, PermissionEdit, batch.RequiredPermission(context.Background()))
+	assert.Equal(t, PermissionEdit, (&Idempotent{Action: batch}).RequiredPermission(context.Background()))
+	assert.Equal(t, PermissionNone, (&Batch{Actions: []IAction{&CreateLedger{}}}).RequiredPermission(context.Background()))
+}
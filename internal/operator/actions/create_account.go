@@ -3,6 +3,7 @@ package actions
 import (
 	"context"
 
+	"github.com/carson-networks/budget-server/internal/events"
 	"github.com/carson-networks/budget-server/internal/storage"
 	"github.com/carson-networks/budget-server/internal/storage/account"
 	"github.com/shopspring/decimal"
@@ -17,11 +18,19 @@ type CreateAccount struct {
 	IAction
 }
 
-func (c *CreateAccount) Perform(ctx context.Context, writer *storage.Writer) error {
-	err := writer.Account.Create(ctx, c.Name, c.Type, c.SubType, c.StartingBalance)
+// Perform returns the created *account.Account.
+func (c *CreateAccount) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	created, err := writer.Account.Create(ctx, c.Name, c.Type, c.SubType, c.StartingBalance)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	if err := recordEvent(ctx, writer, events.AccountCreated, created.ID, events.NewAccount(created)); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *CreateAccount) RequiredPermission(context.Context) Permission {
+	return PermissionEdit
 }
@@ -5,16 +5,19 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/gofrs/uuid/v5"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/carson-networks/budget-server/internal/events"
 	"github.com/carson-networks/budget-server/internal/storage"
 	"github.com/carson-networks/budget-server/internal/storage/account"
 )
 
 func TestCreateAccount_Perform_Success(t *testing.T) {
+	created := &account.Account{ID: uuid.Must(uuid.NewV4()), Name: "Checking"}
 	mockAccount := &storage.MockIAccountWriter{}
 	mockAccount.EXPECT().
 		Create(
@@ -24,10 +27,11 @@ func TestCreateAccount_Perform_Success(t *testing.T) {
 			"test sub type",
 			decimal.Zero,
 		).
-		Return(nil)
+		Return(created, nil)
 
 	wt := storage.NewWriterForTest()
 	wt.Account = mockAccount
+	mockOutbox := expectEvents(wt, events.AccountCreated)
 	action := &CreateAccount{
 		Name:            "Checking",
 		Type:            account.AccountTypeCash,
@@ -35,9 +39,11 @@ func TestCreateAccount_Perform_Success(t *testing.T) {
 		StartingBalance: decimal.Zero,
 	}
 
-	err := action.Perform(context.Background(), wt)
+	result, err := action.Perform(context.Background(), wt)
 	require.NoError(t, err)
+	assert.Same(t, created, result)
 	mockAccount.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
 }
 
 func TestCreateAccount_Perform_CreateFails(t *testing.T) {
@@ -51,7 +57,7 @@ func TestCreateAccount_Perform_CreateFails(t *testing.T) {
 			"High Yield",
 			decimal.NewFromInt(1000),
 		).
-		Return(createErr)
+		Return(nil, createErr)
 
 	wt := storage.NewWriterForTest()
 	wt.Account = mockAccount
@@ -62,7 +68,7 @@ func TestCreateAccount_Perform_CreateFails(t *testing.T) {
 		StartingBalance: decimal.NewFromInt(1000),
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	assert.ErrorIs(t, err, createErr)
 	mockAccount.AssertExpectations(t)
 }
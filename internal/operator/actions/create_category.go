@@ -5,15 +5,18 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/carson-networks/budget-server/internal/events"
 	"github.com/carson-networks/budget-server/internal/storage"
 	"github.com/carson-networks/budget-server/internal/storage/category"
 	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
 )
 
 var (
 	ErrParentCategoryIsNotParent      = errors.New("parent category is not parent")
 	ErrParentCategoryNotFound         = errors.New("parent category not found")
 	ErrStandaloneCategoryNotSupported = errors.New("standalone category is not supported")
+	ErrNegativeMonthlyBudget          = errors.New("monthly budget must not be negative")
 )
 
 type CreateCategory struct {
@@ -22,24 +25,29 @@ type CreateCategory struct {
 	ParentCategoryID *uuid.UUID
 	IsDisabled       bool
 	CategoryType     category.CategoryType
+	MonthlyBudget    *decimal.Decimal
 
 	IAction
 }
 
-func (c *CreateCategory) Perform(ctx context.Context, writer *storage.Writer) error {
+// Perform returns the created *category.Category.
+func (c *CreateCategory) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
 	if !c.IsParent && c.ParentCategoryID == nil {
-		return ErrStandaloneCategoryNotSupported
+		return nil, ErrStandaloneCategoryNotSupported
+	}
+	if c.MonthlyBudget != nil && c.MonthlyBudget.IsNegative() {
+		return nil, ErrNegativeMonthlyBudget
 	}
 	if c.ParentCategoryID != nil {
 		parent, err := writer.Category.GetByID(ctx, *c.ParentCategoryID)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
-				return ErrParentCategoryNotFound
+				return nil, ErrParentCategoryNotFound
 			}
-			return err
+			return nil, err
 		}
 		if !parent.IsParent {
-			return ErrParentCategoryIsNotParent
+			return nil, ErrParentCategoryIsNotParent
 		}
 	}
 
@@ -49,10 +57,27 @@ func (c *CreateCategory) Perform(ctx context.Context, writer *storage.Writer) er
 		ParentCategoryID: c.ParentCategoryID,
 		IsDisabled:       c.IsDisabled,
 		CategoryType:     c.CategoryType,
+		MonthlyBudget:    c.MonthlyBudget,
 	}
-	err := writer.Category.Create(ctx, create)
+	created, err := writer.Category.Create(ctx, create)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if err := recordEvent(ctx, writer, events.CategoryCreated, created.ID, events.NewCategory(created)); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *CreateCategory) RequiredPermission(context.Context) Permission {
+	return PermissionEdit
+}
+
+// Inverse deletes the created category.
+func (c *CreateCategory) Inverse(result any) (IAction, error) {
+	created, ok := result.(*category.Category)
+	if !ok {
+		return nil, ErrNotInvertible
 	}
-	return nil
+	return &DeleteCategory{ID: created.ID}, nil
 }
@@ -7,24 +7,28 @@ import (
 	"testing"
 
 	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/carson-networks/budget-server/internal/events"
 	"github.com/carson-networks/budget-server/internal/storage"
 	"github.com/carson-networks/budget-server/internal/storage/category"
 )
 
 func TestCreateCategory_Perform_Success(t *testing.T) {
+	created := &category.Category{ID: uuid.Must(uuid.NewV4()), Name: "Expenses", IsParent: true}
 	mockCat := &storage.MockICategoryWriter{}
 	mockCat.EXPECT().
 		Create(mock.Anything, mock.MatchedBy(func(c *category.CategoryCreate) bool {
 			return c != nil && c.Name == "Expenses" && c.IsParent && c.ParentCategoryID == nil
 		})).
-		Return(nil)
+		Return(created, nil)
 
 	wt := storage.NewWriterForTest()
 	wt.Category = mockCat
+	mockOutbox := expectEvents(wt, events.CategoryCreated)
 	action := &CreateCategory{
 		Name:         "Expenses",
 		IsParent:     true,
@@ -32,9 +36,11 @@ func TestCreateCategory_Perform_Success(t *testing.T) {
 		CategoryType: category.CatergoryType_Expense,
 	}
 
-	err := action.Perform(context.Background(), wt)
+	result, err := action.Perform(context.Background(), wt)
 	require.NoError(t, err)
+	assert.Same(t, created, result)
 	mockCat.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
 }
 
 func TestCreateCategory_Perform_Success_LeafWithParent(t *testing.T) {
@@ -51,10 +57,11 @@ func TestCreateCategory_Perform_Success_LeafWithParent(t *testing.T) {
 		Create(mock.Anything, mock.MatchedBy(func(c *category.CategoryCreate) bool {
 			return c != nil && c.Name == "Food" && !c.IsParent && c.ParentCategoryID != nil && *c.ParentCategoryID == parentID
 		})).
-		Return(nil)
+		Return(&category.Category{ID: uuid.Must(uuid.NewV4())}, nil)
 
 	wt := storage.NewWriterForTest()
 	wt.Category = mockCat
+	mockOutbox := expectEvents(wt, events.CategoryCreated)
 	action := &CreateCategory{
 		Name:             "Food",
 		IsParent:         false,
@@ -63,9 +70,10 @@ func TestCreateCategory_Perform_Success_LeafWithParent(t *testing.T) {
 		CategoryType:     category.CatergoryType_Expense,
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	require.NoError(t, err)
 	mockCat.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
 }
 
 func TestCreateCategory_Perform_MissingParentIDForNonParent(t *testing.T) {
@@ -78,11 +86,25 @@ func TestCreateCategory_Perform_MissingParentIDForNonParent(t *testing.T) {
 		CategoryType:     category.CatergoryType_Expense,
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrStandaloneCategoryNotSupported)
 }
 
+func TestCreateCategory_Perform_NegativeMonthlyBudget(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	budget := decimal.RequireFromString("-10")
+	action := &CreateCategory{
+		Name:          "Food",
+		IsParent:      true,
+		CategoryType:  category.CatergoryType_Expense,
+		MonthlyBudget: &budget,
+	}
+
+	_, err := action.Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrNegativeMonthlyBudget)
+}
+
 func TestCreateCategory_Perform_ParentNotFound(t *testing.T) {
 	parentID := uuid.Must(uuid.NewV4())
 	mockCat := &storage.MockICategoryWriter{}
@@ -100,7 +122,7 @@ func TestCreateCategory_Perform_ParentNotFound(t *testing.T) {
 		CategoryType:     category.CatergoryType_Expense,
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrParentCategoryNotFound)
 	mockCat.AssertExpectations(t)
@@ -128,7 +150,7 @@ func TestCreateCategory_Perform_ParentNotAGroup(t *testing.T) {
 		CategoryType:     category.CatergoryType_Expense,
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrParentCategoryIsNotParent)
 	mockCat.AssertExpectations(t)
@@ -152,7 +174,7 @@ func TestCreateCategory_Perform_GetByIDError(t *testing.T) {
 		CategoryType:     category.CatergoryType_Expense,
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	assert.ErrorIs(t, err, dbErr)
 	mockCat.AssertExpectations(t)
 }
@@ -162,7 +184,7 @@ func TestCreateCategory_Perform_CreateError(t *testing.T) {
 	mockCat := &storage.MockICategoryWriter{}
 	mockCat.EXPECT().
 		Create(mock.Anything, mock.Anything).
-		Return(createErr)
+		Return(nil, createErr)
 
 	wt := storage.NewWriterForTest()
 	wt.Category = mockCat
@@ -174,7 +196,7 @@ func TestCreateCategory_Perform_CreateError(t *testing.T) {
 		CategoryType:     category.CatergoryType_Expense,
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	assert.ErrorIs(t, err, createErr)
 	mockCat.AssertExpectations(t)
 }
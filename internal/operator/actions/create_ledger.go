@@ -0,0 +1,34 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+	"github.com/gofrs/uuid/v5"
+)
+
+// CreateLedger creates a ledger owned by OwnerID.
+type CreateLedger struct {
+	Name    string
+	OwnerID uuid.UUID
+
+	IAction
+}
+
+// Perform returns the created ledger as a *ledger.UserLedger.
+func (c *CreateLedger) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	created, err := writer.Ledger.Create(ctx, c.Name)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writer.Ledger.SetMember(ctx, created.ID, c.OwnerID, ledger.RoleOwner); err != nil {
+		return nil, err
+	}
+	return &ledger.UserLedger{Ledger: *created, Role: ledger.RoleOwner}, nil
+}
+
+// RequiredPermission is PermissionNone: any user may create a ledger.
+func (c *CreateLedger) RequiredPermission(context.Context) Permission {
+	return PermissionNone
+}
@@ -0,0 +1,31 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+)
+
+func TestCreateLedger_Perform_AddsOwner(t *testing.T) {
+	ownerID := uuid.Must(uuid.NewV4())
+	created := &ledger.Ledger{ID: uuid.Must(uuid.NewV4()), Name: "Home"}
+	mockLedger := &storage.MockILedgerWriter{}
+	mockLedger.EXPECT().Create(mock.Anything, "Home").Return(created, nil)
+	mockLedger.EXPECT().SetMember(mock.Anything, created.ID, ownerID, ledger.RoleOwner).
+		Return(&ledger.Membership{LedgerID: created.ID, UserID: ownerID, Role: ledger.RoleOwner}, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Ledger = mockLedger
+
+	result, err := (&CreateLedger{Name: "Home", OwnerID: ownerID}).Perform(context.Background(), wt)
+	require.NoError(t, err)
+	assert.Equal(t, &ledger.UserLedger{Ledger: *created, Role: ledger.RoleOwner}, result)
+	mockLedger.AssertExpectations(t)
+}
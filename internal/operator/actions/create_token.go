@@ -0,0 +1,40 @@
+package actions
+
+import (
+	"context"
+	"time"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+	"github.com/gofrs/uuid/v5"
+)
+
+// CreateToken stores a bearer token for UserID. Hash is the hash of the
+// token, which is generated by the caller and never stored.
+type CreateToken struct {
+	UserID    uuid.UUID
+	Name      string
+	Kind      user.TokenKind
+	Scope     user.Scope
+	Hash      []byte
+	ExpiresAt *time.Time
+
+	IAction
+}
+
+// Perform returns the created *user.Token.
+func (c *CreateToken) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	return writer.User.CreateToken(ctx, &user.TokenCreate{
+		UserID:    c.UserID,
+		Name:      c.Name,
+		Kind:      c.Kind,
+		Scope:     c.Scope,
+		Hash:      c.Hash,
+		ExpiresAt: c.ExpiresAt,
+	})
+}
+
+// RequiredPermission is PermissionNone: tokens belong to users, not ledgers.
+func (c *CreateToken) RequiredPermission(context.Context) Permission {
+	return PermissionNone
+}
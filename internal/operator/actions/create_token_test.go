@@ -0,0 +1,45 @@
+package actions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+func TestCreateToken_Perform(t *testing.T) {
+	userID := uuid.Must(uuid.NewV4())
+	expiresAt := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	created := &user.Token{ID: uuid.Must(uuid.NewV4()), UserID: userID}
+	mockUser := &storage.MockIUserWriter{}
+	mockUser.EXPECT().CreateToken(mock.Anything, &user.TokenCreate{
+		UserID:    userID,
+		Name:      "login",
+		Kind:      user.TokenKindSession,
+		Scope:     user.ScopeWrite,
+		Hash:      []byte("hash"),
+		ExpiresAt: &expiresAt,
+	}).Return(created, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.User = mockUser
+	action := &CreateToken{
+		UserID:    userID,
+		Name:      "login",
+		Kind:      user.TokenKindSession,
+		Scope:     user.ScopeWrite,
+		Hash:      []byte("hash"),
+		ExpiresAt: &expiresAt,
+	}
+
+	result, err := action.Perform(context.Background(), wt)
+	require.NoError(t, err)
+	assert.Same(t, created, result)
+}
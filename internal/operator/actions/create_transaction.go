@@ -6,7 +6,9 @@ import (
 	"errors"
 	"time"
 
+	"github.com/carson-networks/budget-server/internal/events"
 	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/category"
 	"github.com/carson-networks/budget-server/internal/storage/transaction"
 	"github.com/gofrs/uuid/v5"
 	"github.com/shopspring/decimal"
@@ -17,6 +19,7 @@ var (
 	ErrCategoryDisabled               = errors.New("category is disabled")
 	ErrCategoryIsParent               = errors.New("category is a parent; transactions must us child category")
 	ErrAccountNotFound                = errors.New("account not found")
+	ErrAmountSignMismatch             = errors.New("amount sign does not match category type")
 )
 
 type CreateTransaction struct {
@@ -25,30 +28,32 @@ type CreateTransaction struct {
 	Amount          decimal.Decimal
 	TransactionName string
 	TransactionDate time.Time
+	IsRefund        bool
 	IAction
 }
 
-func (t *CreateTransaction) Perform(ctx context.Context, writer *storage.Writer) error {
+// Perform returns the created *transaction.Transaction.
+func (t *CreateTransaction) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
 	cat, err := writer.Category.GetByID(ctx, t.CategoryID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return ErrCategoryNotFoundForTransaction
+			return nil, ErrCategoryNotFoundForTransaction
 		}
-		return err
+		return nil, err
 	}
-	if cat.IsDisabled {
-		return ErrCategoryDisabled
+	if err := checkLeafCategory(cat); err != nil {
+		return nil, err
 	}
-	if cat.IsParent {
-		return ErrCategoryIsParent
+	if err := checkAmountSign(cat.CategoryType, t.Amount, t.IsRefund); err != nil {
+		return nil, err
 	}
 
 	account, err := writer.Account.FindByIDForUpdate(ctx, t.AccountID)
 	if err != nil {
-		return err
-	}
-	if account == nil {
-		return ErrAccountNotFound
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, err
 	}
 
 	storageCreate := &transaction.TransactionCreate{
@@ -57,17 +62,70 @@ func (t *CreateTransaction) Perform(ctx context.Context, writer *storage.Writer)
 		Amount:          t.Amount,
 		TransactionName: t.TransactionName,
 		TransactionDate: t.TransactionDate,
+		IsRefund:        t.IsRefund,
 	}
-	_, err = writer.Transaction.Insert(ctx, storageCreate)
+	created, err := writer.Transaction.Insert(ctx, storageCreate)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	newBalance := account.Balance.Add(t.Amount)
 	err = writer.Account.UpdateBalance(ctx, t.AccountID, newBalance)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	if err := recordEvent(ctx, writer, events.TransactionCreated, created.ID, events.NewTransaction(created)); err != nil {
+		return nil, err
+	}
+	balanceChange := events.NewBalanceChange(t.AccountID, created.ID, account.Balance, newBalance)
+	if err := recordEvent(ctx, writer, events.AccountBalanceChanged, t.AccountID, balanceChange); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (t *CreateTransaction) RequiredPermission(context.Context) Permission {
+	return PermissionEdit
+}
+
+// OrderingKey serializes transactions against the same account.
+func (t *CreateTransaction) OrderingKey() string {
+	return t.AccountID.String()
+}
+
+// Inverse deletes the created transaction.
+func (t *CreateTransaction) Inverse(result any) (IAction, error) {
+	created, ok := result.(*transaction.Transaction)
+	if !ok {
+		return nil, ErrNotInvertible
+	}
+	return &DeleteTransaction{ID: created.ID, AccountID: created.AccountID}, nil
+}
+
+// checkLeafCategory returns an error if transactions cannot be assigned to cat.
+func checkLeafCategory(cat *category.Category) error {
+	if cat.IsDisabled {
+		return ErrCategoryDisabled
+	}
+	if cat.IsParent {
+		return ErrCategoryIsParent
+	}
+	return nil
+}
+
+// checkAmountSign enforces the sign convention: money leaving an account is
+// negative and money entering it is positive, so expense transactions are
+// negative and income transactions positive. A refund reverses the expected
+// sign while staying in its category, so a grocery refund reduces grocery
+// spend rather than counting as income. Zero amounts are always rejected.
+func checkAmountSign(categoryType category.CategoryType, amount decimal.Decimal, isRefund bool) error {
+	wantPositive := categoryType == category.CatergoryType_Income
+	if isRefund {
+		wantPositive = !wantPositive
+	}
+	if wantPositive && !amount.IsPositive() || !wantPositive && !amount.IsNegative() {
+		return ErrAmountSignMismatch
+	}
 	return nil
 }
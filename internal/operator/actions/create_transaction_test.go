@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/carson-networks/budget-server/internal/events"
 	"github.com/carson-networks/budget-server/internal/storage"
 	"github.com/carson-networks/budget-server/internal/storage/account"
 	"github.com/carson-networks/budget-server/internal/storage/category"
@@ -34,6 +35,7 @@ func TestCreateTransaction_Perform_Success(t *testing.T) {
 	amount := decimal.NewFromInt(-50)
 	newBalance := existingBalance.Add(amount)
 	txnDate := time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)
+	created := &transaction.Transaction{ID: txnID, AccountID: accountID, CategoryID: categoryID, Amount: amount}
 
 	mockCat := &storage.MockICategoryWriter{}
 	mockCat.EXPECT().
@@ -60,12 +62,13 @@ func TestCreateTransaction_Perform_Success(t *testing.T) {
 			TransactionName: "Groceries",
 			TransactionDate: txnDate,
 		}).
-		Return(txnID, nil)
+		Return(created, nil)
 
 	wt := storage.NewWriterForTest()
 	wt.Category = mockCat
 	wt.Account = mockAccount
 	wt.Transaction = mockTxn
+	mockOutbox := expectEvents(wt, events.TransactionCreated, events.AccountBalanceChanged)
 	action := &CreateTransaction{
 		AccountID:       accountID,
 		CategoryID:      categoryID,
@@ -74,11 +77,13 @@ func TestCreateTransaction_Perform_Success(t *testing.T) {
 		TransactionDate: txnDate,
 	}
 
-	err := action.Perform(context.Background(), wt)
+	result, err := action.Perform(context.Background(), wt)
 	require.NoError(t, err)
+	assert.Same(t, created, result)
 	mockCat.AssertExpectations(t)
 	mockAccount.AssertExpectations(t)
 	mockTxn.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
 }
 
 func TestCreateTransaction_Perform_CategoryNotFound(t *testing.T) {
@@ -100,7 +105,7 @@ func TestCreateTransaction_Perform_CategoryNotFound(t *testing.T) {
 		TransactionDate: time.Now(),
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrCategoryNotFoundForTransaction)
 	mockCat.AssertExpectations(t)
@@ -127,7 +132,7 @@ func TestCreateTransaction_Perform_CategoryDisabled(t *testing.T) {
 		TransactionDate: time.Now(),
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrCategoryDisabled)
 	mockCat.AssertExpectations(t)
@@ -154,7 +159,7 @@ func TestCreateTransaction_Perform_CategoryIsParent(t *testing.T) {
 		TransactionDate: time.Now(),
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrCategoryIsParent)
 	mockCat.AssertExpectations(t)
@@ -172,7 +177,7 @@ func TestCreateTransaction_Perform_AccountNotFound(t *testing.T) {
 	mockAccount := &storage.MockIAccountWriter{}
 	mockAccount.EXPECT().
 		FindByIDForUpdate(mock.Anything, accountID).
-		Return(nil, nil)
+		Return(nil, sql.ErrNoRows)
 
 	wt := storage.NewWriterForTest()
 	wt.Category = mockCat
@@ -181,12 +186,12 @@ func TestCreateTransaction_Perform_AccountNotFound(t *testing.T) {
 	action := &CreateTransaction{
 		AccountID:       accountID,
 		CategoryID:      categoryID,
-		Amount:          decimal.NewFromInt(100),
+		Amount:          decimal.NewFromInt(-100),
 		TransactionName: "Test",
 		TransactionDate: time.Now(),
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrAccountNotFound)
 	mockCat.AssertExpectations(t)
@@ -215,12 +220,12 @@ func TestCreateTransaction_Perform_FindByIDForUpdateError(t *testing.T) {
 	action := &CreateTransaction{
 		AccountID:       accountID,
 		CategoryID:      categoryID,
-		Amount:          decimal.NewFromInt(100),
+		Amount:          decimal.NewFromInt(-100),
 		TransactionName: "Test",
 		TransactionDate: time.Now(),
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	assert.ErrorIs(t, err, findErr)
 	mockCat.AssertExpectations(t)
 	mockAccount.AssertExpectations(t)
@@ -244,7 +249,7 @@ func TestCreateTransaction_Perform_InsertError(t *testing.T) {
 	mockTxn := &storage.MockITransactionWriter{}
 	mockTxn.EXPECT().
 		Insert(mock.Anything, mock.Anything).
-		Return(uuid.Nil, insertErr)
+		Return(nil, insertErr)
 
 	wt := storage.NewWriterForTest()
 	wt.Category = mockCat
@@ -254,12 +259,12 @@ func TestCreateTransaction_Perform_InsertError(t *testing.T) {
 	action := &CreateTransaction{
 		AccountID:       accountID,
 		CategoryID:      categoryID,
-		Amount:          decimal.NewFromInt(100),
+		Amount:          decimal.NewFromInt(-100),
 		TransactionName: "Test",
 		TransactionDate: time.Now(),
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	assert.ErrorIs(t, err, insertErr)
 	mockCat.AssertExpectations(t)
 	mockAccount.AssertExpectations(t)
@@ -272,7 +277,7 @@ func TestCreateTransaction_Perform_UpdateBalanceError(t *testing.T) {
 	categoryID := uuid.Must(uuid.NewV4())
 	txnID := uuid.Must(uuid.NewV4())
 	existingBalance := decimal.NewFromInt(100)
-	amount := decimal.NewFromInt(50)
+	amount := decimal.NewFromInt(-50)
 	newBalance := existingBalance.Add(amount)
 
 	mockCat := &storage.MockICategoryWriter{}
@@ -291,7 +296,7 @@ func TestCreateTransaction_Perform_UpdateBalanceError(t *testing.T) {
 	mockTxn := &storage.MockITransactionWriter{}
 	mockTxn.EXPECT().
 		Insert(mock.Anything, mock.Anything).
-		Return(txnID, nil)
+		Return(&transaction.Transaction{ID: txnID}, nil)
 
 	wt := storage.NewWriterForTest()
 	wt.Category = mockCat
@@ -306,9 +311,100 @@ func TestCreateTransaction_Perform_UpdateBalanceError(t *testing.T) {
 		TransactionDate: time.Now(),
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	assert.ErrorIs(t, err, updateErr)
 	mockCat.AssertExpectations(t)
 	mockAccount.AssertExpectations(t)
 	mockTxn.AssertExpectations(t)
 }
+
+func TestCreateTransaction_Perform_AmountSignMismatch(t *testing.T) {
+	categoryID := uuid.Must(uuid.NewV4())
+	income := &category.Category{ID: categoryID, CategoryType: category.CatergoryType_Income}
+	expense := validCategoryForTransaction(categoryID)
+
+	cases := []struct {
+		name     string
+		cat      *category.Category
+		amount   decimal.Decimal
+		isRefund bool
+	}{
+		{"expense positive", expense, decimal.NewFromInt(10), false},
+		{"expense zero", expense, decimal.Zero, false},
+		{"expense refund negative", expense, decimal.NewFromInt(-10), true},
+		{"income negative", income, decimal.NewFromInt(-10), false},
+		{"income refund positive", income, decimal.NewFromInt(10), true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCat := &storage.MockICategoryWriter{}
+			mockCat.EXPECT().
+				GetByID(mock.Anything, categoryID).
+				Return(tc.cat, nil)
+			mockAccount := &storage.MockIAccountWriter{}
+
+			wt := storage.NewWriterForTest()
+			wt.Category = mockCat
+			wt.Account = mockAccount
+
+			action := &CreateTransaction{
+				AccountID:  uuid.Must(uuid.NewV4()),
+				CategoryID: categoryID,
+				Amount:     tc.amount,
+				IsRefund:   tc.isRefund,
+			}
+
+			_, err := action.Perform(context.Background(), wt)
+			assert.ErrorIs(t, err, ErrAmountSignMismatch)
+			mockAccount.AssertNotCalled(t, "FindByIDForUpdate", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestCreateTransaction_Perform_ExpenseRefund(t *testing.T) {
+	accountID := uuid.Must(uuid.NewV4())
+	categoryID := uuid.Must(uuid.NewV4())
+	existingBalance := decimal.NewFromInt(100)
+	amount := decimal.NewFromInt(25)
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().
+		GetByID(mock.Anything, categoryID).
+		Return(validCategoryForTransaction(categoryID), nil)
+
+	mockAccount := &storage.MockIAccountWriter{}
+	mockAccount.EXPECT().
+		FindByIDForUpdate(mock.Anything, accountID).
+		Return(&account.Account{ID: accountID, Balance: existingBalance}, nil)
+	mockAccount.EXPECT().
+		UpdateBalance(mock.Anything, accountID, existingBalance.Add(amount)).
+		Return(nil)
+
+	mockTxn := &storage.MockITransactionWriter{}
+	mockTxn.EXPECT().
+		Insert(mock.Anything, mock.MatchedBy(func(c *transaction.TransactionCreate) bool {
+			return c.IsRefund && c.Amount.Equal(amount) && c.CategoryID == categoryID
+		})).
+		Return(&transaction.Transaction{ID: uuid.Must(uuid.NewV4())}, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+	wt.Account = mockAccount
+	wt.Transaction = mockTxn
+	mockOutbox := expectEvents(wt, events.TransactionCreated, events.AccountBalanceChanged)
+
+	action := &CreateTransaction{
+		AccountID:       accountID,
+		CategoryID:      categoryID,
+		Amount:          amount,
+		TransactionName: "Grocery return",
+		TransactionDate: time.Now(),
+		IsRefund:        true,
+	}
+
+	_, err := action.Perform(context.Background(), wt)
+	require.NoError(t, err)
+	mockAccount.AssertExpectations(t)
+	mockTxn.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+}
@@ -0,0 +1,65 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+var ErrUsersExist = errors.New("users already exist")
+
+// CreateUser adds a user who can sign in with the password hashed into
+// PasswordHash. FirstUser creates the initial user of a new install, which
+// needs no authentication, and fails with ErrUsersExist once there is one;
+// that user owns any ledger without members, such as one holding data
+// recorded before users existed.
+type CreateUser struct {
+	Username     string
+	PasswordHash string
+	FirstUser    bool
+
+	IAction
+}
+
+// Perform returns the created *user.User.
+func (c *CreateUser) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	if c.FirstUser {
+		exists, err := writer.User.HasUsers(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, ErrUsersExist
+		}
+	}
+	created, err := writer.User.Create(ctx, &user.UserCreate{
+		Username:     c.Username,
+		PasswordHash: c.PasswordHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if c.FirstUser {
+		if _, err := writer.Ledger.ClaimUnowned(ctx, created.ID); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+// RequiredPermission is PermissionNone: users exist outside any ledger.
+func (c *CreateUser) RequiredPermission(context.Context) Permission {
+	return PermissionNone
+}
+
+// IsolationLevel is serializable so two first users can't both find no
+// users.
+func (c *CreateUser) IsolationLevel() sql.IsolationLevel {
+	if c.FirstUser {
+		return sql.LevelSerializable
+	}
+	return sql.LevelDefault
+}
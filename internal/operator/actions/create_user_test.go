@@ -0,0 +1,65 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+)
+
+func TestCreateUser_Perform_Success(t *testing.T) {
+	created := &user.User{ID: uuid.Must(uuid.NewV4()), Username: "sam"}
+	mockUser := &storage.MockIUserWriter{}
+	mockUser.EXPECT().Create(mock.Anything, &user.UserCreate{Username: "sam", PasswordHash: "hash"}).Return(created, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.User = mockUser
+	action := &CreateUser{Username: "sam", PasswordHash: "hash"}
+
+	result, err := action.Perform(context.Background(), wt)
+	require.NoError(t, err)
+	assert.Same(t, created, result)
+	assert.Equal(t, sql.LevelDefault, IsolationLevel(action))
+	mockUser.AssertNotCalled(t, "HasUsers", mock.Anything)
+}
+
+func TestCreateUser_Perform_FirstUserAfterUsersExist(t *testing.T) {
+	mockUser := &storage.MockIUserWriter{}
+	mockUser.EXPECT().HasUsers(mock.Anything).Return(true, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.User = mockUser
+	action := &CreateUser{Username: "sam", PasswordHash: "hash", FirstUser: true}
+
+	_, err := action.Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrUsersExist)
+	assert.Equal(t, sql.LevelSerializable, IsolationLevel(action))
+	mockUser.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateUser_Perform_FirstUserClaimsUnownedLedgers(t *testing.T) {
+	created := &user.User{ID: uuid.Must(uuid.NewV4()), Username: "sam"}
+	mockUser := &storage.MockIUserWriter{}
+	mockUser.EXPECT().HasUsers(mock.Anything).Return(false, nil)
+	mockUser.EXPECT().Create(mock.Anything, &user.UserCreate{Username: "sam", PasswordHash: "hash"}).Return(created, nil)
+	mockLedger := &storage.MockILedgerWriter{}
+	mockLedger.EXPECT().ClaimUnowned(mock.Anything, created.ID).Return([]*ledger.Membership{{UserID: created.ID, Role: ledger.RoleOwner}}, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.User = mockUser
+	wt.Ledger = mockLedger
+	action := &CreateUser{Username: "sam", PasswordHash: "hash", FirstUser: true}
+
+	result, err := action.Perform(context.Background(), wt)
+	require.NoError(t, err)
+	assert.Same(t, created, result)
+	mockLedger.AssertExpectations(t)
+}
@@ -0,0 +1,40 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+// CreateWebhook subscribes URL to domain events. EventTypes limits the
+// events delivered; empty means every event.
+type CreateWebhook struct {
+	URL        string
+	Secret     string
+	EventTypes []string
+
+	IAction
+}
+
+// Perform returns the created *webhook.Webhook.
+func (c *CreateWebhook) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	return writer.Webhook.Create(ctx, &webhook.WebhookCreate{
+		URL:        c.URL,
+		Secret:     c.Secret,
+		EventTypes: c.EventTypes,
+	})
+}
+
+func (c *CreateWebhook) RequiredPermission(context.Context) Permission {
+	return PermissionEdit
+}
+
+// Inverse deletes the created webhook.
+func (c *CreateWebhook) Inverse(result any) (IAction, error) {
+	created, ok := result.(*webhook.Webhook)
+	if !ok {
+		return nil, ErrNotInvertible
+	}
+	return &DeleteWebhook{ID: created.ID}, nil
+}
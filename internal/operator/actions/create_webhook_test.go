@@ -0,0 +1,31 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+func TestCreateWebhook_Perform_Success(t *testing.T) {
+	created := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), URL: "https://example.com/hook"}
+	mockWebhook := &storage.MockIWebhookWriter{}
+	mockWebhook.EXPECT().
+		Create(mock.Anything, &webhook.WebhookCreate{URL: "https://example.com/hook", Secret: "s3cret", EventTypes: []string{"TransactionCreated"}}).
+		Return(created, nil)
+	wt := storage.NewWriterForTest()
+	wt.Webhook = mockWebhook
+
+	action := &CreateWebhook{URL: "https://example.com/hook", Secret: "s3cret", EventTypes: []string{"TransactionCreated"}}
+	result, err := action.Perform(context.Background(), wt)
+
+	require.NoError(t, err)
+	assert.Same(t, created, result)
+	mockWebhook.AssertExpectations(t)
+}
@@ -0,0 +1,78 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/category"
+	"github.com/gofrs/uuid/v5"
+)
+
+// DeleteCategory removes a category that has no child categories and no
+// transactions. Use MergeCategories to fold a category that is still in use
+// into another one.
+type DeleteCategory struct {
+	ID uuid.UUID
+
+	// deleted is the category as it was before Perform removed it.
+	deleted *category.Category
+
+	IAction
+}
+
+func (d *DeleteCategory) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	d.deleted = nil
+	existing, err := writer.Category.GetByID(ctx, d.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	hasChildren, err := writer.Category.HasChildren(ctx, d.ID)
+	if err != nil {
+		return nil, err
+	}
+	if hasChildren {
+		return nil, ErrCategoryHasChildren
+	}
+
+	hasTransactions, err := writer.Transaction.ExistsForCategory(ctx, d.ID)
+	if err != nil {
+		return nil, err
+	}
+	if hasTransactions {
+		return nil, ErrCategoryHasTransactions
+	}
+
+	if err := writer.Category.Delete(ctx, d.ID); err != nil {
+		return nil, err
+	}
+	if err := recordEvent(ctx, writer, events.CategoryDeleted, d.ID, events.CategoryRef{ID: d.ID.String()}); err != nil {
+		return nil, err
+	}
+	d.deleted = existing
+	return nil, nil
+}
+
+func (d *DeleteCategory) RequiredPermission(context.Context) Permission {
+	return PermissionEdit
+}
+
+// IsolationLevel is serializable so no transaction can be assigned to the
+// category between the in-use check and the delete.
+func (d *DeleteCategory) IsolationLevel() sql.IsolationLevel {
+	return sql.LevelSerializable
+}
+
+// Inverse restores the deleted category.
+func (d *DeleteCategory) Inverse(any) (IAction, error) {
+	if d.deleted == nil {
+		return nil, ErrNotInvertible
+	}
+	return &RestoreCategory{Category: *d.deleted}, nil
+}
@@ -0,0 +1,84 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/category"
+)
+
+func TestDeleteCategory_Perform_Success(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, id).Return(&category.Category{ID: id}, nil)
+	mockCat.EXPECT().HasChildren(mock.Anything, id).Return(false, nil)
+	mockCat.EXPECT().Delete(mock.Anything, id).Return(nil)
+	mockTxn := &storage.MockITransactionWriter{}
+	mockTxn.EXPECT().ExistsForCategory(mock.Anything, id).Return(false, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+	wt.Transaction = mockTxn
+	mockOutbox := expectEvents(wt, events.CategoryDeleted)
+
+	_, err := (&DeleteCategory{ID: id}).Perform(context.Background(), wt)
+	require.NoError(t, err)
+	mockCat.AssertExpectations(t)
+	mockTxn.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+}
+
+func TestDeleteCategory_Perform_NotFound(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, id).Return(nil, sql.ErrNoRows)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+
+	_, err := (&DeleteCategory{ID: id}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrCategoryNotFound)
+}
+
+func TestDeleteCategory_Perform_HasChildren(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, id).Return(&category.Category{ID: id, IsParent: true}, nil)
+	mockCat.EXPECT().HasChildren(mock.Anything, id).Return(true, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+
+	_, err := (&DeleteCategory{ID: id}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrCategoryHasChildren)
+	mockCat.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestDeleteCategory_Perform_HasTransactions(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, id).Return(&category.Category{ID: id}, nil)
+	mockCat.EXPECT().HasChildren(mock.Anything, id).Return(false, nil)
+	mockTxn := &storage.MockITransactionWriter{}
+	mockTxn.EXPECT().ExistsForCategory(mock.Anything, id).Return(true, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+	wt.Transaction = mockTxn
+
+	_, err := (&DeleteCategory{ID: id}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrCategoryHasTransactions)
+	mockCat.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,90 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/transaction"
+	"github.com/gofrs/uuid/v5"
+)
+
+var ErrTransactionNotFound = errors.New("transaction not found")
+
+// DeleteTransaction removes a transaction from AccountID and reverses its
+// effect on the account balance.
+type DeleteTransaction struct {
+	ID        uuid.UUID
+	AccountID uuid.UUID
+
+	// deleted is the transaction as it was before Perform removed it.
+	deleted *transaction.Transaction
+
+	IAction
+}
+
+func (d *DeleteTransaction) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	d.deleted = nil
+	existing, err := writer.Transaction.FindByID(ctx, d.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, err
+	}
+	if existing.AccountID != d.AccountID {
+		return nil, ErrTransactionNotFound
+	}
+
+	account, err := writer.Account.FindByIDForUpdate(ctx, d.AccountID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, err
+	}
+
+	if err := writer.Transaction.Delete(ctx, d.ID); err != nil {
+		return nil, err
+	}
+	newBalance := account.Balance.Sub(existing.Amount)
+	if err := writer.Account.UpdateBalance(ctx, d.AccountID, newBalance); err != nil {
+		return nil, err
+	}
+
+	if err := recordEvent(ctx, writer, events.TransactionDeleted, d.ID, events.NewTransaction(existing)); err != nil {
+		return nil, err
+	}
+	balanceChange := events.NewBalanceChange(d.AccountID, d.ID, account.Balance, newBalance)
+	if err := recordEvent(ctx, writer, events.AccountBalanceChanged, d.AccountID, balanceChange); err != nil {
+		return nil, err
+	}
+	d.deleted = existing
+	return nil, nil
+}
+
+func (d *DeleteTransaction) RequiredPermission(context.Context) Permission {
+	return PermissionEdit
+}
+
+// OrderingKey serializes transactions against the same account.
+func (d *DeleteTransaction) OrderingKey() string {
+	return d.AccountID.String()
+}
+
+// Inverse creates the transaction again. It gets a new ID.
+func (d *DeleteTransaction) Inverse(any) (IAction, error) {
+	if d.deleted == nil {
+		return nil, ErrNotInvertible
+	}
+	return &CreateTransaction{
+		AccountID:       d.deleted.AccountID,
+		CategoryID:      d.deleted.CategoryID,
+		Amount:          d.deleted.Amount,
+		TransactionName: d.deleted.TransactionName,
+		TransactionDate: d.deleted.TransactionDate,
+		IsRefund:        d.deleted.IsRefund,
+	}, nil
+}
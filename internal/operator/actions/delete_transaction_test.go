@@ -0,0 +1,106 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/account"
+	"github.com/carson-networks/budget-server/internal/storage/transaction"
+)
+
+func TestDeleteTransaction_Perform_ReversesBalance(t *testing.T) {
+	accountID := uuid.Must(uuid.NewV4())
+	existing := &transaction.Transaction{
+		ID:              uuid.Must(uuid.NewV4()),
+		AccountID:       accountID,
+		CategoryID:      uuid.Must(uuid.NewV4()),
+		Amount:          decimal.NewFromInt(-50),
+		TransactionName: "Groceries",
+		TransactionDate: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockTxn := &storage.MockITransactionWriter{}
+	mockTxn.EXPECT().FindByID(mock.Anything, existing.ID).Return(existing, nil)
+	mockTxn.EXPECT().Delete(mock.Anything, existing.ID).Return(nil)
+	mockAccount := &storage.MockIAccountWriter{}
+	mockAccount.EXPECT().
+		FindByIDForUpdate(mock.Anything, accountID).
+		Return(&account.Account{ID: accountID, Balance: decimal.NewFromInt(450)}, nil)
+	mockAccount.EXPECT().UpdateBalance(mock.Anything, accountID, decimal.NewFromInt(500)).Return(nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Transaction = mockTxn
+	wt.Account = mockAccount
+	mockOutbox := expectEvents(wt, events.TransactionDeleted, events.AccountBalanceChanged)
+	action := &DeleteTransaction{ID: existing.ID, AccountID: accountID}
+
+	_, err := action.Perform(context.Background(), wt)
+	require.NoError(t, err)
+	mockTxn.AssertExpectations(t)
+	mockAccount.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+
+	inverse, err := action.Inverse(nil)
+	require.NoError(t, err)
+	assert.Equal(t, &CreateTransaction{
+		AccountID:       accountID,
+		CategoryID:      existing.CategoryID,
+		Amount:          existing.Amount,
+		TransactionName: "Groceries",
+		TransactionDate: existing.TransactionDate,
+	}, inverse)
+}
+
+func TestDeleteTransaction_Perform_NotFound(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+
+	mockTxn := &storage.MockITransactionWriter{}
+	mockTxn.EXPECT().FindByID(mock.Anything, id).Return(nil, sql.ErrNoRows)
+
+	wt := storage.NewWriterForTest()
+	wt.Transaction = mockTxn
+
+	_, err := (&DeleteTransaction{ID: id}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrTransactionNotFound)
+}
+
+func TestDeleteTransaction_Perform_OtherAccount(t *testing.T) {
+	existing := &transaction.Transaction{ID: uuid.Must(uuid.NewV4()), AccountID: uuid.Must(uuid.NewV4())}
+
+	mockTxn := &storage.MockITransactionWriter{}
+	mockTxn.EXPECT().FindByID(mock.Anything, existing.ID).Return(existing, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Transaction = mockTxn
+
+	_, err := (&DeleteTransaction{ID: existing.ID, AccountID: uuid.Must(uuid.NewV4())}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrTransactionNotFound)
+	mockTxn.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestDeleteTransaction_Perform_AccountNotFound(t *testing.T) {
+	existing := &transaction.Transaction{ID: uuid.Must(uuid.NewV4()), AccountID: uuid.Must(uuid.NewV4())}
+
+	mockTxn := &storage.MockITransactionWriter{}
+	mockTxn.EXPECT().FindByID(mock.Anything, existing.ID).Return(existing, nil)
+	mockAccount := &storage.MockIAccountWriter{}
+	mockAccount.EXPECT().FindByIDForUpdate(mock.Anything, existing.AccountID).Return(nil, sql.ErrNoRows)
+
+	wt := storage.NewWriterForTest()
+	wt.Transaction = mockTxn
+	wt.Account = mockAccount
+
+	_, err := (&DeleteTransaction{ID: existing.ID, AccountID: existing.AccountID}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+	mockTxn.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,30 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// DeleteWebhook removes a webhook and its delivery log; queued deliveries
+// are dropped.
+type DeleteWebhook struct {
+	ID uuid.UUID
+
+	IAction
+}
+
+func (d *DeleteWebhook) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	if _, err := getWebhook(ctx, writer, d.ID); err != nil {
+		return nil, err
+	}
+	if err := writer.Webhook.Delete(ctx, d.ID); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (d *DeleteWebhook) RequiredPermission(context.Context) Permission {
+	return PermissionEdit
+}
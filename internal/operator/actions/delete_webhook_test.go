@@ -0,0 +1,41 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+func TestDeleteWebhook_Perform_Success(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockWebhook := &storage.MockIWebhookWriter{}
+	mockWebhook.EXPECT().GetByID(mock.Anything, id).Return(&webhook.Webhook{ID: id}, nil)
+	mockWebhook.EXPECT().Delete(mock.Anything, id).Return(nil)
+	wt := storage.NewWriterForTest()
+	wt.Webhook = mockWebhook
+
+	_, err := (&DeleteWebhook{ID: id}).Perform(context.Background(), wt)
+
+	require.NoError(t, err)
+	mockWebhook.AssertExpectations(t)
+}
+
+func TestDeleteWebhook_Perform_NotFound(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockWebhook := &storage.MockIWebhookWriter{}
+	mockWebhook.EXPECT().GetByID(mock.Anything, id).Return(nil, sql.ErrNoRows)
+	wt := storage.NewWriterForTest()
+	wt.Webhook = mockWebhook
+
+	_, err := (&DeleteWebhook{ID: id}).Perform(context.Background(), wt)
+
+	assert.ErrorIs(t, err, ErrWebhookNotFound)
+}
@@ -0,0 +1,101 @@
+package actions
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/idempotency"
+)
+
+var ErrIdempotencyKeyReused = errors.New("idempotency key was already used for a different request")
+
+// Idempotent runs Action at most once per Key within Scope, the ledger or
+// user the request was made for. The key, a hash of the
+// request and the response are stored in the same transaction as Action, so
+// either both commit or neither does. When the key has already been stored
+// for the same request, Action is skipped, Perform returns a nil result and
+// Replay holds the original response. A concurrent first use of the key surfaces as
+// idempotency.ErrKeyExists once the other request commits; retrying then
+// replays its response.
+type Idempotent struct {
+	Scope       string
+	Key         string
+	RequestHash []byte
+	Action      IAction
+	// Response is called with Action's result after it succeeds to produce
+	// the response to store.
+	Response func(result any) (json.RawMessage, error)
+
+	Replay json.RawMessage
+
+	IAction
+}
+
+// Perform returns Action's result, or nil when the response is replayed.
+func (i *Idempotent) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	i.Replay = nil
+	existing, err := writer.Idempotency.Get(ctx, i.Scope, i.Key)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if existing != nil {
+		if !bytes.Equal(existing.RequestHash, i.RequestHash) {
+			return nil, ErrIdempotencyKeyReused
+		}
+		i.Replay = existing.Response
+		return nil, nil
+	}
+
+	result, err := i.Action.Perform(ctx, writer)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := i.Response(result)
+	if err != nil {
+		return nil, err
+	}
+	err = writer.Idempotency.Insert(ctx, &idempotency.Record{
+		Scope:       i.Scope,
+		Key:         i.Key,
+		RequestHash: i.RequestHash,
+		Response:    response,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// OrderingKey returns the wrapped action's key.
+func (i *Idempotent) OrderingKey() string {
+	return OrderingKey(i.Action)
+}
+
+// IsolationLevel returns the wrapped action's level.
+func (i *Idempotent) IsolationLevel() sql.IsolationLevel {
+	return IsolationLevel(i.Action)
+}
+
+// RequiredPermission returns the wrapped action's permission.
+func (i *Idempotent) RequiredPermission(ctx context.Context) Permission {
+	return i.Action.RequiredPermission(ctx)
+}
+
+// ActionName returns the wrapped action's name.
+func (i *Idempotent) ActionName() string {
+	return Name(i.Action)
+}
+
+// Inverse returns the wrapped action's inverse. A replayed response changed
+// nothing, so there is nothing to undo.
+func (i *Idempotent) Inverse(result any) (IAction, error) {
+	if i.Replay != nil {
+		return nil, ErrNotInvertible
+	}
+	return Inverse(i.Action, result)
+}
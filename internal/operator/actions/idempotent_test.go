@@ -0,0 +1,102 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/idempotency"
+)
+
+func TestIdempotent_Perform_FirstUse(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	mockIdem := &storage.MockIIdempotencyWriter{}
+	mockIdem.EXPECT().Get(mock.Anything, "ledger:1", "key-1").Return(nil, sql.ErrNoRows)
+	mockIdem.EXPECT().
+		Insert(mock.Anything, &idempotency.Record{
+			Scope:       "ledger:1",
+			Key:         "key-1",
+			RequestHash: []byte("hash"),
+			Response:    json.RawMessage(`{"status":201}`),
+		}).
+		Return(nil)
+	wt.Idempotency = mockIdem
+	inner := &MockIAction{}
+	inner.EXPECT().Perform(mock.Anything, wt).Return("created", nil)
+
+	var responded any
+	action := &Idempotent{
+		Scope:       "ledger:1",
+		Key:         "key-1",
+		RequestHash: []byte("hash"),
+		Action:      inner,
+		Response: func(result any) (json.RawMessage, error) {
+			responded = result
+			return json.RawMessage(`{"status":201}`), nil
+		},
+	}
+
+	result, err := action.Perform(context.Background(), wt)
+	require.NoError(t, err)
+	assert.Equal(t, "created", result)
+	assert.Equal(t, "created", responded)
+	assert.Nil(t, action.Replay)
+	inner.AssertExpectations(t)
+	mockIdem.AssertExpectations(t)
+}
+
+func TestIdempotent_Perform_Replay(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	mockIdem := &storage.MockIIdempotencyWriter{}
+	mockIdem.EXPECT().
+		Get(mock.Anything, "ledger:1", "key-1").
+		Return(&idempotency.Record{Key: "key-1", RequestHash: []byte("hash"), Response: json.RawMessage(`{"status":201}`)}, nil)
+	wt.Idempotency = mockIdem
+	inner := &MockIAction{}
+
+	action := &Idempotent{Scope: "ledger:1", Key: "key-1", RequestHash: []byte("hash"), Action: inner}
+
+	result, err := action.Perform(context.Background(), wt)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+	assert.JSONEq(t, `{"status":201}`, string(action.Replay))
+	inner.AssertNotCalled(t, "Perform", mock.Anything, mock.Anything)
+	mockIdem.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+}
+
+func TestIdempotent_Perform_KeyReused(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	mockIdem := &storage.MockIIdempotencyWriter{}
+	mockIdem.EXPECT().
+		Get(mock.Anything, "ledger:1", "key-1").
+		Return(&idempotency.Record{Key: "key-1", RequestHash: []byte("other")}, nil)
+	wt.Idempotency = mockIdem
+	inner := &MockIAction{}
+
+	action := &Idempotent{Scope: "ledger:1", Key: "key-1", RequestHash: []byte("hash"), Action: inner}
+
+	_, err := action.Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+	inner.AssertNotCalled(t, "Perform", mock.Anything, mock.Anything)
+}
+
+func TestIdempotent_Perform_ActionFailsStoresNothing(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	mockIdem := &storage.MockIIdempotencyWriter{}
+	mockIdem.EXPECT().Get(mock.Anything, "ledger:1", "key-1").Return(nil, sql.ErrNoRows)
+	wt.Idempotency = mockIdem
+	inner := &MockIAction{}
+	inner.EXPECT().Perform(mock.Anything, wt).Return(nil, ErrCategoryDisabled)
+
+	action := &Idempotent{Scope: "ledger:1", Key: "key-1", RequestHash: []byte("hash"), Action: inner}
+
+	_, err := action.Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrCategoryDisabled)
+	mockIdem.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+}
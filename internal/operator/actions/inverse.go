@@ -0,0 +1,131 @@
+package actions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var ErrNotInvertible = errors.New("action cannot be undone")
+
+// Invertible is implemented by actions that can produce the action that
+// reverses them. Inverse is called after Perform succeeded, in the same
+// transaction attempt, with Perform's result; actions that need the rows as
+// they were before keep them from Perform in unexported fields. It returns
+// ErrNotInvertible when this particular run cannot be reversed.
+type Invertible interface {
+	Inverse(result any) (IAction, error)
+}
+
+// Inverse returns the action that reverses action given its result, or
+// ErrNotInvertible.
+func Inverse(action IAction, result any) (IAction, error) {
+	invertible, ok := action.(Invertible)
+	if !ok {
+		return nil, ErrNotInvertible
+	}
+	return invertible.Inverse(result)
+}
+
+// decodable lists the actions an inverse may be made of, by name.
+var decodable = map[string]func() IAction{
+	"CreateTransaction": func() IAction { return &CreateTransaction{} },
+	"DeleteTransaction": func() IAction { return &DeleteTransaction{} },
+	"DeleteCategory":    func() IAction { return &DeleteCategory{} },
+	"RestoreCategory":   func() IAction { return &RestoreCategory{} },
+	"MergeCategories":   func() IAction { return &MergeCategories{} },
+	"UpdateCategory":    func() IAction { return &UpdateCategory{} },
+	"DeleteWebhook":     func() IAction { return &DeleteWebhook{} },
+	"UpdateWebhook":     func() IAction { return &UpdateWebhook{} },
+}
+
+// encodedAction is an action as stored with its audit record. A Batch keeps
+// its actions encoded in Actions.
+type encodedAction struct {
+	Type    string            `json:"type"`
+	Action  json.RawMessage   `json:"action,omitempty"`
+	Actions []json.RawMessage `json:"actions,omitempty"`
+}
+
+// EncodeAction serializes an inverse action so it can be stored and later
+// run by DecodeAction.
+func EncodeAction(action IAction) (json.RawMessage, error) {
+	if batch, ok := action.(*Batch); ok {
+		encoded := encodedAction{Type: "Batch", Actions: make([]json.RawMessage, len(batch.Actions))}
+		for i, item := range batch.Actions {
+			data, err := EncodeAction(item)
+			if err != nil {
+				return nil, err
+			}
+			encoded.Actions[i] = data
+		}
+		return json.Marshal(encoded)
+	}
+
+	name := Name(action)
+	if _, ok := decodable[name]; !ok {
+		return nil, fmt.Errorf("action %s cannot be encoded", name)
+	}
+	data, err := json.Marshal(action)
+	if err != nil {
+		return nil, err
+	}
+	// Drop the embedded IAction, which is always nil.
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	delete(fields, "IAction")
+	if data, err = json.Marshal(fields); err != nil {
+		return nil, err
+	}
+	return json.Marshal(encodedAction{Type: name, Action: data})
+}
+
+// DecodeAction rebuilds an action encoded by EncodeAction.
+func DecodeAction(data json.RawMessage) (IAction, error) {
+	var encoded encodedAction
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return nil, err
+	}
+	if encoded.Type == "Batch" {
+		batch := &Batch{Actions: make([]IAction, len(encoded.Actions))}
+		for i, item := range encoded.Actions {
+			action, err := DecodeAction(item)
+			if err != nil {
+				return nil, err
+			}
+			batch.Actions[i] = action
+		}
+		return batch, nil
+	}
+
+	newAction, ok := decodable[encoded.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown action type %q", encoded.Type)
+	}
+	action := newAction()
+	if err := json.Unmarshal(encoded.Action, action); err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+// inverseAll returns a Batch reversing actions with their results: each
+// action's inverse, last action first.
+func inverseAll(actions []IAction, results []any) (IAction, error) {
+	if len(actions) == 0 || len(actions) != len(results) {
+		return nil, ErrNotInvertible
+	}
+	inverses := make([]IAction, len(actions))
+	for i, action := range actions {
+		inverse, err := Inverse(action, results[i])
+		if err != nil {
+			return nil, err
+		}
+		inverses[i] = inverse
+	}
+	slices.Reverse(inverses)
+	return &Batch{Actions: inverses}, nil
+}
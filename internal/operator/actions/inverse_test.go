@@ -0,0 +1,150 @@
+package actions
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage/category"
+)
+
+func TestEncodeAction_RoundTrips(t *testing.T) {
+	parentID := uuid.Must(uuid.NewV4())
+	fromID := uuid.Must(uuid.NewV4())
+	name := "Food"
+	action := &Batch{Actions: []IAction{
+		&RestoreCategory{
+			Category: category.Category{
+				ID:               uuid.Must(uuid.NewV4()),
+				Name:             "Groceries",
+				ParentCategoryID: &parentID,
+				CategoryType:     category.CatergoryType_Expense,
+				CreatedAt:        time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+			},
+			TransactionIDs: []uuid.UUID{uuid.Must(uuid.NewV4())},
+			FromCategoryID: &fromID,
+		},
+		&UpdateCategory{ID: parentID, Name: &name},
+		&CreateTransaction{
+			AccountID:       uuid.Must(uuid.NewV4()),
+			CategoryID:      uuid.Must(uuid.NewV4()),
+			Amount:          decimal.RequireFromString("-12.5"),
+			TransactionName: "Lunch",
+			TransactionDate: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}}
+
+	data, err := EncodeAction(action)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "IAction")
+
+	decoded, err := DecodeAction(data)
+	require.NoError(t, err)
+	assert.Equal(t, action, decoded)
+}
+
+func TestEncodeAction_RejectsUnregisteredAction(t *testing.T) {
+	_, err := EncodeAction(&CreateAccount{Name: "Checking"})
+	assert.Error(t, err)
+}
+
+func TestDecodeAction_UnknownType(t *testing.T) {
+	_, err := DecodeAction(json.RawMessage(`{"type":"DropTables","action":{}}`))
+	assert.Error(t, err)
+}
+
+func TestInverse_NotInvertible(t *testing.T) {
+	_, err := Inverse(&CreateAccount{}, nil)
+	assert.ErrorIs(t, err, ErrNotInvertible)
+
+	_, err = Inverse(&DeleteCategory{ID: uuid.Must(uuid.NewV4())}, nil)
+	assert.ErrorIs(t, err, ErrNotInvertible)
+}
+
+func TestInverse_CreateCategory(t *testing.T) {
+	created := &category.Category{ID: uuid.Must(uuid.NewV4())}
+
+	inverse, err := Inverse(&CreateCategory{Name: "Food"}, created)
+	require.NoError(t, err)
+	assert.Equal(t, &DeleteCategory{ID: created.ID}, inverse)
+}
+
+func TestInverse_UpdateCategory_RestoresChangedFields(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	name := "Dining"
+	action := &UpdateCategory{ID: id, Name: &name}
+	action.previous = &category.Category{ID: id, Name: "Food", IsDisabled: true}
+
+	inverse, err := Inverse(action, nil)
+	require.NoError(t, err)
+	previousName := "Food"
+	assert.Equal(t, &UpdateCategory{ID: id, Name: &previousName}, inverse)
+}
+
+func TestInverse_UpdateCategory_RestoresMonthlyBudget(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	budget := decimal.RequireFromString("300")
+	previousBudget := decimal.RequireFromString("250")
+
+	action := &UpdateCategory{ID: id, MonthlyBudget: &budget}
+	action.previous = &category.Category{ID: id, MonthlyBudget: &previousBudget}
+	inverse, err := Inverse(action, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &UpdateCategory{ID: id, MonthlyBudget: &previousBudget}, inverse)
+
+	action = &UpdateCategory{ID: id, MonthlyBudget: &budget}
+	action.previous = &category.Category{ID: id}
+	inverse, err = Inverse(action, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &UpdateCategory{ID: id, ClearMonthlyBudget: true}, inverse)
+}
+
+func TestInverse_ApplyCategoryTemplate_DeletesChildrenFirst(t *testing.T) {
+	parent := &category.Category{ID: uuid.Must(uuid.NewV4())}
+	child := &category.Category{ID: uuid.Must(uuid.NewV4())}
+	result := &ApplyCategoryTemplateResult{Created: []*category.Category{parent, child}}
+
+	inverse, err := Inverse(&ApplyCategoryTemplate{}, result)
+	require.NoError(t, err)
+	assert.Equal(t, &Batch{Actions: []IAction{
+		&DeleteCategory{ID: child.ID},
+		&DeleteCategory{ID: parent.ID},
+	}}, inverse)
+
+	_, err = Inverse(&ApplyCategoryTemplate{}, &ApplyCategoryTemplateResult{})
+	assert.ErrorIs(t, err, ErrNotInvertible)
+}
+
+func TestInverse_Batch_ReversesOrder(t *testing.T) {
+	first := &category.Category{ID: uuid.Must(uuid.NewV4())}
+	second := &category.Category{ID: uuid.Must(uuid.NewV4())}
+	batch := &Batch{Actions: []IAction{&CreateCategory{}, &CreateCategory{}}}
+
+	inverse, err := Inverse(batch, []any{first, second})
+	require.NoError(t, err)
+	assert.Equal(t, &Batch{Actions: []IAction{
+		&DeleteCategory{ID: second.ID},
+		&DeleteCategory{ID: first.ID},
+	}}, inverse)
+
+	_, err = Inverse(&Batch{Actions: []IAction{&CreateCategory{}, &CreateAccount{}}}, []any{first, nil})
+	assert.ErrorIs(t, err, ErrNotInvertible)
+}
+
+func TestInverse_Idempotent(t *testing.T) {
+	created := &category.Category{ID: uuid.Must(uuid.NewV4())}
+	action := &Idempotent{Action: &CreateCategory{}}
+
+	inverse, err := Inverse(action, created)
+	require.NoError(t, err)
+	assert.Equal(t, &DeleteCategory{ID: created.ID}, inverse)
+
+	action.Replay = json.RawMessage(`{}`)
+	_, err = Inverse(action, nil)
+	assert.ErrorIs(t, err, ErrNotInvertible)
+}
@@ -0,0 +1,123 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/category"
+	"github.com/gofrs/uuid/v5"
+)
+
+var (
+	ErrMergeSameCategory       = errors.New("source and target category must differ")
+	ErrMergeTargetNotFound     = errors.New("target category not found")
+	ErrMergeTypeMismatch       = errors.New("source and target category types differ")
+	ErrCategoryHasChildren     = errors.New("category has child categories")
+	ErrCategoryHasTransactions = errors.New("category has transactions")
+)
+
+// MergeCategories moves every transaction from SourceID to TargetID and then
+// deletes the source. The target must be a category that could accept a new
+// transaction and have the source's type, so moved amounts keep the sign
+// their category type requires; the source must not have child categories.
+type MergeCategories struct {
+	SourceID uuid.UUID
+	TargetID uuid.UUID
+
+	// source and movedIDs are the deleted source category and the
+	// transactions moved out of it.
+	source   *category.Category
+	movedIDs []uuid.UUID
+
+	IAction
+}
+
+func (m *MergeCategories) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	m.source, m.movedIDs = nil, nil
+	if m.SourceID == m.TargetID {
+		return nil, ErrMergeSameCategory
+	}
+
+	source, err := writer.Category.GetByID(ctx, m.SourceID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	target, err := writer.Category.GetByID(ctx, m.TargetID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrMergeTargetNotFound
+		}
+		return nil, err
+	}
+	if err := checkLeafCategory(target); err != nil {
+		return nil, err
+	}
+	if source.CategoryType != target.CategoryType {
+		return nil, ErrMergeTypeMismatch
+	}
+
+	hasChildren, err := writer.Category.HasChildren(ctx, m.SourceID)
+	if err != nil {
+		return nil, err
+	}
+	if hasChildren {
+		return nil, ErrCategoryHasChildren
+	}
+
+	toMove, err := writer.Transaction.ListByCategory(ctx, m.SourceID)
+	if err != nil {
+		return nil, err
+	}
+	moved, err := writer.Transaction.ReassignCategory(ctx, m.SourceID, m.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writer.Category.Delete(ctx, m.SourceID); err != nil {
+		return nil, err
+	}
+	merge := events.Merge{
+		SourceID:          m.SourceID.String(),
+		TargetID:          m.TargetID.String(),
+		TransactionsMoved: moved,
+	}
+	if err := recordEvent(ctx, writer, events.CategoriesMerged, m.SourceID, merge); err != nil {
+		return nil, err
+	}
+	m.source = source
+	m.movedIDs = make([]uuid.UUID, len(toMove))
+	for i, txn := range toMove {
+		m.movedIDs[i] = txn.ID
+	}
+	return nil, nil
+}
+
+func (m *MergeCategories) RequiredPermission(context.Context) Permission {
+	return PermissionEdit
+}
+
+// IsolationLevel is serializable so no transaction can be moved onto the
+// source category after it has been emptied.
+func (m *MergeCategories) IsolationLevel() sql.IsolationLevel {
+	return sql.LevelSerializable
+}
+
+// Inverse restores the source category and moves the merged transactions
+// back into it.
+func (m *MergeCategories) Inverse(any) (IAction, error) {
+	if m.source == nil {
+		return nil, ErrNotInvertible
+	}
+	return &RestoreCategory{
+		Category:       *m.source,
+		TransactionIDs: m.movedIDs,
+		FromCategoryID: &m.TargetID,
+	}, nil
+}
@@ -0,0 +1,142 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/category"
+	"github.com/carson-networks/budget-server/internal/storage/transaction"
+)
+
+func TestMergeCategories_Perform_Success(t *testing.T) {
+	sourceID := uuid.Must(uuid.NewV4())
+	targetID := uuid.Must(uuid.NewV4())
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, sourceID).Return(&category.Category{ID: sourceID}, nil)
+	mockCat.EXPECT().GetByID(mock.Anything, targetID).Return(&category.Category{ID: targetID}, nil)
+	mockCat.EXPECT().HasChildren(mock.Anything, sourceID).Return(false, nil)
+	mockCat.EXPECT().Delete(mock.Anything, sourceID).Return(nil)
+	movedID := uuid.Must(uuid.NewV4())
+	mockTxn := &storage.MockITransactionWriter{}
+	mockTxn.EXPECT().ListByCategory(mock.Anything, sourceID).Return([]*transaction.Transaction{{ID: movedID}}, nil)
+	mockTxn.EXPECT().ReassignCategory(mock.Anything, sourceID, targetID).Return(int64(1), nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+	wt.Transaction = mockTxn
+	mockOutbox := expectEvents(wt, events.CategoriesMerged)
+	action := &MergeCategories{SourceID: sourceID, TargetID: targetID}
+
+	_, err := action.Perform(context.Background(), wt)
+	require.NoError(t, err)
+	mockCat.AssertExpectations(t)
+	mockTxn.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+
+	inverse, err := action.Inverse(nil)
+	require.NoError(t, err)
+	assert.Equal(t, &RestoreCategory{
+		Category:       category.Category{ID: sourceID},
+		TransactionIDs: []uuid.UUID{movedID},
+		FromCategoryID: &targetID,
+	}, inverse)
+}
+
+func TestMergeCategories_Perform_SameCategory(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	wt := storage.NewWriterForTest()
+	action := &MergeCategories{SourceID: id, TargetID: id}
+
+	_, err := action.Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrMergeSameCategory)
+}
+
+func TestMergeCategories_Perform_TargetNotFound(t *testing.T) {
+	sourceID := uuid.Must(uuid.NewV4())
+	targetID := uuid.Must(uuid.NewV4())
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, sourceID).Return(&category.Category{ID: sourceID}, nil)
+	mockCat.EXPECT().GetByID(mock.Anything, targetID).Return(nil, sql.ErrNoRows)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+	action := &MergeCategories{SourceID: sourceID, TargetID: targetID}
+
+	_, err := action.Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrMergeTargetNotFound)
+	mockCat.AssertExpectations(t)
+}
+
+func TestMergeCategories_Perform_TargetNotLeaf(t *testing.T) {
+	sourceID := uuid.Must(uuid.NewV4())
+	parentID := uuid.Must(uuid.NewV4())
+	disabledID := uuid.Must(uuid.NewV4())
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, sourceID).Return(&category.Category{ID: sourceID}, nil)
+	mockCat.EXPECT().GetByID(mock.Anything, parentID).Return(&category.Category{ID: parentID, IsParent: true}, nil)
+	mockCat.EXPECT().GetByID(mock.Anything, disabledID).Return(&category.Category{ID: disabledID, IsDisabled: true}, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+
+	_, err := (&MergeCategories{SourceID: sourceID, TargetID: parentID}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrCategoryIsParent)
+
+	_, err = (&MergeCategories{SourceID: sourceID, TargetID: disabledID}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrCategoryDisabled)
+	mockCat.AssertExpectations(t)
+}
+
+func TestMergeCategories_Perform_TypeMismatch(t *testing.T) {
+	sourceID := uuid.Must(uuid.NewV4())
+	targetID := uuid.Must(uuid.NewV4())
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, sourceID).
+		Return(&category.Category{ID: sourceID, CategoryType: category.CatergoryType_Income}, nil)
+	mockCat.EXPECT().GetByID(mock.Anything, targetID).
+		Return(&category.Category{ID: targetID, CategoryType: category.CatergoryType_Expense}, nil)
+	mockTxn := &storage.MockITransactionWriter{}
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+	wt.Transaction = mockTxn
+	action := &MergeCategories{SourceID: sourceID, TargetID: targetID}
+
+	_, err := action.Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrMergeTypeMismatch)
+	mockCat.AssertExpectations(t)
+	mockTxn.AssertNotCalled(t, "ReassignCategory", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMergeCategories_Perform_SourceHasChildren(t *testing.T) {
+	sourceID := uuid.Must(uuid.NewV4())
+	targetID := uuid.Must(uuid.NewV4())
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, sourceID).Return(&category.Category{ID: sourceID, IsParent: true}, nil)
+	mockCat.EXPECT().GetByID(mock.Anything, targetID).Return(&category.Category{ID: targetID}, nil)
+	mockCat.EXPECT().HasChildren(mock.Anything, sourceID).Return(true, nil)
+	mockTxn := &storage.MockITransactionWriter{}
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+	wt.Transaction = mockTxn
+	action := &MergeCategories{SourceID: sourceID, TargetID: targetID}
+
+	_, err := action.Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrCategoryHasChildren)
+	mockTxn.AssertNotCalled(t, "ReassignCategory", mock.Anything, mock.Anything, mock.Anything)
+	mockCat.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
@@ -23,21 +23,33 @@ func (_m *MockIAction) EXPECT() *MockIAction_Expecter {
 }
 
 // Perform provides a mock function with given fields: ctx, writer
-func (_m *MockIAction) Perform(ctx context.Context, writer *storage.Writer) error {
+func (_m *MockIAction) Perform(ctx context.Context, writer *storage.Writer) (interface{}, error) {
 	ret := _m.Called(ctx, writer)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Perform")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *storage.Writer) error); ok {
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *storage.Writer) (interface{}, error)); ok {
+		return rf(ctx, writer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *storage.Writer) interface{}); ok {
 		r0 = rf(ctx, writer)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *storage.Writer) error); ok {
+		r1 = rf(ctx, writer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockIAction_Perform_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Perform'
@@ -59,12 +71,58 @@ func (_c *MockIAction_Perform_Call) Run(run func(ctx context.Context, writer *st
 	return _c
 }
 
-func (_c *MockIAction_Perform_Call) Return(_a0 error) *MockIAction_Perform_Call {
+func (_c *MockIAction_Perform_Call) Return(_a0 interface{}, _a1 error) *MockIAction_Perform_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockIAction_Perform_Call) RunAndReturn(run func(context.Context, *storage.Writer) (interface{}, error)) *MockIAction_Perform_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequiredPermission provides a mock function with given fields: ctx
+func (_m *MockIAction) RequiredPermission(ctx context.Context) Permission {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequiredPermission")
+	}
+
+	var r0 Permission
+	if rf, ok := ret.Get(0).(func(context.Context) Permission); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(Permission)
+	}
+
+	return r0
+}
+
+// MockIAction_RequiredPermission_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequiredPermission'
+type MockIAction_RequiredPermission_Call struct {
+	*mock.Call
+}
+
+// RequiredPermission is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIAction_Expecter) RequiredPermission(ctx interface{}) *MockIAction_RequiredPermission_Call {
+	return &MockIAction_RequiredPermission_Call{Call: _e.mock.On("RequiredPermission", ctx)}
+}
+
+func (_c *MockIAction_RequiredPermission_Call) Run(run func(ctx context.Context)) *MockIAction_RequiredPermission_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIAction_RequiredPermission_Call) Return(_a0 Permission) *MockIAction_RequiredPermission_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockIAction_Perform_Call) RunAndReturn(run func(context.Context, *storage.Writer) error) *MockIAction_Perform_Call {
+func (_c *MockIAction_RequiredPermission_Call) RunAndReturn(run func(context.Context) Permission) *MockIAction_RequiredPermission_Call {
 	_c.Call.Return(run)
 	return _c
 }
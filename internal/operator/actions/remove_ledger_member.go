@@ -0,0 +1,56 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+var ErrLedgerMemberNotFound = errors.New("ledger member not found")
+
+// RemoveLedgerMember removes UserID from the ledger. Owners may remove
+// anyone and any member may remove themselves, but the last owner can't
+// leave. It acts on the request's ledger, whose role the operator checks.
+type RemoveLedgerMember struct {
+	UserID uuid.UUID
+
+	IAction
+}
+
+func (r *RemoveLedgerMember) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := keepAnOwner(ctx, writer, ledgerID, r.UserID); err != nil {
+		return nil, err
+	}
+
+	removed, err := writer.Ledger.RemoveMember(ctx, ledgerID, r.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !removed {
+		return nil, ErrLedgerMemberNotFound
+	}
+	return nil, nil
+}
+
+// RequiredPermission lets any member remove themselves but only owners
+// remove others. Who is removing comes from the request, not the action.
+func (r *RemoveLedgerMember) RequiredPermission(ctx context.Context) Permission {
+	if actorID, ok := requestctx.ActorID(ctx); ok && actorID == r.UserID {
+		return PermissionView
+	}
+	return PermissionOwn
+}
+
+// IsolationLevel is serializable so two owners can't remove each other at
+// once and leave the ledger with none.
+func (r *RemoveLedgerMember) IsolationLevel() sql.IsolationLevel {
+	return sql.LevelSerializable
+}
@@ -0,0 +1,76 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+)
+
+func TestRemoveLedgerMember_Perform_OwnerRemovesMember(t *testing.T) {
+	mockLedger := &storage.MockILedgerWriter{}
+	mockLedger.EXPECT().GetMembership(mock.Anything, testLedgerID, testMemberID).Return(membership(testMemberID, ledger.RoleViewer), nil)
+	mockLedger.EXPECT().RemoveMember(mock.Anything, testLedgerID, testMemberID).Return(true, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Ledger = mockLedger
+	action := &RemoveLedgerMember{UserID: testMemberID}
+
+	_, err := action.Perform(ledgerContext(), wt)
+	require.NoError(t, err)
+	assert.Equal(t, sql.LevelSerializable, IsolationLevel(action))
+	mockLedger.AssertExpectations(t)
+}
+
+func TestRemoveLedgerMember_Perform_MemberLeaves(t *testing.T) {
+	mockLedger := &storage.MockILedgerWriter{}
+	mockLedger.EXPECT().GetMembership(mock.Anything, testLedgerID, testMemberID).Return(membership(testMemberID, ledger.RoleViewer), nil)
+	mockLedger.EXPECT().RemoveMember(mock.Anything, testLedgerID, testMemberID).Return(true, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Ledger = mockLedger
+
+	_, err := (&RemoveLedgerMember{UserID: testMemberID}).Perform(ledgerContext(), wt)
+	require.NoError(t, err)
+	mockLedger.AssertExpectations(t)
+}
+
+func TestRemoveLedgerMember_RequiredPermission(t *testing.T) {
+	asMember := requestctx.WithActorID(context.Background(), testMemberID)
+
+	assert.Equal(t, PermissionView, (&RemoveLedgerMember{UserID: testMemberID}).RequiredPermission(asMember))
+	assert.Equal(t, PermissionOwn, (&RemoveLedgerMember{UserID: testOwnerID}).RequiredPermission(asMember))
+	assert.Equal(t, PermissionOwn, (&RemoveLedgerMember{UserID: testMemberID}).RequiredPermission(context.Background()))
+}
+
+func TestRemoveLedgerMember_Perform_LastOwnerLeaves(t *testing.T) {
+	mockLedger := &storage.MockILedgerWriter{}
+	mockLedger.EXPECT().GetMembership(mock.Anything, testLedgerID, testOwnerID).Return(membership(testOwnerID, ledger.RoleOwner), nil)
+	mockLedger.EXPECT().CountOwners(mock.Anything, testLedgerID).Return(1, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Ledger = mockLedger
+
+	_, err := (&RemoveLedgerMember{UserID: testOwnerID}).Perform(ledgerContext(), wt)
+	assert.ErrorIs(t, err, ErrLastOwner)
+	mockLedger.AssertNotCalled(t, "RemoveMember", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRemoveLedgerMember_Perform_NotAMember(t *testing.T) {
+	mockLedger := &storage.MockILedgerWriter{}
+	mockLedger.EXPECT().GetMembership(mock.Anything, testLedgerID, testMemberID).Return(nil, sql.ErrNoRows)
+	mockLedger.EXPECT().RemoveMember(mock.Anything, testLedgerID, testMemberID).Return(false, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Ledger = mockLedger
+
+	_, err := (&RemoveLedgerMember{UserID: testMemberID}).Perform(ledgerContext(), wt)
+	assert.ErrorIs(t, err, ErrLedgerMemberNotFound)
+}
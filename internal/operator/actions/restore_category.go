@@ -0,0 +1,77 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/category"
+	"github.com/gofrs/uuid/v5"
+)
+
+var ErrCategoryExists = errors.New("category already exists")
+
+// RestoreCategory re-creates a deleted category with its original ID and
+// moves TransactionIDs back into it from FromCategoryID. It is the inverse
+// of DeleteCategory and MergeCategories and is only run by Undo.
+type RestoreCategory struct {
+	Category       category.Category
+	TransactionIDs []uuid.UUID
+	FromCategoryID *uuid.UUID
+
+	IAction
+}
+
+// Perform returns the restored *category.Category.
+func (r *RestoreCategory) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	_, err := writer.Category.GetByID(ctx, r.Category.ID)
+	if err == nil {
+		return nil, ErrCategoryExists
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	if r.Category.ParentCategoryID != nil {
+		if _, err := writer.Category.GetByID(ctx, *r.Category.ParentCategoryID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, ErrParentCategoryNotFound
+			}
+			return nil, err
+		}
+	}
+
+	restored, err := writer.Category.Restore(ctx, &r.Category)
+	if err != nil {
+		return nil, err
+	}
+	if r.FromCategoryID != nil {
+		if _, err := writer.Transaction.MoveToCategory(ctx, r.TransactionIDs, *r.FromCategoryID, restored.ID); err != nil {
+			return nil, err
+		}
+	}
+	if err := recordEvent(ctx, writer, events.CategoryCreated, restored.ID, events.NewCategory(restored)); err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+func (r *RestoreCategory) RequiredPermission(context.Context) Permission {
+	return PermissionEdit
+}
+
+// IsolationLevel is serializable so the category ID can't be taken between
+// the existence check and the insert.
+func (r *RestoreCategory) IsolationLevel() sql.IsolationLevel {
+	return sql.LevelSerializable
+}
+
+// Inverse merges the restored category back into the category its
+// transactions came from, or deletes it when none were moved.
+func (r *RestoreCategory) Inverse(any) (IAction, error) {
+	if r.FromCategoryID != nil && len(r.TransactionIDs) > 0 {
+		return &MergeCategories{SourceID: r.Category.ID, TargetID: *r.FromCategoryID}, nil
+	}
+	return &DeleteCategory{ID: r.Category.ID}, nil
+}
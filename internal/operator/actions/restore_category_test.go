@@ -0,0 +1,76 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/category"
+)
+
+func TestRestoreCategory_Perform_MovesTransactionsBack(t *testing.T) {
+	parentID := uuid.Must(uuid.NewV4())
+	fromID := uuid.Must(uuid.NewV4())
+	movedIDs := []uuid.UUID{uuid.Must(uuid.NewV4())}
+	cat := category.Category{ID: uuid.Must(uuid.NewV4()), Name: "Dining", ParentCategoryID: &parentID}
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, cat.ID).Return(nil, sql.ErrNoRows)
+	mockCat.EXPECT().GetByID(mock.Anything, parentID).Return(&category.Category{ID: parentID, IsParent: true}, nil)
+	mockCat.EXPECT().Restore(mock.Anything, &cat).Return(&cat, nil)
+	mockTxn := &storage.MockITransactionWriter{}
+	mockTxn.EXPECT().MoveToCategory(mock.Anything, movedIDs, fromID, cat.ID).Return(int64(1), nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+	wt.Transaction = mockTxn
+	mockOutbox := expectEvents(wt, events.CategoryCreated)
+	action := &RestoreCategory{Category: cat, TransactionIDs: movedIDs, FromCategoryID: &fromID}
+
+	result, err := action.Perform(context.Background(), wt)
+	require.NoError(t, err)
+	assert.Equal(t, &cat, result)
+	mockCat.AssertExpectations(t)
+	mockTxn.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+
+	inverse, err := action.Inverse(result)
+	require.NoError(t, err)
+	assert.Equal(t, &MergeCategories{SourceID: cat.ID, TargetID: fromID}, inverse)
+}
+
+func TestRestoreCategory_Perform_AlreadyExists(t *testing.T) {
+	cat := category.Category{ID: uuid.Must(uuid.NewV4())}
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, cat.ID).Return(&cat, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+
+	_, err := (&RestoreCategory{Category: cat}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrCategoryExists)
+	mockCat.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+}
+
+func TestRestoreCategory_Perform_ParentGone(t *testing.T) {
+	parentID := uuid.Must(uuid.NewV4())
+	cat := category.Category{ID: uuid.Must(uuid.NewV4()), ParentCategoryID: &parentID}
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, cat.ID).Return(nil, sql.ErrNoRows)
+	mockCat.EXPECT().GetByID(mock.Anything, parentID).Return(nil, sql.ErrNoRows)
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+
+	_, err := (&RestoreCategory{Category: cat}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrParentCategoryNotFound)
+}
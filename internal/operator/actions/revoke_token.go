@@ -0,0 +1,35 @@
+package actions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+var ErrTokenNotFound = errors.New("token not found")
+
+// RevokeToken deletes one of UserID's tokens.
+type RevokeToken struct {
+	UserID  uuid.UUID
+	TokenID uuid.UUID
+
+	IAction
+}
+
+func (r *RevokeToken) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	deleted, err := writer.User.DeleteToken(ctx, r.UserID, r.TokenID)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, ErrTokenNotFound
+	}
+	return nil, nil
+}
+
+// RequiredPermission is PermissionNone: tokens belong to users, not ledgers.
+func (r *RevokeToken) RequiredPermission(context.Context) Permission {
+	return PermissionNone
+}
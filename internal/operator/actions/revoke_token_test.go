@@ -0,0 +1,31 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+)
+
+func TestRevokeToken_Perform(t *testing.T) {
+	userID := uuid.Must(uuid.NewV4())
+	tokenID := uuid.Must(uuid.NewV4())
+	missingID := uuid.Must(uuid.NewV4())
+	mockUser := &storage.MockIUserWriter{}
+	mockUser.EXPECT().DeleteToken(mock.Anything, userID, tokenID).Return(true, nil)
+	mockUser.EXPECT().DeleteToken(mock.Anything, userID, missingID).Return(false, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.User = mockUser
+
+	_, err := (&RevokeToken{UserID: userID, TokenID: tokenID}).Perform(context.Background(), wt)
+	require.NoError(t, err)
+
+	_, err = (&RevokeToken{UserID: userID, TokenID: missingID}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrTokenNotFound)
+}
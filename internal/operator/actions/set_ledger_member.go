@@ -0,0 +1,73 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+	"github.com/gofrs/uuid/v5"
+)
+
+var ErrLastOwner = errors.New("ledger must keep at least one owner")
+
+// SetLedgerMember adds UserID to the ledger with Role, or changes their role
+// if they are already a member. Only owners may do this, and the last owner
+// can't be demoted. It acts on the request's ledger, whose role the operator
+// checks.
+type SetLedgerMember struct {
+	UserID uuid.UUID
+	Role   ledger.Role
+
+	IAction
+}
+
+// Perform returns the resulting *ledger.Membership.
+func (s *SetLedgerMember) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.Role != ledger.RoleOwner {
+		if err := keepAnOwner(ctx, writer, ledgerID, s.UserID); err != nil {
+			return nil, err
+		}
+	}
+	return writer.Ledger.SetMember(ctx, ledgerID, s.UserID, s.Role)
+}
+
+// RequiredPermission is PermissionOwn: only owners manage members.
+func (s *SetLedgerMember) RequiredPermission(context.Context) Permission {
+	return PermissionOwn
+}
+
+// IsolationLevel is serializable so two owners can't demote each other at
+// once and leave the ledger with none.
+func (s *SetLedgerMember) IsolationLevel() sql.IsolationLevel {
+	return sql.LevelSerializable
+}
+
+// keepAnOwner returns ErrLastOwner when userID is the ledger's only owner,
+// so they can't lose that role.
+func keepAnOwner(ctx context.Context, writer *storage.Writer, ledgerID uuid.UUID, userID uuid.UUID) error {
+	membership, err := writer.Ledger.GetMembership(ctx, ledgerID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if membership.Role != ledger.RoleOwner {
+		return nil
+	}
+	owners, err := writer.Ledger.CountOwners(ctx, ledgerID)
+	if err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return ErrLastOwner
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+)
+
+var (
+	testLedgerID = uuid.Must(uuid.FromString("550e8400-e29b-41d4-a716-446655440090"))
+	testOwnerID  = uuid.Must(uuid.FromString("550e8400-e29b-41d4-a716-446655440091"))
+	testMemberID = uuid.Must(uuid.FromString("550e8400-e29b-41d4-a716-446655440092"))
+)
+
+// ledgerContext is a request for testLedgerID, the ledger membership
+// actions act on.
+func ledgerContext() context.Context {
+	return requestctx.WithLedgerID(context.Background(), testLedgerID)
+}
+
+func membership(userID uuid.UUID, role ledger.Role) *ledger.Membership {
+	return &ledger.Membership{UserID: userID, Role: role}
+}
+
+func TestSetLedgerMember_Perform_AddsMember(t *testing.T) {
+	mockLedger := &storage.MockILedgerWriter{}
+	mockLedger.EXPECT().GetMembership(mock.Anything, testLedgerID, testMemberID).Return(nil, sql.ErrNoRows)
+	mockLedger.EXPECT().SetMember(mock.Anything, testLedgerID, testMemberID, ledger.RoleEditor).Return(membership(testMemberID, ledger.RoleEditor), nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Ledger = mockLedger
+	action := &SetLedgerMember{UserID: testMemberID, Role: ledger.RoleEditor}
+
+	result, err := action.Perform(ledgerContext(), wt)
+	require.NoError(t, err)
+	assert.Equal(t, membership(testMemberID, ledger.RoleEditor), result)
+	assert.Equal(t, sql.LevelSerializable, IsolationLevel(action))
+}
+
+func TestSetLedgerMember_Perform_LastOwnerDemotion(t *testing.T) {
+	mockLedger := &storage.MockILedgerWriter{}
+	mockLedger.EXPECT().GetMembership(mock.Anything, testLedgerID, testOwnerID).Return(membership(testOwnerID, ledger.RoleOwner), nil)
+	mockLedger.EXPECT().CountOwners(mock.Anything, testLedgerID).Return(1, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Ledger = mockLedger
+
+	_, err := (&SetLedgerMember{UserID: testOwnerID, Role: ledger.RoleEditor}).Perform(ledgerContext(), wt)
+	assert.ErrorIs(t, err, ErrLastOwner)
+	mockLedger.AssertNotCalled(t, "SetMember", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetLedgerMember_Perform_DemotesOneOfSeveralOwners(t *testing.T) {
+	mockLedger := &storage.MockILedgerWriter{}
+	mockLedger.EXPECT().GetMembership(mock.Anything, testLedgerID, testMemberID).Return(membership(testMemberID, ledger.RoleOwner), nil)
+	mockLedger.EXPECT().CountOwners(mock.Anything, testLedgerID).Return(2, nil)
+	mockLedger.EXPECT().SetMember(mock.Anything, testLedgerID, testMemberID, ledger.RoleViewer).Return(membership(testMemberID, ledger.RoleViewer), nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Ledger = mockLedger
+
+	_, err := (&SetLedgerMember{UserID: testMemberID, Role: ledger.RoleViewer}).Perform(ledgerContext(), wt)
+	require.NoError(t, err)
+	mockLedger.AssertExpectations(t)
+}
+
+func TestSetLedgerMember_Perform_NoLedger(t *testing.T) {
+	mockLedger := &storage.MockILedgerWriter{}
+	wt := storage.NewWriterForTest()
+	wt.Ledger = mockLedger
+
+	_, err := (&SetLedgerMember{UserID: testMemberID, Role: ledger.RoleOwner}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, requestctx.ErrNoLedger)
+	mockLedger.AssertNotCalled(t, "SetMember", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,132 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/audit"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+// WebhookSnapshot is a webhook as recorded in the audit log, without its
+// secret.
+type WebhookSnapshot struct {
+	ID         string    `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"eventTypes"`
+	IsDisabled bool      `json:"isDisabled"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func NewWebhookSnapshot(w *webhook.Webhook) WebhookSnapshot {
+	return WebhookSnapshot{
+		ID:         w.ID.String(),
+		URL:        w.URL,
+		EventTypes: w.EventTypes,
+		IsDisabled: w.IsDisabled,
+		CreatedAt:  w.CreatedAt,
+	}
+}
+
+// rowsUnchanged reports whether every row record's action changed is still
+// as that action left it: equal to the change's After, or still gone when
+// After is nil. A row changed more than once is compared with its last
+// change.
+func rowsUnchanged(ctx context.Context, writer *storage.Writer, record *audit.Record) (bool, error) {
+	type rowKey struct {
+		entity audit.Entity
+		id     uuid.UUID
+	}
+	last := map[rowKey]audit.Change{}
+	var order []rowKey
+	for _, change := range record.Changes {
+		key := rowKey{change.Entity, change.EntityID}
+		if _, ok := last[key]; !ok {
+			order = append(order, key)
+		}
+		last[key] = change
+	}
+
+	for _, key := range order {
+		current, err := currentRow(ctx, writer, key.entity, key.id)
+		if err != nil {
+			return false, err
+		}
+		after := last[key].After
+		if current == nil || after == nil {
+			if current != nil || after != nil {
+				return false, nil
+			}
+			continue
+		}
+		same, err := sameJSON(current, after)
+		if err != nil || !same {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// currentRow returns the row as the audit log records it, or nil when it
+// doesn't exist.
+func currentRow(ctx context.Context, writer *storage.Writer, entity audit.Entity, id uuid.UUID) (json.RawMessage, error) {
+	var row any
+	switch entity {
+	case audit.EntityAccount:
+		a, err := writer.Account.FindByIDForUpdate(ctx, id)
+		if err != nil {
+			return nilIfMissing(err)
+		}
+		row = events.NewAccount(a)
+	case audit.EntityTransaction:
+		t, err := writer.Transaction.FindByID(ctx, id)
+		if err != nil {
+			return nilIfMissing(err)
+		}
+		row = events.NewTransaction(t)
+	case audit.EntityCategory:
+		c, err := writer.Category.GetByID(ctx, id)
+		if err != nil {
+			return nilIfMissing(err)
+		}
+		row = events.NewCategory(c)
+	case audit.EntityWebhook:
+		w, err := writer.Webhook.GetByID(ctx, id)
+		if err != nil {
+			return nilIfMissing(err)
+		}
+		row = NewWebhookSnapshot(w)
+	default:
+		return nil, fmt.Errorf("undo can't check %s rows", entity)
+	}
+	return json.Marshal(row)
+}
+
+func nilIfMissing(err error) (json.RawMessage, error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return nil, err
+}
+
+// sameJSON reports whether a and b hold the same JSON value. Recorded
+// changes come back from jsonb, which doesn't keep key order or spacing.
+func sameJSON(a json.RawMessage, b json.RawMessage) (bool, error) {
+	var av, bv any
+	if err := json.Unmarshal(a, &av); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(b, &bv); err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(av, bv), nil
+}
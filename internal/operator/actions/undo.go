@@ -0,0 +1,89 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+var (
+	ErrAuditRecordNotFound = errors.New("audit record not found")
+	ErrUndoConflict        = errors.New("a later action changed the same rows")
+)
+
+// UndoResult is the result of Undo.
+type UndoResult struct {
+	// Action is the name of the inverse action that was performed.
+	Action string
+	Result any
+}
+
+// Undo performs the inverse stored with an audit record. It refuses when any
+// row the record's action changed is no longer as that action left it, since
+// the inverse was computed from those rows. Comparing the rows themselves
+// catches every later write, however its transaction was ordered. Undo is
+// itself audited with an inverse, so undoing an Undo redoes the action.
+type Undo struct {
+	RecordID uuid.UUID
+
+	// inverse is the action Perform ran.
+	inverse IAction
+
+	IAction
+}
+
+// Perform returns an *UndoResult.
+func (u *Undo) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	u.inverse = nil
+	record, err := writer.Audit.GetByID(ctx, u.RecordID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAuditRecordNotFound
+		}
+		return nil, err
+	}
+	if record.Inverse == nil {
+		return nil, ErrNotInvertible
+	}
+
+	unchanged, err := rowsUnchanged(ctx, writer, record)
+	if err != nil {
+		return nil, err
+	}
+	if !unchanged {
+		return nil, ErrUndoConflict
+	}
+
+	inverse, err := DecodeAction(record.Inverse)
+	if err != nil {
+		return nil, err
+	}
+	result, err := inverse.Perform(ctx, writer)
+	if err != nil {
+		return nil, err
+	}
+	u.inverse = inverse
+	return &UndoResult{Action: Name(inverse), Result: result}, nil
+}
+
+func (u *Undo) RequiredPermission(context.Context) Permission {
+	return PermissionEdit
+}
+
+// IsolationLevel is serializable so no action can change the record's rows
+// between the conflict check and the inverse.
+func (u *Undo) IsolationLevel() sql.IsolationLevel {
+	return sql.LevelSerializable
+}
+
+// Inverse reverses the inverse, redoing the original action.
+func (u *Undo) Inverse(result any) (IAction, error) {
+	undone, ok := result.(*UndoResult)
+	if !ok || u.inverse == nil {
+		return nil, ErrNotInvertible
+	}
+	return Inverse(u.inverse, undone.Result)
+}
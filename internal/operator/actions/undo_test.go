@@ -0,0 +1,191 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/account"
+	"github.com/carson-networks/budget-server/internal/storage/audit"
+	"github.com/carson-networks/budget-server/internal/storage/category"
+	"github.com/carson-networks/budget-server/internal/storage/transaction"
+)
+
+func TestUndo_Perform_RunsInverse(t *testing.T) {
+	categoryID := uuid.Must(uuid.NewV4())
+	inverse, err := EncodeAction(&DeleteCategory{ID: categoryID})
+	require.NoError(t, err)
+	record := &audit.Record{ID: uuid.Must(uuid.NewV4()), Inverse: inverse}
+
+	mockAudit := &storage.MockIAuditWriter{}
+	mockAudit.EXPECT().GetByID(mock.Anything, record.ID).Return(record, nil)
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, categoryID).Return(&category.Category{ID: categoryID, Name: "Food"}, nil)
+	mockCat.EXPECT().HasChildren(mock.Anything, categoryID).Return(false, nil)
+	mockCat.EXPECT().Delete(mock.Anything, categoryID).Return(nil)
+	mockTxn := &storage.MockITransactionWriter{}
+	mockTxn.EXPECT().ExistsForCategory(mock.Anything, categoryID).Return(false, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Audit = mockAudit
+	wt.Category = mockCat
+	wt.Transaction = mockTxn
+	mockOutbox := expectEvents(wt, events.CategoryDeleted)
+	action := &Undo{RecordID: record.ID}
+
+	result, err := action.Perform(context.Background(), wt)
+	require.NoError(t, err)
+	assert.Equal(t, &UndoResult{Action: "DeleteCategory"}, result)
+	mockAudit.AssertExpectations(t)
+	mockCat.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+
+	redo, err := Inverse(action, result)
+	require.NoError(t, err)
+	assert.Equal(t, &RestoreCategory{Category: category.Category{ID: categoryID, Name: "Food"}}, redo)
+}
+
+func TestUndo_Perform_NotFound(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+
+	mockAudit := &storage.MockIAuditWriter{}
+	mockAudit.EXPECT().GetByID(mock.Anything, id).Return(nil, sql.ErrNoRows)
+
+	wt := storage.NewWriterForTest()
+	wt.Audit = mockAudit
+
+	_, err := (&Undo{RecordID: id}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrAuditRecordNotFound)
+}
+
+func TestUndo_Perform_NotInvertible(t *testing.T) {
+	record := &audit.Record{ID: uuid.Must(uuid.NewV4())}
+
+	mockAudit := &storage.MockIAuditWriter{}
+	mockAudit.EXPECT().GetByID(mock.Anything, record.ID).Return(record, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Audit = mockAudit
+
+	_, err := (&Undo{RecordID: record.ID}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrNotInvertible)
+}
+
+func TestUndo_Perform_LaterChangeConflicts(t *testing.T) {
+	categoryID := uuid.Must(uuid.NewV4())
+	inverse, err := EncodeAction(&DeleteCategory{ID: categoryID})
+	require.NoError(t, err)
+	record := &audit.Record{
+		ID:      uuid.Must(uuid.NewV4()),
+		Inverse: inverse,
+		Changes: []audit.Change{{
+			Entity:   audit.EntityCategory,
+			EntityID: categoryID,
+			After:    snapshotJSON(t, events.NewCategory(&category.Category{ID: categoryID, Name: "Food"})),
+		}},
+	}
+
+	mockAudit := &storage.MockIAuditWriter{}
+	mockAudit.EXPECT().GetByID(mock.Anything, record.ID).Return(record, nil)
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, categoryID).Return(&category.Category{ID: categoryID, Name: "Groceries"}, nil)
+
+	wt := storage.NewWriterForTest()
+	wt.Audit = mockAudit
+	wt.Category = mockCat
+
+	_, err = (&Undo{RecordID: record.ID}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrUndoConflict)
+	mockCat.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestUndo_Perform_DeletedRowConflicts(t *testing.T) {
+	categoryID := uuid.Must(uuid.NewV4())
+	inverse, err := EncodeAction(&DeleteCategory{ID: categoryID})
+	require.NoError(t, err)
+	record := &audit.Record{
+		ID:      uuid.Must(uuid.NewV4()),
+		Inverse: inverse,
+		Changes: []audit.Change{{
+			Entity:   audit.EntityCategory,
+			EntityID: categoryID,
+			After:    snapshotJSON(t, events.NewCategory(&category.Category{ID: categoryID, Name: "Food"})),
+		}},
+	}
+
+	mockAudit := &storage.MockIAuditWriter{}
+	mockAudit.EXPECT().GetByID(mock.Anything, record.ID).Return(record, nil)
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, categoryID).Return(nil, sql.ErrNoRows)
+
+	wt := storage.NewWriterForTest()
+	wt.Audit = mockAudit
+	wt.Category = mockCat
+
+	_, err = (&Undo{RecordID: record.ID}).Perform(context.Background(), wt)
+	assert.ErrorIs(t, err, ErrUndoConflict)
+}
+
+func TestRowsUnchanged(t *testing.T) {
+	accountID := uuid.Must(uuid.NewV4())
+	transactionID := uuid.Must(uuid.NewV4())
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	balance := func(b int64) *account.Account {
+		return &account.Account{ID: accountID, Balance: decimal.NewFromInt(b), CreatedAt: at}
+	}
+	// The record's action changed the account twice; the row must match the
+	// last change. jsonb doesn't keep key order, so reorder the keys.
+	var reordered map[string]any
+	require.NoError(t, json.Unmarshal(snapshotJSON(t, events.NewAccount(balance(30))), &reordered))
+	record := &audit.Record{Changes: []audit.Change{
+		{Entity: audit.EntityAccount, EntityID: accountID, After: snapshotJSON(t, events.NewAccount(balance(20)))},
+		{Entity: audit.EntityAccount, EntityID: accountID, After: snapshotJSON(t, reordered)},
+		{Entity: audit.EntityTransaction, EntityID: transactionID},
+	}}
+
+	tests := map[string]struct {
+		account     *account.Account
+		transaction *transaction.Transaction
+		want        bool
+	}{
+		"unchanged":           {account: balance(30), want: true},
+		"balance changed":     {account: balance(35), want: false},
+		"deleted row is back": {account: balance(30), transaction: &transaction.Transaction{ID: transactionID}, want: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockAccount := &storage.MockIAccountWriter{}
+			mockAccount.EXPECT().FindByIDForUpdate(mock.Anything, accountID).Return(tc.account, nil)
+			mockTxn := &storage.MockITransactionWriter{}
+			if tc.transaction != nil {
+				mockTxn.EXPECT().FindByID(mock.Anything, transactionID).Return(tc.transaction, nil)
+			} else {
+				mockTxn.EXPECT().FindByID(mock.Anything, transactionID).Return(nil, sql.ErrNoRows)
+			}
+			wt := storage.NewWriterForTest()
+			wt.Account = mockAccount
+			wt.Transaction = mockTxn
+
+			unchanged, err := rowsUnchanged(context.Background(), wt, record)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, unchanged)
+		})
+	}
+}
+
+func snapshotJSON(t *testing.T, row any) json.RawMessage {
+	t.Helper()
+	data, err := json.Marshal(row)
+	require.NoError(t, err)
+	return data
+}
@@ -5,9 +5,11 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/carson-networks/budget-server/internal/events"
 	"github.com/carson-networks/budget-server/internal/storage"
 	"github.com/carson-networks/budget-server/internal/storage/category"
 	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
 )
 
 var (
@@ -15,44 +17,100 @@ var (
 	ErrSpecifiedCategoryParentIsNotParent = errors.New("specified category parent is not a parent category")
 )
 
+// UpdateCategory changes the set fields of a category. ClearMonthlyBudget
+// removes the budget and takes precedence over MonthlyBudget.
 type UpdateCategory struct {
-	ID               uuid.UUID
-	Name             *string
-	ParentCategoryID *uuid.UUID
-	IsDisabled       *bool
+	ID                 uuid.UUID
+	Name               *string
+	ParentCategoryID   *uuid.UUID
+	IsDisabled         *bool
+	MonthlyBudget      *decimal.Decimal
+	ClearMonthlyBudget bool
+
+	// previous is the category as it was before Perform updated it.
+	previous *category.Category
 
 	IAction
 }
 
-func (u *UpdateCategory) Perform(ctx context.Context, writer *storage.Writer) error {
+// Perform returns the updated *category.Category.
+func (u *UpdateCategory) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	u.previous = nil
+	if u.MonthlyBudget != nil && u.MonthlyBudget.IsNegative() {
+		return nil, ErrNegativeMonthlyBudget
+	}
 	existing, err := writer.Category.GetByID(ctx, u.ID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return ErrCategoryNotFound
+			return nil, ErrCategoryNotFound
 		}
-		return err
+		return nil, err
 	}
 	if existing == nil {
-		return ErrCategoryNotFound
+		return nil, ErrCategoryNotFound
 	}
 
 	if u.ParentCategoryID != nil {
 		parent, err := writer.Category.GetByID(ctx, *u.ParentCategoryID)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
-				return ErrParentCategoryNotFound
+				return nil, ErrParentCategoryNotFound
 			}
-			return err
+			return nil, err
 		}
 		if !parent.IsParent {
-			return ErrSpecifiedCategoryParentIsNotParent
+			return nil, ErrSpecifiedCategoryParentIsNotParent
 		}
 	}
 
 	update := &category.CategoryUpdate{
-		Name:             u.Name,
-		ParentCategoryID: u.ParentCategoryID,
-		IsDisabled:       u.IsDisabled,
+		Name:               u.Name,
+		ParentCategoryID:   u.ParentCategoryID,
+		IsDisabled:         u.IsDisabled,
+		MonthlyBudget:      u.MonthlyBudget,
+		ClearMonthlyBudget: u.ClearMonthlyBudget,
+	}
+	if err := writer.Category.Update(ctx, u.ID, update); err != nil {
+		return nil, err
+	}
+	updated, err := writer.Category.GetByID(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := recordEvent(ctx, writer, events.CategoryUpdated, updated.ID, events.NewCategory(updated)); err != nil {
+		return nil, err
+	}
+	u.previous = existing
+	return updated, nil
+}
+
+func (u *UpdateCategory) RequiredPermission(context.Context) Permission {
+	return PermissionEdit
+}
+
+// Inverse sets the fields the update changed back to their previous values.
+// A category moved out of the root can't be moved back, since an update
+// can't clear the parent.
+func (u *UpdateCategory) Inverse(any) (IAction, error) {
+	if u.previous == nil {
+		return nil, ErrNotInvertible
+	}
+	inverse := &UpdateCategory{ID: u.ID}
+	if u.Name != nil {
+		inverse.Name = &u.previous.Name
+	}
+	if u.ParentCategoryID != nil {
+		if u.previous.ParentCategoryID == nil {
+			return nil, ErrNotInvertible
+		}
+		inverse.ParentCategoryID = u.previous.ParentCategoryID
+	}
+	if u.IsDisabled != nil {
+		inverse.IsDisabled = &u.previous.IsDisabled
+	}
+	if u.MonthlyBudget != nil || u.ClearMonthlyBudget {
+		inverse.MonthlyBudget = u.previous.MonthlyBudget
+		inverse.ClearMonthlyBudget = u.previous.MonthlyBudget == nil
 	}
-	return writer.Category.Update(ctx, u.ID, update)
+	return inverse, nil
 }
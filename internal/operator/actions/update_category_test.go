@@ -7,10 +7,12 @@ import (
 	"testing"
 
 	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/carson-networks/budget-server/internal/events"
 	"github.com/carson-networks/budget-server/internal/storage"
 	"github.com/carson-networks/budget-server/internal/storage/category"
 )
@@ -23,26 +25,37 @@ func TestUpdateCategory_Perform_Success(t *testing.T) {
 		IsDisabled: false, CategoryType: category.CatergoryType_Expense,
 	}
 
+	updated := &category.Category{
+		ID: catID, Name: newName, IsParent: false, ParentCategoryID: nil,
+		IsDisabled: false, CategoryType: category.CatergoryType_Expense,
+	}
+
 	mockCat := &storage.MockICategoryWriter{}
 	mockCat.EXPECT().
 		GetByID(mock.Anything, catID).
-		Return(existing, nil)
+		Return(existing, nil).Once()
 	mockCat.EXPECT().
 		Update(mock.Anything, catID, mock.MatchedBy(func(u *category.CategoryUpdate) bool {
 			return u != nil && u.Name != nil && *u.Name == newName
 		})).
 		Return(nil)
+	mockCat.EXPECT().
+		GetByID(mock.Anything, catID).
+		Return(updated, nil).Once()
 
 	wt := storage.NewWriterForTest()
 	wt.Category = mockCat
+	mockOutbox := expectEvents(wt, events.CategoryUpdated)
 	action := &UpdateCategory{
 		ID:   catID,
 		Name: &newName,
 	}
 
-	err := action.Perform(context.Background(), wt)
+	result, err := action.Perform(context.Background(), wt)
 	require.NoError(t, err)
+	assert.Same(t, updated, result)
 	mockCat.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
 }
 
 func TestUpdateCategory_Perform_Success_WithNewParent(t *testing.T) {
@@ -72,14 +85,50 @@ func TestUpdateCategory_Perform_Success_WithNewParent(t *testing.T) {
 
 	wt := storage.NewWriterForTest()
 	wt.Category = mockCat
+	mockOutbox := expectEvents(wt, events.CategoryUpdated)
 	action := &UpdateCategory{
 		ID:               catID,
 		ParentCategoryID: &parentID,
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
+	require.NoError(t, err)
+	mockCat.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+}
+
+func TestUpdateCategory_Perform_ClearMonthlyBudget(t *testing.T) {
+	catID := uuid.Must(uuid.NewV4())
+	budget := decimal.RequireFromString("120")
+	existing := &category.Category{ID: catID, Name: "Food", MonthlyBudget: &budget}
+	updated := &category.Category{ID: catID, Name: "Food"}
+
+	mockCat := &storage.MockICategoryWriter{}
+	mockCat.EXPECT().GetByID(mock.Anything, catID).Return(existing, nil).Once()
+	mockCat.EXPECT().
+		Update(mock.Anything, catID, mock.MatchedBy(func(u *category.CategoryUpdate) bool {
+			return u.ClearMonthlyBudget && u.MonthlyBudget == nil
+		})).
+		Return(nil)
+	mockCat.EXPECT().GetByID(mock.Anything, catID).Return(updated, nil).Once()
+
+	wt := storage.NewWriterForTest()
+	wt.Category = mockCat
+	mockOutbox := expectEvents(wt, events.CategoryUpdated)
+	action := &UpdateCategory{ID: catID, ClearMonthlyBudget: true}
+
+	_, err := action.Perform(context.Background(), wt)
 	require.NoError(t, err)
 	mockCat.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+}
+
+func TestUpdateCategory_Perform_NegativeMonthlyBudget(t *testing.T) {
+	budget := decimal.RequireFromString("-1")
+	action := &UpdateCategory{ID: uuid.Must(uuid.NewV4()), MonthlyBudget: &budget}
+
+	_, err := action.Perform(context.Background(), storage.NewWriterForTest())
+	assert.ErrorIs(t, err, ErrNegativeMonthlyBudget)
 }
 
 func TestUpdateCategory_Perform_CategoryNotFound(t *testing.T) {
@@ -97,7 +146,7 @@ func TestUpdateCategory_Perform_CategoryNotFound(t *testing.T) {
 		Name: &newName,
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrCategoryNotFound)
 	mockCat.AssertExpectations(t)
@@ -126,7 +175,7 @@ func TestUpdateCategory_Perform_ParentNotFound(t *testing.T) {
 		ParentCategoryID: &parentID,
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrParentCategoryNotFound)
 	mockCat.AssertExpectations(t)
@@ -159,7 +208,7 @@ func TestUpdateCategory_Perform_ParentNotAGroup(t *testing.T) {
 		ParentCategoryID: &parentID,
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrSpecifiedCategoryParentIsNotParent)
 	mockCat.AssertExpectations(t)
@@ -189,7 +238,7 @@ func TestUpdateCategory_Perform_UpdateError(t *testing.T) {
 		Name: &newName,
 	}
 
-	err := action.Perform(context.Background(), wt)
+	_, err := action.Perform(context.Background(), wt)
 	assert.ErrorIs(t, err, updateErr)
 	mockCat.AssertExpectations(t)
 }
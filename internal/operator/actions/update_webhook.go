@@ -0,0 +1,76 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+	"github.com/gofrs/uuid/v5"
+)
+
+var ErrWebhookNotFound = errors.New("webhook not found")
+
+type UpdateWebhook struct {
+	ID         uuid.UUID
+	URL        *string
+	EventTypes *[]string
+	IsDisabled *bool
+
+	// previous is the webhook as it was before Perform updated it.
+	previous *webhook.Webhook
+
+	IAction
+}
+
+// Perform returns the updated *webhook.Webhook.
+func (u *UpdateWebhook) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	u.previous = nil
+	existing, err := getWebhook(ctx, writer, u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	update := &webhook.WebhookUpdate{
+		URL:        u.URL,
+		EventTypes: u.EventTypes,
+		IsDisabled: u.IsDisabled,
+	}
+	if err := writer.Webhook.Update(ctx, u.ID, update); err != nil {
+		return nil, err
+	}
+	u.previous = existing
+	return writer.Webhook.GetByID(ctx, u.ID)
+}
+
+func (u *UpdateWebhook) RequiredPermission(context.Context) Permission {
+	return PermissionEdit
+}
+
+// Inverse sets the fields the update changed back to their previous values.
+func (u *UpdateWebhook) Inverse(any) (IAction, error) {
+	if u.previous == nil {
+		return nil, ErrNotInvertible
+	}
+	inverse := &UpdateWebhook{ID: u.ID}
+	if u.URL != nil {
+		inverse.URL = &u.previous.URL
+	}
+	if u.EventTypes != nil {
+		inverse.EventTypes = &u.previous.EventTypes
+	}
+	if u.IsDisabled != nil {
+		inverse.IsDisabled = &u.previous.IsDisabled
+	}
+	return inverse, nil
+}
+
+// getWebhook returns the webhook, or ErrWebhookNotFound.
+func getWebhook(ctx context.Context, writer *storage.Writer, id uuid.UUID) (*webhook.Webhook, error) {
+	hook, err := writer.Webhook.GetByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrWebhookNotFound
+	}
+	return hook, err
+}
@@ -0,0 +1,46 @@
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+func TestUpdateWebhook_Perform_Success(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	disabled := true
+	updated := &webhook.Webhook{ID: id, IsDisabled: true}
+	mockWebhook := &storage.MockIWebhookWriter{}
+	mockWebhook.EXPECT().GetByID(mock.Anything, id).Return(&webhook.Webhook{ID: id}, nil).Once()
+	mockWebhook.EXPECT().Update(mock.Anything, id, &webhook.WebhookUpdate{IsDisabled: &disabled}).Return(nil)
+	mockWebhook.EXPECT().GetByID(mock.Anything, id).Return(updated, nil).Once()
+	wt := storage.NewWriterForTest()
+	wt.Webhook = mockWebhook
+
+	result, err := (&UpdateWebhook{ID: id, IsDisabled: &disabled}).Perform(context.Background(), wt)
+
+	require.NoError(t, err)
+	assert.Same(t, updated, result)
+	mockWebhook.AssertExpectations(t)
+}
+
+func TestUpdateWebhook_Perform_NotFound(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	mockWebhook := &storage.MockIWebhookWriter{}
+	mockWebhook.EXPECT().GetByID(mock.Anything, id).Return(nil, sql.ErrNoRows)
+	wt := storage.NewWriterForTest()
+	wt.Webhook = mockWebhook
+
+	_, err := (&UpdateWebhook{ID: id}).Perform(context.Background(), wt)
+
+	assert.ErrorIs(t, err, ErrWebhookNotFound)
+	mockWebhook.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,436 @@
+package operator
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/account"
+	"github.com/carson-networks/budget-server/internal/storage/audit"
+	"github.com/carson-networks/budget-server/internal/storage/category"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+	"github.com/carson-networks/budget-server/internal/storage/transaction"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+// auditTracker collects the rows an action changes so the operator can
+// write one audit record per action in the action's transaction. Actions
+// don't record anything themselves: trackChanges wraps the writer's entity
+// writers so every create, update and delete is captured with the row as it
+// was before and after.
+type auditTracker struct {
+	changes []audit.Change
+	// ledgers are the ledgers membership changes acted on, which need not be
+	// the request's.
+	ledgers []uuid.UUID
+}
+
+func trackChanges(writer *storage.Writer) *auditTracker {
+	t := &auditTracker{}
+	writer.Account = &auditedAccountWriter{IAccountWriter: writer.Account, tracker: t}
+	writer.Transaction = &auditedTransactionWriter{ITransactionWriter: writer.Transaction, tracker: t}
+	writer.Category = &auditedCategoryWriter{ICategoryWriter: writer.Category, tracker: t}
+	writer.Webhook = &auditedWebhookWriter{IWebhookWriter: writer.Webhook, tracker: t}
+	writer.User = &auditedUserWriter{IUserWriter: writer.User, tracker: t}
+	writer.Ledger = &auditedLedgerWriter{ILedgerWriter: writer.Ledger, tracker: t}
+	return t
+}
+
+// record writes the audit record for action, attributed to the actor and
+// request in ctx, along with the inverse that undoes it when there is one.
+// An action that changed no rows, such as an Idempotent replaying an
+// earlier response, leaves no record.
+func (t *auditTracker) record(ctx context.Context, writer *storage.Writer, action actions.IAction, result any) error {
+	if len(t.changes) == 0 {
+		return nil
+	}
+	create := &audit.RecordCreate{
+		ActionType: actions.Name(action),
+		Actor:      requestctx.Actor(ctx),
+		RequestID:  requestctx.RequestID(ctx),
+		Changes:    t.changes,
+	}
+	if len(t.ledgers) == 1 {
+		create.LedgerID = &t.ledgers[0]
+	}
+	inverse, err := actions.Inverse(action, result)
+	switch {
+	case errors.Is(err, actions.ErrNotInvertible):
+	case err != nil:
+		return err
+	default:
+		if create.Inverse, err = actions.EncodeAction(inverse); err != nil {
+			return err
+		}
+	}
+	return writer.Audit.Insert(ctx, create)
+}
+
+// add records a change to a row. A nil before means the row was created and
+// a nil after that it was deleted.
+func (t *auditTracker) add(entity audit.Entity, id uuid.UUID, before any, after any) error {
+	change := audit.Change{Entity: entity, EntityID: id}
+	var err error
+	if before != nil {
+		if change.Before, err = json.Marshal(before); err != nil {
+			return err
+		}
+	}
+	if after != nil {
+		if change.After, err = json.Marshal(after); err != nil {
+			return err
+		}
+	}
+	t.changes = append(t.changes, change)
+	return nil
+}
+
+// actOn notes that a change acted on ledgerID. Records whose changes act on
+// exactly one ledger are tagged with it.
+func (t *auditTracker) actOn(ledgerID uuid.UUID) {
+	if !slices.Contains(t.ledgers, ledgerID) {
+		t.ledgers = append(t.ledgers, ledgerID)
+	}
+}
+
+type auditedAccountWriter struct {
+	storage.IAccountWriter
+	tracker *auditTracker
+}
+
+func (w *auditedAccountWriter) Create(ctx context.Context, name string, accountType account.AccountType, accountSubType string, startingBalance decimal.Decimal) (*account.Account, error) {
+	created, err := w.IAccountWriter.Create(ctx, name, accountType, accountSubType, startingBalance)
+	if err != nil {
+		return nil, err
+	}
+	return created, w.tracker.add(audit.EntityAccount, created.ID, nil, events.NewAccount(created))
+}
+
+func (w *auditedAccountWriter) UpdateBalance(ctx context.Context, id uuid.UUID, balance decimal.Decimal) error {
+	before, err := w.IAccountWriter.FindByIDForUpdate(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := w.IAccountWriter.UpdateBalance(ctx, id, balance); err != nil {
+		return err
+	}
+	after := *before
+	after.Balance = balance
+	return w.tracker.add(audit.EntityAccount, id, events.NewAccount(before), events.NewAccount(&after))
+}
+
+type auditedTransactionWriter struct {
+	storage.ITransactionWriter
+	tracker *auditTracker
+}
+
+func (w *auditedTransactionWriter) Insert(ctx context.Context, create *transaction.TransactionCreate) (*transaction.Transaction, error) {
+	created, err := w.ITransactionWriter.Insert(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	return created, w.tracker.add(audit.EntityTransaction, created.ID, nil, events.NewTransaction(created))
+}
+
+func (w *auditedTransactionWriter) ReassignCategory(ctx context.Context, from uuid.UUID, to uuid.UUID) (int64, error) {
+	moved, err := w.ITransactionWriter.ListByCategory(ctx, from)
+	if err != nil {
+		return 0, err
+	}
+	count, err := w.ITransactionWriter.ReassignCategory(ctx, from, to)
+	if err != nil {
+		return 0, err
+	}
+	for _, before := range moved {
+		after := *before
+		after.CategoryID = to
+		if err := w.tracker.add(audit.EntityTransaction, before.ID, events.NewTransaction(before), events.NewTransaction(&after)); err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
+
+func (w *auditedTransactionWriter) MoveToCategory(ctx context.Context, ids []uuid.UUID, from uuid.UUID, to uuid.UUID) (int64, error) {
+	inFrom, err := w.ITransactionWriter.ListByCategory(ctx, from)
+	if err != nil {
+		return 0, err
+	}
+	count, err := w.ITransactionWriter.MoveToCategory(ctx, ids, from, to)
+	if err != nil {
+		return 0, err
+	}
+	for _, before := range inFrom {
+		if !slices.Contains(ids, before.ID) {
+			continue
+		}
+		after := *before
+		after.CategoryID = to
+		if err := w.tracker.add(audit.EntityTransaction, before.ID, events.NewTransaction(before), events.NewTransaction(&after)); err != nil {
+			return 0, err
+		}
+	}
+	return count, nil
+}
+
+func (w *auditedTransactionWriter) Delete(ctx context.Context, id uuid.UUID) error {
+	before, err := w.ITransactionWriter.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := w.ITransactionWriter.Delete(ctx, id); err != nil {
+		return err
+	}
+	return w.tracker.add(audit.EntityTransaction, id, events.NewTransaction(before), nil)
+}
+
+type auditedCategoryWriter struct {
+	storage.ICategoryWriter
+	tracker *auditTracker
+}
+
+func (w *auditedCategoryWriter) Create(ctx context.Context, create *category.CategoryCreate) (*category.Category, error) {
+	created, err := w.ICategoryWriter.Create(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	return created, w.tracker.add(audit.EntityCategory, created.ID, nil, events.NewCategory(created))
+}
+
+func (w *auditedCategoryWriter) Restore(ctx context.Context, cat *category.Category) (*category.Category, error) {
+	restored, err := w.ICategoryWriter.Restore(ctx, cat)
+	if err != nil {
+		return nil, err
+	}
+	return restored, w.tracker.add(audit.EntityCategory, restored.ID, nil, events.NewCategory(restored))
+}
+
+func (w *auditedCategoryWriter) Update(ctx context.Context, id uuid.UUID, update *category.CategoryUpdate) error {
+	before, err := w.ICategoryWriter.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := w.ICategoryWriter.Update(ctx, id, update); err != nil {
+		return err
+	}
+	after, err := w.ICategoryWriter.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return w.tracker.add(audit.EntityCategory, id, events.NewCategory(before), events.NewCategory(after))
+}
+
+func (w *auditedCategoryWriter) Delete(ctx context.Context, id uuid.UUID) error {
+	before, err := w.ICategoryWriter.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := w.ICategoryWriter.Delete(ctx, id); err != nil {
+		return err
+	}
+	return w.tracker.add(audit.EntityCategory, id, events.NewCategory(before), nil)
+}
+
+type auditedWebhookWriter struct {
+	storage.IWebhookWriter
+	tracker *auditTracker
+}
+
+func (w *auditedWebhookWriter) Create(ctx context.Context, create *webhook.WebhookCreate) (*webhook.Webhook, error) {
+	created, err := w.IWebhookWriter.Create(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	return created, w.tracker.add(audit.EntityWebhook, created.ID, nil, actions.NewWebhookSnapshot(created))
+}
+
+func (w *auditedWebhookWriter) Update(ctx context.Context, id uuid.UUID, update *webhook.WebhookUpdate) error {
+	before, err := w.IWebhookWriter.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := w.IWebhookWriter.Update(ctx, id, update); err != nil {
+		return err
+	}
+	after, err := w.IWebhookWriter.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return w.tracker.add(audit.EntityWebhook, id, actions.NewWebhookSnapshot(before), actions.NewWebhookSnapshot(after))
+}
+
+func (w *auditedWebhookWriter) Delete(ctx context.Context, id uuid.UUID) error {
+	before, err := w.IWebhookWriter.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := w.IWebhookWriter.Delete(ctx, id); err != nil {
+		return err
+	}
+	return w.tracker.add(audit.EntityWebhook, id, actions.NewWebhookSnapshot(before), nil)
+}
+
+type auditedUserWriter struct {
+	storage.IUserWriter
+	tracker *auditTracker
+}
+
+// userSnapshot is a user as recorded in the audit log, without their
+// password hash.
+type userSnapshot struct {
+	ID        string    `json:"id"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// tokenSnapshot is a token as recorded in the audit log. The token's hash is
+// never loaded, so it can't end up here.
+type tokenSnapshot struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"userId"`
+	Name      string     `json:"name"`
+	Kind      string     `json:"kind"`
+	Scope     string     `json:"scope"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+func newTokenSnapshot(t *user.Token) tokenSnapshot {
+	return tokenSnapshot{
+		ID:        t.ID.String(),
+		UserID:    t.UserID.String(),
+		Name:      t.Name,
+		Kind:      t.Kind.String(),
+		Scope:     string(t.Scope),
+		ExpiresAt: t.ExpiresAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+func (w *auditedUserWriter) Create(ctx context.Context, create *user.UserCreate) (*user.User, error) {
+	created, err := w.IUserWriter.Create(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	after := userSnapshot{ID: created.ID.String(), Username: created.Username, CreatedAt: created.CreatedAt}
+	return created, w.tracker.add(audit.EntityUser, created.ID, nil, after)
+}
+
+func (w *auditedUserWriter) CreateToken(ctx context.Context, create *user.TokenCreate) (*user.Token, error) {
+	created, err := w.IUserWriter.CreateToken(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	return created, w.tracker.add(audit.EntityToken, created.ID, nil, newTokenSnapshot(created))
+}
+
+func (w *auditedUserWriter) DeleteToken(ctx context.Context, userID uuid.UUID, id uuid.UUID) (bool, error) {
+	before, err := w.IUserWriter.GetToken(ctx, userID, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return w.IUserWriter.DeleteToken(ctx, userID, id)
+	}
+	if err != nil {
+		return false, err
+	}
+	deleted, err := w.IUserWriter.DeleteToken(ctx, userID, id)
+	if err != nil || !deleted {
+		return deleted, err
+	}
+	return true, w.tracker.add(audit.EntityToken, id, newTokenSnapshot(before), nil)
+}
+
+type auditedLedgerWriter struct {
+	storage.ILedgerWriter
+	tracker *auditTracker
+}
+
+type ledgerSnapshot struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type membershipSnapshot struct {
+	LedgerID  string    `json:"ledgerId"`
+	UserID    string    `json:"userId"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func newMembershipSnapshot(m *ledger.Membership) membershipSnapshot {
+	return membershipSnapshot{
+		LedgerID:  m.LedgerID.String(),
+		UserID:    m.UserID.String(),
+		Role:      m.Role.String(),
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func (w *auditedLedgerWriter) Create(ctx context.Context, name string) (*ledger.Ledger, error) {
+	created, err := w.ILedgerWriter.Create(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	w.tracker.actOn(created.ID)
+	after := ledgerSnapshot{ID: created.ID.String(), Name: created.Name, CreatedAt: created.CreatedAt}
+	return created, w.tracker.add(audit.EntityLedger, created.ID, nil, after)
+}
+
+func (w *auditedLedgerWriter) SetMember(ctx context.Context, ledgerID uuid.UUID, userID uuid.UUID, role ledger.Role) (*ledger.Membership, error) {
+	var before any
+	existing, err := w.ILedgerWriter.GetMembership(ctx, ledgerID, userID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return nil, err
+	default:
+		before = newMembershipSnapshot(existing)
+	}
+	membership, err := w.ILedgerWriter.SetMember(ctx, ledgerID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+	w.tracker.actOn(ledgerID)
+	return membership, w.tracker.add(audit.EntityLedgerMember, userID, before, newMembershipSnapshot(membership))
+}
+
+func (w *auditedLedgerWriter) RemoveMember(ctx context.Context, ledgerID uuid.UUID, userID uuid.UUID) (bool, error) {
+	before, err := w.ILedgerWriter.GetMembership(ctx, ledgerID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return w.ILedgerWriter.RemoveMember(ctx, ledgerID, userID)
+	}
+	if err != nil {
+		return false, err
+	}
+	removed, err := w.ILedgerWriter.RemoveMember(ctx, ledgerID, userID)
+	if err != nil || !removed {
+		return removed, err
+	}
+	w.tracker.actOn(ledgerID)
+	return true, w.tracker.add(audit.EntityLedgerMember, userID, newMembershipSnapshot(before), nil)
+}
+
+func (w *auditedLedgerWriter) ClaimUnowned(ctx context.Context, userID uuid.UUID) ([]*ledger.Membership, error) {
+	claimed, err := w.ILedgerWriter.ClaimUnowned(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, membership := range claimed {
+		w.tracker.actOn(membership.LedgerID)
+		if err := w.tracker.add(audit.EntityLedgerMember, userID, nil, newMembershipSnapshot(membership)); err != nil {
+			return nil, err
+		}
+	}
+	return claimed, nil
+}
@@ -0,0 +1,360 @@
+package operator
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/account"
+	"github.com/carson-networks/budget-server/internal/storage/audit"
+	"github.com/carson-networks/budget-server/internal/storage/category"
+	"github.com/carson-networks/budget-server/internal/storage/idempotency"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+	"github.com/carson-networks/budget-server/internal/storage/transaction"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+func TestOperator_processItem_WritesAuditRecord(t *testing.T) {
+	tx := &mockTx{}
+	wt := storage.NewWriterForTestWithTx(tx)
+	created := &category.Category{ID: uuid.Must(uuid.NewV4()), Name: "Food"}
+	wt.Category.(*storage.MockICategoryWriter).EXPECT().Create(mock.Anything, mock.Anything).Return(created, nil)
+	var record *audit.RecordCreate
+	wt.Audit.(*storage.MockIAuditWriter).EXPECT().
+		Insert(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, create *audit.RecordCreate) { record = create }).
+		Return(nil)
+	mockStorage := &MockIStorage{}
+	mockStorage.EXPECT().Write(mock.Anything, mock.Anything).Return(wt, nil)
+
+	ctx := requestctx.WithActor(requestctx.WithRequestID(context.Background(), "req-1"), "sam")
+	respCh := make(chan ActionItemResponse, 1)
+	NewOperator(mockStorage, nil).processItem(ActionItem{
+		ctx:      ctx,
+		action:   &createCategoryAction{},
+		response: respCh,
+	})
+
+	require.NoError(t, (<-respCh).err)
+	assert.True(t, tx.commitCalled)
+	require.NotNil(t, record)
+	assert.Equal(t, "createCategoryAction", record.ActionType)
+	assert.Equal(t, "sam", record.Actor)
+	assert.Equal(t, "req-1", record.RequestID)
+	require.Len(t, record.Changes, 1)
+	assert.Equal(t, audit.EntityCategory, record.Changes[0].Entity)
+	assert.Equal(t, created.ID, record.Changes[0].EntityID)
+	assert.Nil(t, record.Changes[0].Before)
+	assert.JSONEq(t, `"Food"`, jsonField(t, record.Changes[0].After, "name"))
+}
+
+func TestOperator_processItem_AuditInsertFails(t *testing.T) {
+	tx := &mockTx{}
+	wt := storage.NewWriterForTestWithTx(tx)
+	auditErr := errors.New("audit insert failed")
+	wt.Category.(*storage.MockICategoryWriter).EXPECT().Create(mock.Anything, mock.Anything).Return(&category.Category{ID: uuid.Must(uuid.NewV4())}, nil)
+	wt.Audit.(*storage.MockIAuditWriter).EXPECT().Insert(mock.Anything, mock.Anything).Return(auditErr)
+	mockStorage := &MockIStorage{}
+	mockStorage.EXPECT().Write(mock.Anything, mock.Anything).Return(wt, nil)
+
+	resp := runItem(NewOperator(mockStorage, nil), &createCategoryAction{})
+
+	assert.ErrorIs(t, resp.err, auditErr)
+	assert.Nil(t, resp.result)
+	assert.True(t, tx.rollbackCalled)
+	assert.False(t, tx.commitCalled)
+}
+
+func TestOperator_processItem_RecordsInverse(t *testing.T) {
+	tx := &mockTx{}
+	wt := storage.NewWriterForTestWithTx(tx)
+	created := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), URL: "https://example.com"}
+	wt.Webhook.(*storage.MockIWebhookWriter).EXPECT().Create(mock.Anything, mock.Anything).Return(created, nil)
+	var record *audit.RecordCreate
+	wt.Audit.(*storage.MockIAuditWriter).EXPECT().
+		Insert(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, create *audit.RecordCreate) { record = create }).
+		Return(nil)
+	mockStorage := &MockIStorage{}
+	mockStorage.EXPECT().Write(mock.Anything, mock.Anything).Return(wt, nil)
+
+	resp := runItem(NewOperator(mockStorage, nil), &actions.CreateWebhook{URL: "https://example.com"})
+
+	require.NoError(t, resp.err)
+	require.NotNil(t, record)
+	inverse, err := actions.DecodeAction(record.Inverse)
+	require.NoError(t, err)
+	assert.Equal(t, &actions.DeleteWebhook{ID: created.ID}, inverse)
+}
+
+func TestOperator_processItem_NotInvertibleHasNoInverse(t *testing.T) {
+	tx := &mockTx{}
+	wt := storage.NewWriterForTestWithTx(tx)
+	wt.Category.(*storage.MockICategoryWriter).EXPECT().Create(mock.Anything, mock.Anything).Return(&category.Category{ID: uuid.Must(uuid.NewV4())}, nil)
+	var record *audit.RecordCreate
+	wt.Audit.(*storage.MockIAuditWriter).EXPECT().
+		Insert(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, create *audit.RecordCreate) { record = create }).
+		Return(nil)
+	mockStorage := &MockIStorage{}
+	mockStorage.EXPECT().Write(mock.Anything, mock.Anything).Return(wt, nil)
+
+	resp := runItem(NewOperator(mockStorage, nil), &createCategoryAction{})
+
+	require.NoError(t, resp.err)
+	require.NotNil(t, record)
+	assert.Nil(t, record.Inverse)
+}
+
+func TestOperator_processItem_NoChangesNoRecord(t *testing.T) {
+	tx := &mockTx{}
+	wt := storage.NewWriterForTestWithTx(tx)
+	wt.Idempotency.(*storage.MockIIdempotencyWriter).EXPECT().
+		Get(mock.Anything, "scope", "key-1").
+		Return(&idempotency.Record{RequestHash: []byte("hash"), Response: json.RawMessage(`{}`)}, nil)
+	mockStorage := &MockIStorage{}
+	mockStorage.EXPECT().Write(mock.Anything, mock.Anything).Return(wt, nil)
+	replay := &actions.Idempotent{Scope: "scope", Key: "key-1", RequestHash: []byte("hash"), Action: &createCategoryAction{}}
+
+	resp := runItem(NewOperator(mockStorage, nil), replay)
+
+	require.NoError(t, resp.err)
+	assert.NotNil(t, replay.Replay)
+	assert.True(t, tx.commitCalled)
+	wt.Audit.(*storage.MockIAuditWriter).AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+}
+
+func TestTrackChanges_UpdateBalance(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	id := uuid.Must(uuid.NewV4())
+	mockAccount := wt.Account.(*storage.MockIAccountWriter)
+	mockAccount.EXPECT().FindByIDForUpdate(mock.Anything, id).Return(&account.Account{ID: id, Balance: decimal.NewFromInt(10)}, nil)
+	mockAccount.EXPECT().UpdateBalance(mock.Anything, id, decimal.NewFromInt(25)).Return(nil)
+
+	tracker := trackChanges(wt)
+	require.NoError(t, wt.Account.UpdateBalance(context.Background(), id, decimal.NewFromInt(25)))
+
+	require.Len(t, tracker.changes, 1)
+	assert.JSONEq(t, `"10"`, jsonField(t, tracker.changes[0].Before, "balance"))
+	assert.JSONEq(t, `"25"`, jsonField(t, tracker.changes[0].After, "balance"))
+}
+
+func TestTrackChanges_ReassignCategory(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	from := uuid.Must(uuid.NewV4())
+	to := uuid.Must(uuid.NewV4())
+	moved := []*transaction.Transaction{
+		{ID: uuid.Must(uuid.NewV4()), CategoryID: from},
+		{ID: uuid.Must(uuid.NewV4()), CategoryID: from},
+	}
+	mockTxn := wt.Transaction.(*storage.MockITransactionWriter)
+	mockTxn.EXPECT().ListByCategory(mock.Anything, from).Return(moved, nil)
+	mockTxn.EXPECT().ReassignCategory(mock.Anything, from, to).Return(int64(2), nil)
+
+	tracker := trackChanges(wt)
+	count, err := wt.Transaction.ReassignCategory(context.Background(), from, to)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	require.Len(t, tracker.changes, 2)
+	for i, change := range tracker.changes {
+		assert.Equal(t, moved[i].ID, change.EntityID)
+		assert.JSONEq(t, `"`+from.String()+`"`, jsonField(t, change.Before, "categoryID"))
+		assert.JSONEq(t, `"`+to.String()+`"`, jsonField(t, change.After, "categoryID"))
+	}
+}
+
+func TestTrackChanges_MoveToCategory_OnlyGivenIDs(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	from := uuid.Must(uuid.NewV4())
+	to := uuid.Must(uuid.NewV4())
+	moved := &transaction.Transaction{ID: uuid.Must(uuid.NewV4()), CategoryID: from}
+	stayed := &transaction.Transaction{ID: uuid.Must(uuid.NewV4()), CategoryID: from}
+	mockTxn := wt.Transaction.(*storage.MockITransactionWriter)
+	mockTxn.EXPECT().ListByCategory(mock.Anything, from).Return([]*transaction.Transaction{moved, stayed}, nil)
+	mockTxn.EXPECT().MoveToCategory(mock.Anything, []uuid.UUID{moved.ID}, from, to).Return(int64(1), nil)
+
+	tracker := trackChanges(wt)
+	_, err := wt.Transaction.MoveToCategory(context.Background(), []uuid.UUID{moved.ID}, from, to)
+
+	require.NoError(t, err)
+	require.Len(t, tracker.changes, 1)
+	assert.Equal(t, moved.ID, tracker.changes[0].EntityID)
+	assert.JSONEq(t, `"`+to.String()+`"`, jsonField(t, tracker.changes[0].After, "categoryID"))
+}
+
+func TestTrackChanges_DeleteCategory(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	id := uuid.Must(uuid.NewV4())
+	mockCat := wt.Category.(*storage.MockICategoryWriter)
+	mockCat.EXPECT().GetByID(mock.Anything, id).Return(&category.Category{ID: id, Name: "Old"}, nil)
+	mockCat.EXPECT().Delete(mock.Anything, id).Return(nil)
+
+	tracker := trackChanges(wt)
+	require.NoError(t, wt.Category.Delete(context.Background(), id))
+
+	require.Len(t, tracker.changes, 1)
+	assert.JSONEq(t, `"Old"`, jsonField(t, tracker.changes[0].Before, "name"))
+	assert.Nil(t, tracker.changes[0].After)
+}
+
+func TestTrackChanges_FailedWriteNotRecorded(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	id := uuid.Must(uuid.NewV4())
+	mockCat := wt.Category.(*storage.MockICategoryWriter)
+	mockCat.EXPECT().GetByID(mock.Anything, id).Return(&category.Category{ID: id}, nil)
+	mockCat.EXPECT().Update(mock.Anything, id, mock.Anything).Return(errors.New("update failed"))
+
+	tracker := trackChanges(wt)
+	assert.Error(t, wt.Category.Update(context.Background(), id, &category.CategoryUpdate{}))
+
+	assert.Empty(t, tracker.changes)
+}
+
+func TestTrackChanges_WebhookOmitsSecret(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	created := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), URL: "https://example.com", Secret: "s3cret-value"}
+	wt.Webhook.(*storage.MockIWebhookWriter).EXPECT().Create(mock.Anything, mock.Anything).Return(created, nil)
+
+	tracker := trackChanges(wt)
+	_, err := wt.Webhook.Create(context.Background(), &webhook.WebhookCreate{})
+
+	require.NoError(t, err)
+	require.Len(t, tracker.changes, 1)
+	assert.NotContains(t, string(tracker.changes[0].After), "s3cret-value")
+}
+
+func TestTrackChanges_UserOmitsPasswordHash(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	created := &user.User{ID: uuid.Must(uuid.NewV4()), Username: "sam", PasswordHash: "$2a$hash-value"}
+	wt.User.(*storage.MockIUserWriter).EXPECT().Create(mock.Anything, mock.Anything).Return(created, nil)
+
+	tracker := trackChanges(wt)
+	_, err := wt.User.Create(context.Background(), &user.UserCreate{})
+
+	require.NoError(t, err)
+	require.Len(t, tracker.changes, 1)
+	assert.Equal(t, audit.EntityUser, tracker.changes[0].Entity)
+	assert.JSONEq(t, `"sam"`, jsonField(t, tracker.changes[0].After, "username"))
+	assert.NotContains(t, string(tracker.changes[0].After), "hash-value")
+}
+
+func TestTrackChanges_RevokeToken(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	userID := uuid.Must(uuid.NewV4())
+	token := &user.Token{ID: uuid.Must(uuid.NewV4()), UserID: userID, Name: "cli", Kind: user.TokenKindPersonal, Scope: user.ScopeRead}
+	mockUser := wt.User.(*storage.MockIUserWriter)
+	mockUser.EXPECT().GetToken(mock.Anything, userID, token.ID).Return(token, nil)
+	mockUser.EXPECT().DeleteToken(mock.Anything, userID, token.ID).Return(true, nil)
+
+	tracker := trackChanges(wt)
+	deleted, err := wt.User.DeleteToken(context.Background(), userID, token.ID)
+
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	require.Len(t, tracker.changes, 1)
+	assert.Equal(t, audit.EntityToken, tracker.changes[0].Entity)
+	assert.JSONEq(t, `"personal"`, jsonField(t, tracker.changes[0].Before, "kind"))
+	assert.Nil(t, tracker.changes[0].After)
+}
+
+func TestTrackChanges_RevokeMissingTokenNotRecorded(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	userID := uuid.Must(uuid.NewV4())
+	id := uuid.Must(uuid.NewV4())
+	mockUser := wt.User.(*storage.MockIUserWriter)
+	mockUser.EXPECT().GetToken(mock.Anything, userID, id).Return(nil, sql.ErrNoRows)
+	mockUser.EXPECT().DeleteToken(mock.Anything, userID, id).Return(false, nil)
+
+	tracker := trackChanges(wt)
+	deleted, err := wt.User.DeleteToken(context.Background(), userID, id)
+
+	require.NoError(t, err)
+	assert.False(t, deleted)
+	assert.Empty(t, tracker.changes)
+}
+
+func TestOperator_processItem_MembershipTaggedWithItsLedger(t *testing.T) {
+	tx := &mockTx{}
+	wt := storage.NewWriterForTestWithTx(tx)
+	ledgerID := uuid.Must(uuid.NewV4())
+	userID := uuid.Must(uuid.NewV4())
+	before := &ledger.Membership{LedgerID: ledgerID, UserID: userID, Role: ledger.RoleViewer}
+	after := &ledger.Membership{LedgerID: ledgerID, UserID: userID, Role: ledger.RoleEditor}
+	mockLedger := wt.Ledger.(*storage.MockILedgerWriter)
+	mockLedger.EXPECT().GetMembership(mock.Anything, ledgerID, userID).Return(before, nil)
+	mockLedger.EXPECT().SetMember(mock.Anything, ledgerID, userID, ledger.RoleEditor).Return(after, nil)
+	var record *audit.RecordCreate
+	wt.Audit.(*storage.MockIAuditWriter).EXPECT().
+		Insert(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, create *audit.RecordCreate) { record = create }).
+		Return(nil)
+	mockStorage := &MockIStorage{}
+	mockStorage.EXPECT().Write(mock.Anything, mock.Anything).Return(wt, nil)
+	mockAction := &actions.MockIAction{}
+	mockAction.EXPECT().Perform(mock.Anything, wt).RunAndReturn(func(ctx context.Context, writer *storage.Writer) (any, error) {
+		return writer.Ledger.SetMember(ctx, ledgerID, userID, ledger.RoleEditor)
+	})
+
+	resp := runItem(NewOperator(mockStorage, nil), mockAction)
+
+	require.NoError(t, resp.err)
+	require.NotNil(t, record)
+	require.NotNil(t, record.LedgerID)
+	assert.Equal(t, ledgerID, *record.LedgerID)
+	require.Len(t, record.Changes, 1)
+	assert.Equal(t, audit.EntityLedgerMember, record.Changes[0].Entity)
+	assert.Equal(t, userID, record.Changes[0].EntityID)
+	assert.JSONEq(t, `"viewer"`, jsonField(t, record.Changes[0].Before, "role"))
+	assert.JSONEq(t, `"editor"`, jsonField(t, record.Changes[0].After, "role"))
+}
+
+func TestTrackChanges_RemoveMember(t *testing.T) {
+	wt := storage.NewWriterForTest()
+	ledgerID := uuid.Must(uuid.NewV4())
+	userID := uuid.Must(uuid.NewV4())
+	mockLedger := wt.Ledger.(*storage.MockILedgerWriter)
+	mockLedger.EXPECT().GetMembership(mock.Anything, ledgerID, userID).
+		Return(&ledger.Membership{LedgerID: ledgerID, UserID: userID, Role: ledger.RoleEditor}, nil)
+	mockLedger.EXPECT().RemoveMember(mock.Anything, ledgerID, userID).Return(true, nil)
+
+	tracker := trackChanges(wt)
+	removed, err := wt.Ledger.RemoveMember(context.Background(), ledgerID, userID)
+
+	require.NoError(t, err)
+	assert.True(t, removed)
+	require.Len(t, tracker.changes, 1)
+	assert.JSONEq(t, `"editor"`, jsonField(t, tracker.changes[0].Before, "role"))
+	assert.Nil(t, tracker.changes[0].After)
+	assert.Equal(t, []uuid.UUID{ledgerID}, tracker.ledgers)
+}
+
+// createCategoryAction creates one category through the writer.
+type createCategoryAction struct{}
+
+func (a *createCategoryAction) Perform(ctx context.Context, writer *storage.Writer) (any, error) {
+	return writer.Category.Create(ctx, &category.CategoryCreate{Name: "Food"})
+}
+
+func (a *createCategoryAction) RequiredPermission(context.Context) actions.Permission {
+	return actions.PermissionEdit
+}
+
+func jsonField(t *testing.T, data json.RawMessage, field string) string {
+	t.Helper()
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(data, &fields))
+	return string(fields[field])
+}
@@ -0,0 +1,46 @@
+package operator
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	queueDepthDesc = prometheus.NewDesc("budget_operator_queue_depth",
+		"Actions waiting in the operator queues.", nil, nil)
+	queueCapacityDesc = prometheus.NewDesc("budget_operator_queue_capacity",
+		"Actions the operator queues can hold.", nil, nil)
+	workersRunningDesc = prometheus.NewDesc("budget_operator_workers_running",
+		"Operator workers currently running.", nil, nil)
+	rejectedDesc = prometheus.NewDesc("budget_operator_rejected_total",
+		"Actions turned away because their queue was full.", nil, nil)
+	retriedDesc = prometheus.NewDesc("budget_operator_retried_total",
+		"Transactions run again after a serialization failure or deadlock.", nil, nil)
+)
+
+// collector reports the delegator's Stats on each scrape.
+type collector struct {
+	delegator *OperatorDelegator
+}
+
+// Collector returns a Prometheus collector for the delegator's queue and
+// worker stats.
+func (d *OperatorDelegator) Collector() prometheus.Collector {
+	return collector{delegator: d}
+}
+
+func (c collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- queueDepthDesc
+	ch <- queueCapacityDesc
+	ch <- workersRunningDesc
+	ch <- rejectedDesc
+	ch <- retriedDesc
+}
+
+func (c collector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.delegator.Stats()
+	ch <- prometheus.MustNewConstMetric(queueDepthDesc, prometheus.GaugeValue, float64(stats.QueueDepth))
+	ch <- prometheus.MustNewConstMetric(queueCapacityDesc, prometheus.GaugeValue, float64(stats.QueueCapacity))
+	ch <- prometheus.MustNewConstMetric(workersRunningDesc, prometheus.GaugeValue, float64(stats.WorkersRunning))
+	ch <- prometheus.MustNewConstMetric(rejectedDesc, prometheus.CounterValue, float64(stats.Rejected))
+	ch <- prometheus.MustNewConstMetric(retriedDesc, prometheus.CounterValue, float64(stats.Retried))
+}
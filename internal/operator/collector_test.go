@@ -0,0 +1,79 @@
+package operator
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	prommetrics "github.com/carson-networks/budget-server/internal/metrics"
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage"
+)
+
+// meteredAction is an action with its own name, so its metrics are not
+// shared with other tests.
+type meteredAction struct {
+	actions.IAction
+	err error
+}
+
+func (a *meteredAction) Perform(context.Context, *storage.Writer) (any, error) {
+	return nil, a.err
+}
+
+func (a *meteredAction) ActionName() string {
+	return "MeteredAction"
+}
+
+func durationCount(t *testing.T, action string) uint64 {
+	t.Helper()
+	m := &dto.Metric{}
+	require.NoError(t, prommetrics.ActionDuration.WithLabelValues(action).(prometheus.Metric).Write(m))
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestOperator_processItem_RecordsActionMetrics(t *testing.T) {
+	mockStorage := &MockIStorage{}
+	mockStorage.EXPECT().Write(mock.Anything, mock.Anything).RunAndReturn(func(context.Context, sql.IsolationLevel) (*storage.Writer, error) {
+		return newTestWriter(&mockTx{}), nil
+	})
+	op := NewOperator(mockStorage, nil)
+	durations := durationCount(t, "MeteredAction")
+	failures := testutil.ToFloat64(prommetrics.ActionFailures.WithLabelValues("MeteredAction"))
+
+	runItem(op, &meteredAction{})
+	runItem(op, &meteredAction{err: errors.New("boom")})
+
+	assert.Equal(t, durations+2, durationCount(t, "MeteredAction"))
+	assert.Equal(t, failures+1, testutil.ToFloat64(prommetrics.ActionFailures.WithLabelValues("MeteredAction")))
+}
+
+func TestOperatorDelegator_Collector(t *testing.T) {
+	d := NewOperatorDelegator(&MockIStorage{}, 2, 10)
+	d.queues[0] <- ActionItem{}
+
+	expected := `
+# HELP budget_operator_queue_capacity Actions the operator queues can hold.
+# TYPE budget_operator_queue_capacity gauge
+budget_operator_queue_capacity 10
+# HELP budget_operator_queue_depth Actions waiting in the operator queues.
+# TYPE budget_operator_queue_depth gauge
+budget_operator_queue_depth 1
+# HELP budget_operator_workers_running Operator workers currently running.
+# TYPE budget_operator_workers_running gauge
+budget_operator_workers_running 0
+`
+	err := testutil.CollectAndCompare(d.Collector(), strings.NewReader(expected),
+		"budget_operator_queue_capacity", "budget_operator_queue_depth", "budget_operator_workers_running")
+
+	assert.NoError(t, err)
+}
@@ -24,21 +24,33 @@ func (_m *MockIProcessor) EXPECT() *MockIProcessor_Expecter {
 }
 
 // Process provides a mock function with given fields: ctx, action
-func (_m *MockIProcessor) Process(ctx context.Context, action actions.IAction) error {
+func (_m *MockIProcessor) Process(ctx context.Context, action actions.IAction) (interface{}, error) {
 	ret := _m.Called(ctx, action)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Process")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, actions.IAction) error); ok {
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, actions.IAction) (interface{}, error)); ok {
+		return rf(ctx, action)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, actions.IAction) interface{}); ok {
 		r0 = rf(ctx, action)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, actions.IAction) error); ok {
+		r1 = rf(ctx, action)
+	} else {
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
 // MockIProcessor_Process_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Process'
@@ -60,12 +72,12 @@ func (_c *MockIProcessor_Process_Call) Run(run func(ctx context.Context, action
 	return _c
 }
 
-func (_c *MockIProcessor_Process_Call) Return(_a0 error) *MockIProcessor_Process_Call {
-	_c.Call.Return(_a0)
+func (_c *MockIProcessor_Process_Call) Return(_a0 interface{}, _a1 error) *MockIProcessor_Process_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockIProcessor_Process_Call) RunAndReturn(run func(context.Context, actions.IAction) error) *MockIProcessor_Process_Call {
+func (_c *MockIProcessor_Process_Call) RunAndReturn(run func(context.Context, actions.IAction) (interface{}, error)) *MockIProcessor_Process_Call {
 	_c.Call.Return(run)
 	return _c
 }
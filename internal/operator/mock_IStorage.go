@@ -4,9 +4,11 @@ package operator
 
 import (
 	context "context"
+	sql "database/sql"
 
-	storage "github.com/carson-networks/budget-server/internal/storage"
 	mock "github.com/stretchr/testify/mock"
+
+	storage "github.com/carson-networks/budget-server/internal/storage"
 )
 
 // MockIStorage is an autogenerated mock type for the IStorage type
@@ -22,9 +24,9 @@ func (_m *MockIStorage) EXPECT() *MockIStorage_Expecter {
 	return &MockIStorage_Expecter{mock: &_m.Mock}
 }
 
-// Write provides a mock function with given fields: ctx
-func (_m *MockIStorage) Write(ctx context.Context) (*storage.Writer, error) {
-	ret := _m.Called(ctx)
+// Write provides a mock function with given fields: ctx, isolation
+func (_m *MockIStorage) Write(ctx context.Context, isolation sql.IsolationLevel) (*storage.Writer, error) {
+	ret := _m.Called(ctx, isolation)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Write")
@@ -32,19 +34,19 @@ func (_m *MockIStorage) Write(ctx context.Context) (*storage.Writer, error) {
 
 	var r0 *storage.Writer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*storage.Writer, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, sql.IsolationLevel) (*storage.Writer, error)); ok {
+		return rf(ctx, isolation)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *storage.Writer); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, sql.IsolationLevel) *storage.Writer); ok {
+		r0 = rf(ctx, isolation)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*storage.Writer)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, sql.IsolationLevel) error); ok {
+		r1 = rf(ctx, isolation)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,13 +61,14 @@ type MockIStorage_Write_Call struct {
 
 // Write is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockIStorage_Expecter) Write(ctx interface{}) *MockIStorage_Write_Call {
-	return &MockIStorage_Write_Call{Call: _e.mock.On("Write", ctx)}
+//   - isolation sql.IsolationLevel
+func (_e *MockIStorage_Expecter) Write(ctx interface{}, isolation interface{}) *MockIStorage_Write_Call {
+	return &MockIStorage_Write_Call{Call: _e.mock.On("Write", ctx, isolation)}
 }
 
-func (_c *MockIStorage_Write_Call) Run(run func(ctx context.Context)) *MockIStorage_Write_Call {
+func (_c *MockIStorage_Write_Call) Run(run func(ctx context.Context, isolation sql.IsolationLevel)) *MockIStorage_Write_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(sql.IsolationLevel))
 	})
 	return _c
 }
@@ -75,7 +78,7 @@ func (_c *MockIStorage_Write_Call) Return(_a0 *storage.Writer, _a1 error) *MockI
 	return _c
 }
 
-func (_c *MockIStorage_Write_Call) RunAndReturn(run func(context.Context) (*storage.Writer, error)) *MockIStorage_Write_Call {
+func (_c *MockIStorage_Write_Call) RunAndReturn(run func(context.Context, sql.IsolationLevel) (*storage.Writer, error)) *MockIStorage_Write_Call {
 	_c.Call.Return(run)
 	return _c
 }
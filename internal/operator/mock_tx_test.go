@@ -1,6 +1,12 @@
 package operator
 
-import "context"
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/carson-networks/budget-server/internal/storage"
+)
 
 // mockTx implements the storage.txRunner interface for testing.
 // It records whether Commit and Rollback were called and returns configurable errors.
@@ -20,3 +26,11 @@ func (m *mockTx) Rollback(ctx context.Context) error {
 	m.rollbackCalled = true
 	return m.rollbackErr
 }
+
+// newTestWriter returns a test Writer using tx whose audit log accepts any
+// record.
+func newTestWriter(tx *mockTx) *storage.Writer {
+	wt := storage.NewWriterForTestWithTx(tx)
+	wt.Audit.(*storage.MockIAuditWriter).EXPECT().Insert(mock.Anything, mock.Anything).Return(nil).Maybe()
+	return wt
+}
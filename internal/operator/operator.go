@@ -2,26 +2,38 @@ package operator
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
+	prommetrics "github.com/carson-networks/budget-server/internal/metrics"
 	"github.com/carson-networks/budget-server/internal/operator/actions"
 	"github.com/carson-networks/budget-server/internal/storage"
 )
 
 // IStorage defines the storage write operations used by the operator.
 type IStorage interface {
-	Write(ctx context.Context) (*storage.Writer, error)
+	Write(ctx context.Context, isolation sql.IsolationLevel) (*storage.Writer, error)
 }
 
 // Operator is the worker that processes items from the queue.
 type Operator struct {
 	storage IStorage
 	queue   chan ActionItem
+	metrics *metrics
+
+	// maxAttempts and backoff control how transactions that fail with a
+	// retryable error are re-run; see retry.go.
+	maxAttempts int
+	backoff     func(attempt int) time.Duration
 }
 
 func NewOperator(s IStorage, queue chan ActionItem) *Operator {
 	return &Operator{
-		storage: s,
-		queue:   queue,
+		storage:     s,
+		queue:       queue,
+		metrics:     &metrics{},
+		maxAttempts: defaultMaxAttempts,
+		backoff:     jitteredBackoff,
 	}
 }
 
@@ -32,34 +44,84 @@ func (o *Operator) Run() {
 	}
 }
 
+// processItem performs the item's action in a new transaction. Items whose
+// caller has already gone away are skipped without opening one.
 func (o *Operator) processItem(item ActionItem) {
-	writer, err := o.storage.Write(item.ctx)
-	if err != nil {
+	if err := item.ctx.Err(); err != nil {
+		o.metrics.skipped.Add(1)
 		item.response <- ActionItemResponse{err: err}
 		return
 	}
 
-	err = item.action.Perform(item.ctx, writer)
+	start := time.Now()
+	resp := o.performWithRetry(item)
+	process := time.Since(start)
+	o.metrics.observe(start.Sub(item.enqueuedAt), process, resp.err)
+
+	name := actions.Name(item.action)
+	prommetrics.ActionDuration.WithLabelValues(name).Observe(process.Seconds())
+	if resp.err != nil {
+		prommetrics.ActionFailures.WithLabelValues(name).Inc()
+	}
+	item.response <- resp
+}
+
+// performWithRetry performs the action, starting over in a new transaction
+// after a serialization failure or deadlock until maxAttempts is reached.
+func (o *Operator) performWithRetry(item ActionItem) ActionItemResponse {
+	isolation := actions.IsolationLevel(item.action)
+	for attempt := 1; ; attempt++ {
+		resp := o.perform(item, isolation)
+		if resp.err == nil || attempt >= o.maxAttempts || !isRetryable(resp.err) {
+			return resp
+		}
+
+		o.metrics.retried.Add(1)
+		timer := time.NewTimer(o.backoff(attempt))
+		select {
+		case <-timer.C:
+		case <-item.ctx.Done():
+			timer.Stop()
+			return ActionItemResponse{err: item.ctx.Err()}
+		}
+	}
+}
+
+// perform runs one attempt of the action and writes its audit record in the
+// same transaction.
+func (o *Operator) perform(item ActionItem, isolation sql.IsolationLevel) ActionItemResponse {
+	writer, err := o.storage.Write(item.ctx, isolation)
+	if err != nil {
+		return ActionItemResponse{err: err}
+	}
+
+	tracker := trackChanges(writer)
+	result, err := item.action.Perform(item.ctx, writer)
+	if err == nil {
+		err = tracker.record(item.ctx, writer, item.action, result)
+	}
 	if err != nil {
 		_ = writer.Rollback()
-		item.response <- ActionItemResponse{err: err}
-		return
+		return ActionItemResponse{err: err}
 	}
 
 	if err = writer.Commit(); err != nil {
-		item.response <- ActionItemResponse{err: err}
-		return
+		return ActionItemResponse{err: err}
 	}
 
-	item.response <- ActionItemResponse{}
+	return ActionItemResponse{result: result}
 }
 
 type ActionItem struct {
-	ctx      context.Context
-	action   actions.IAction
-	response chan ActionItemResponse
+	ctx        context.Context
+	action     actions.IAction
+	response   chan ActionItemResponse
+	enqueuedAt time.Time
 }
 
+// ActionItemResponse carries the action's result back to Process. result is
+// only set when the transaction committed.
 type ActionItemResponse struct {
-	err error
+	result any
+	err    error
 }
@@ -2,68 +2,202 @@ package operator
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/requestctx"
+)
+
+// DefaultQueueCapacity is the number of items buffered across all workers
+// when NewOperatorDelegator is given none.
+const DefaultQueueCapacity = 1000
+
+var (
+	// ErrQueueFull is returned by Process when the action's worker queue has
+	// no room; callers should retry later.
+	ErrQueueFull = errors.New("operator queue is full")
+	// ErrStopped is returned by Process once Stop has been called.
+	ErrStopped = errors.New("operator is stopped")
+	// ErrPermissionDenied is returned by Process when the caller's role in
+	// the request's ledger does not grant the action's required permission.
+	ErrPermissionDenied = errors.New("permission denied")
 )
 
 // IProcessor defines the interface for processing actions. Used by handlers to enqueue work.
 type IProcessor interface {
-	Process(ctx context.Context, action actions.IAction) error
+	// Process runs the action in its own transaction and returns the action's result.
+	Process(ctx context.Context, action actions.IAction) (any, error)
 }
 
-// OperatorDelegator manages the queue, starts/stops Operators (workers), and enqueues items.
+// OperatorDelegator manages the queues, starts/stops Operators (workers), and enqueues items.
+// Each worker has its own queue. Actions with an ordering key (see
+// actions.Ordered) are always sent to the same worker, so they are performed
+// one at a time in the order they were enqueued; actions without a key are
+// spread across workers round-robin.
 type OperatorDelegator struct {
-	storage    IStorage
-	queue      chan ActionItem
-	numWorkers int
-	wg         sync.WaitGroup
-	stopOnce   sync.Once
+	storage  IStorage
+	queues   []chan ActionItem
+	next     atomic.Uint64
+	running  atomic.Int32
+	metrics  metrics
+	wg       sync.WaitGroup
+	stopOnce sync.Once
+
+	// mu guards stopped and the queues being closed: Process holds it for
+	// reading while it enqueues so Stop cannot close a queue mid-send.
+	mu      sync.RWMutex
+	stopped bool
 }
 
-func NewOperatorDelegator(s IStorage, numWorkers int) *OperatorDelegator {
+// NewOperatorDelegator creates numWorkers workers sharing queueCapacity
+// buffered items between them.
+func NewOperatorDelegator(s IStorage, numWorkers int, queueCapacity int) *OperatorDelegator {
 	if numWorkers < 1 {
 		numWorkers = 1
 	}
+	if queueCapacity < 1 {
+		queueCapacity = DefaultQueueCapacity
+	}
+	perWorker := max(queueCapacity/numWorkers, 1)
+	queues := make([]chan ActionItem, numWorkers)
+	for i := range queues {
+		queues[i] = make(chan ActionItem, perWorker)
+	}
 	return &OperatorDelegator{
-		storage:    s,
-		queue:      make(chan ActionItem, 1000),
-		numWorkers: numWorkers,
+		storage: s,
+		queues:  queues,
 	}
 }
 
 func (d *OperatorDelegator) Start() {
-	for i := 0; i < d.numWorkers; i++ {
+	for _, queue := range d.queues {
 		d.wg.Add(1)
-		op := NewOperator(d.storage, d.queue)
+		op := NewOperator(d.storage, queue)
+		op.metrics = &d.metrics
+		d.running.Add(1)
 		go func() {
 			defer d.wg.Done()
+			defer d.running.Add(-1)
 			op.Run()
 		}()
 	}
 }
 
-func (d *OperatorDelegator) Stop() {
+// Stop rejects new work and waits for queued items to drain. It returns
+// ctx.Err() if ctx ends first; the workers then finish draining in the
+// background.
+func (d *OperatorDelegator) Stop(ctx context.Context) error {
 	d.stopOnce.Do(func() {
-		close(d.queue)
-		d.wg.Wait()
+		d.mu.Lock()
+		d.stopped = true
+		for _, queue := range d.queues {
+			close(queue)
+		}
+		d.mu.Unlock()
 	})
+
+	drained := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
-func (d *OperatorDelegator) Process(ctx context.Context, action actions.IAction) error {
+// Process enqueues the action and waits for its result. It never blocks on
+// a full queue: it returns ErrQueueFull instead, or ErrStopped after Stop.
+// Actions the caller may not submit are refused with ErrPermissionDenied
+// before they are queued.
+func (d *OperatorDelegator) Process(ctx context.Context, action actions.IAction) (any, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := authorize(ctx, action); err != nil {
+		return nil, err
+	}
+
 	respCh := make(chan ActionItemResponse, 1)
 	item := ActionItem{
-		ctx:      ctx,
-		action:   action,
-		response: respCh,
+		ctx:        ctx,
+		action:     action,
+		response:   respCh,
+		enqueuedAt: time.Now(),
 	}
 
-	d.queue <- item
+	if err := d.enqueue(item); err != nil {
+		d.metrics.rejected.Add(1)
+		return nil, err
+	}
 
 	select {
 	case resp := <-respCh:
-		return resp.err
+		return resp.result, resp.err
 	case <-ctx.Done():
-		return ctx.Err()
+		return nil, ctx.Err()
+	}
+}
+
+// authorize checks the caller's role in the request's ledger, set by
+// whichever entry point took the request, against the action's required
+// permission. A request without a role may only submit actions that need
+// none.
+func authorize(ctx context.Context, action actions.IAction) error {
+	required := action.RequiredPermission(ctx)
+	if required == actions.PermissionNone {
+		return nil
+	}
+	role, ok := requestctx.LedgerRole(ctx)
+	if !ok || !required.GrantedBy(role) {
+		return fmt.Errorf("%w: %s needs %s permission", ErrPermissionDenied, actions.Name(action), required)
+	}
+	return nil
+}
+
+func (d *OperatorDelegator) enqueue(item ActionItem) error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.stopped {
+		return ErrStopped
+	}
+	select {
+	case d.queues[d.shard(actions.OrderingKey(item.action))] <- item:
+		d.metrics.enqueued.Add(1)
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Stats returns a snapshot of the queue depth and processing counters.
+func (d *OperatorDelegator) Stats() Stats {
+	stats := d.metrics.snapshot()
+	stats.Workers = len(d.queues)
+	stats.WorkersRunning = int(d.running.Load())
+	for _, queue := range d.queues {
+		stats.QueueDepth += len(queue)
+		stats.QueueCapacity += cap(queue)
+	}
+	return stats
+}
+
+// shard picks the worker queue for an ordering key.
+func (d *OperatorDelegator) shard(key string) int {
+	if key == "" {
+		return int(d.next.Add(1) % uint64(len(d.queues)))
 	}
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int(h.Sum32() % uint32(len(d.queues)))
 }
@@ -2,37 +2,45 @@ package operator
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/gofrs/uuid/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/requestctx"
 	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
 )
 
 func TestNewOperatorDelegator_NumWorkersLessThanOne_DefaultsToOne(t *testing.T) {
 	mockStorage := &MockIStorage{}
-	d := NewOperatorDelegator(mockStorage, 0)
+	d := NewOperatorDelegator(mockStorage, 0, DefaultQueueCapacity)
 	require.NotNil(t, d)
 	d.Start()
-	defer d.Stop()
+	defer d.Stop(context.Background())
 
 	// With 1 worker, Process should succeed
 	tx := &mockTx{}
-	wt := storage.NewWriterForTestWithTx(tx)
+	wt := newTestWriter(tx)
 	mockStorage.EXPECT().
-		Write(mock.Anything).
+		Write(mock.Anything, mock.Anything).
 		Return(wt, nil)
 
 	mockAction := &actions.MockIAction{}
+	mockAction.EXPECT().RequiredPermission(mock.Anything).Return(actions.PermissionNone)
 	mockAction.EXPECT().
 		Perform(mock.Anything, wt).
-		Return(nil)
+		Return(nil, nil)
 
-	err := d.Process(context.Background(), mockAction)
+	_, err := d.Process(context.Background(), mockAction)
 	require.NoError(t, err)
 	mockStorage.AssertExpectations(t)
 	mockAction.AssertExpectations(t)
@@ -40,23 +48,25 @@ func TestNewOperatorDelegator_NumWorkersLessThanOne_DefaultsToOne(t *testing.T)
 
 func TestOperatorDelegator_Process_Success(t *testing.T) {
 	mockStorage := &MockIStorage{}
-	d := NewOperatorDelegator(mockStorage, 2)
+	d := NewOperatorDelegator(mockStorage, 2, DefaultQueueCapacity)
 	d.Start()
-	defer d.Stop()
+	defer d.Stop(context.Background())
 
 	tx := &mockTx{}
-	wt := storage.NewWriterForTestWithTx(tx)
+	wt := newTestWriter(tx)
 	mockStorage.EXPECT().
-		Write(mock.Anything).
+		Write(mock.Anything, mock.Anything).
 		Return(wt, nil)
 
 	mockAction := &actions.MockIAction{}
+	mockAction.EXPECT().RequiredPermission(mock.Anything).Return(actions.PermissionNone)
 	mockAction.EXPECT().
 		Perform(mock.Anything, wt).
-		Return(nil)
+		Return("result", nil)
 
-	err := d.Process(context.Background(), mockAction)
+	result, err := d.Process(context.Background(), mockAction)
 	require.NoError(t, err)
+	assert.Equal(t, "result", result)
 	assert.True(t, tx.commitCalled)
 	mockStorage.AssertExpectations(t)
 	mockAction.AssertExpectations(t)
@@ -65,22 +75,23 @@ func TestOperatorDelegator_Process_Success(t *testing.T) {
 func TestOperatorDelegator_Process_Error(t *testing.T) {
 	performErr := errors.New("action failed")
 	mockStorage := &MockIStorage{}
-	d := NewOperatorDelegator(mockStorage, 1)
+	d := NewOperatorDelegator(mockStorage, 1, DefaultQueueCapacity)
 	d.Start()
-	defer d.Stop()
+	defer d.Stop(context.Background())
 
 	tx := &mockTx{}
-	wt := storage.NewWriterForTestWithTx(tx)
+	wt := newTestWriter(tx)
 	mockStorage.EXPECT().
-		Write(mock.Anything).
+		Write(mock.Anything, mock.Anything).
 		Return(wt, nil)
 
 	mockAction := &actions.MockIAction{}
+	mockAction.EXPECT().RequiredPermission(mock.Anything).Return(actions.PermissionNone)
 	mockAction.EXPECT().
 		Perform(mock.Anything, wt).
-		Return(performErr)
+		Return(nil, performErr)
 
-	err := d.Process(context.Background(), mockAction)
+	_, err := d.Process(context.Background(), mockAction)
 	assert.ErrorIs(t, err, performErr)
 	assert.True(t, tx.rollbackCalled)
 	mockStorage.AssertExpectations(t)
@@ -89,32 +100,289 @@ func TestOperatorDelegator_Process_Error(t *testing.T) {
 
 func TestOperatorDelegator_Process_ContextCancelled(t *testing.T) {
 	mockStorage := &MockIStorage{}
-	d := NewOperatorDelegator(mockStorage, 1)
+	d := NewOperatorDelegator(mockStorage, 1, DefaultQueueCapacity)
 	d.Start()
-	defer d.Stop()
+	defer d.Stop(context.Background())
 
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 
 	mockAction := &actions.MockIAction{}
+	mockAction.EXPECT().RequiredPermission(mock.Anything).Return(actions.PermissionNone)
 
 	// Process returns immediately when context is already cancelled.
 	// The worker may still process the item in the background, so we allow
 	// mock calls with Maybe() to avoid panics from unexpected calls.
 	tx := &mockTx{}
-	wt := storage.NewWriterForTestWithTx(tx)
-	mockStorage.On("Write", mock.Anything).Maybe().Return(wt, nil)
-	mockAction.On("Perform", mock.Anything, mock.Anything).Maybe().Return(nil)
+	wt := newTestWriter(tx)
+	mockStorage.On("Write", mock.Anything, mock.Anything).Maybe().Return(wt, nil)
+	mockAction.On("Perform", mock.Anything, mock.Anything).Maybe().Return(nil, nil)
 
-	err := d.Process(ctx, mockAction)
+	_, err := d.Process(ctx, mockAction)
 	assert.ErrorIs(t, err, context.Canceled)
 }
 
 func TestOperatorDelegator_Stop_IsIdempotent(t *testing.T) {
 	mockStorage := &MockIStorage{}
-	d := NewOperatorDelegator(mockStorage, 1)
+	d := NewOperatorDelegator(mockStorage, 1, DefaultQueueCapacity)
 	d.Start()
 
-	d.Stop()
-	d.Stop() // Second call should not panic
+	assert.NoError(t, d.Stop(context.Background()))
+	assert.NoError(t, d.Stop(context.Background())) // Second call should not panic
+}
+
+// keyedAction is an ordered action that calls perform when it runs.
+type keyedAction struct {
+	key     string
+	perform func()
+}
+
+func (a *keyedAction) Perform(context.Context, *storage.Writer) (any, error) {
+	a.perform()
+	return nil, nil
+}
+
+func (a *keyedAction) RequiredPermission(context.Context) actions.Permission {
+	return actions.PermissionNone
+}
+
+func (a *keyedAction) OrderingKey() string {
+	return a.key
+}
+
+func newWriterPerCall(mockStorage *MockIStorage) {
+	mockStorage.EXPECT().
+		Write(mock.Anything, mock.Anything).
+		RunAndReturn(func(context.Context, sql.IsolationLevel) (*storage.Writer, error) {
+			return newTestWriter(&mockTx{}), nil
+		})
+}
+
+func TestOperatorDelegator_Shard_SameKeySameWorker(t *testing.T) {
+	d := NewOperatorDelegator(&MockIStorage{}, 8, DefaultQueueCapacity)
+
+	shard := d.shard("account-1")
+	for range 10 {
+		assert.Equal(t, shard, d.shard("account-1"))
+	}
+}
+
+func TestOperatorDelegator_Process_SameKeyRunsInOrder(t *testing.T) {
+	mockStorage := &MockIStorage{}
+	newWriterPerCall(mockStorage)
+	d := NewOperatorDelegator(mockStorage, 4, DefaultQueueCapacity)
+	d.Start()
+	defer d.Stop(context.Background())
+
+	const key = "account-1"
+	gate := make(chan struct{})
+	started := make(chan struct{})
+	var mu sync.Mutex
+	var order []int
+	record := func(i int) func() {
+		return func() {
+			mu.Lock()
+			order = append(order, i)
+			mu.Unlock()
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := d.Process(context.Background(), &keyedAction{key: key, perform: func() {
+			close(started)
+			<-gate
+			record(0)()
+		}})
+		assert.NoError(t, err)
+	}()
+	<-started
+
+	// Enqueue the rest one at a time behind the blocked first action.
+	queue := d.queues[d.shard(key)]
+	for i := 1; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := d.Process(context.Background(), &keyedAction{key: key, perform: record(i)})
+			assert.NoError(t, err)
+		}()
+		require.Eventually(t, func() bool { return len(queue) == i }, time.Second, time.Millisecond)
+	}
+
+	close(gate)
+	wg.Wait()
+
+	expected := make([]int, 20)
+	for i := range expected {
+		expected[i] = i
+	}
+	assert.Equal(t, expected, order)
+}
+
+func TestOperatorDelegator_Process_DifferentKeysRunInParallel(t *testing.T) {
+	mockStorage := &MockIStorage{}
+	newWriterPerCall(mockStorage)
+	d := NewOperatorDelegator(mockStorage, 2, DefaultQueueCapacity)
+	d.Start()
+	defer d.Stop(context.Background())
+
+	blockedKey := "account-0"
+	otherKey := ""
+	for i := 1; otherKey == ""; i++ {
+		if key := fmt.Sprintf("account-%d", i); d.shard(key) != d.shard(blockedKey) {
+			otherKey = key
+		}
+	}
+
+	otherDone := make(chan struct{})
+	blockedErr := make(chan error, 1)
+	go func() {
+		// Only finishes once the other key's action has run, which would
+		// deadlock if both keys shared a worker.
+		_, err := d.Process(context.Background(), &keyedAction{key: blockedKey, perform: func() { <-otherDone }})
+		blockedErr <- err
+	}()
+
+	_, err := d.Process(context.Background(), &keyedAction{key: otherKey, perform: func() { close(otherDone) }})
+	require.NoError(t, err)
+
+	select {
+	case err := <-blockedErr:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("blocked action did not finish")
+	}
+}
+
+func TestNewOperatorDelegator_SplitsQueueCapacity(t *testing.T) {
+	d := NewOperatorDelegator(&MockIStorage{}, 4, 40)
+
+	assert.Equal(t, 40, d.Stats().QueueCapacity)
+	for _, queue := range d.queues {
+		assert.Equal(t, 10, cap(queue))
+	}
+}
+
+func TestNewOperatorDelegator_NoQueueCapacity_UsesDefault(t *testing.T) {
+	d := NewOperatorDelegator(&MockIStorage{}, 1, 0)
+
+	assert.Equal(t, DefaultQueueCapacity, d.Stats().QueueCapacity)
+}
+
+func TestOperatorDelegator_Stats_WorkersRunning(t *testing.T) {
+	d := NewOperatorDelegator(&MockIStorage{}, 3, DefaultQueueCapacity)
+	assert.Equal(t, 0, d.Stats().WorkersRunning)
+
+	d.Start()
+	assert.Equal(t, 3, d.Stats().Workers)
+	assert.Equal(t, 3, d.Stats().WorkersRunning)
+
+	require.NoError(t, d.Stop(context.Background()))
+	assert.Equal(t, 0, d.Stats().WorkersRunning)
+}
+
+func TestOperatorDelegator_Process_QueueFull(t *testing.T) {
+	d := NewOperatorDelegator(&MockIStorage{}, 1, DefaultQueueCapacity)
+	queue := d.queues[0]
+	for len(queue) < cap(queue) {
+		queue <- ActionItem{}
+	}
+
+	_, err := d.Process(context.Background(), &keyedAction{})
+	assert.ErrorIs(t, err, ErrQueueFull)
+
+	stats := d.Stats()
+	assert.Equal(t, cap(queue), stats.QueueDepth)
+	assert.Equal(t, uint64(1), stats.Rejected)
+}
+
+func TestOperatorDelegator_Process_AfterStop(t *testing.T) {
+	d := NewOperatorDelegator(&MockIStorage{}, 1, DefaultQueueCapacity)
+	d.Start()
+	require.NoError(t, d.Stop(context.Background()))
+
+	_, err := d.Process(context.Background(), &keyedAction{})
+	assert.ErrorIs(t, err, ErrStopped)
+}
+
+func TestOperatorDelegator_Stop_DrainTimeout(t *testing.T) {
+	mockStorage := &MockIStorage{}
+	newWriterPerCall(mockStorage)
+	d := NewOperatorDelegator(mockStorage, 1, DefaultQueueCapacity)
+	d.Start()
+
+	gate := make(chan struct{})
+	started := make(chan struct{})
+	processed := make(chan error, 1)
+	go func() {
+		_, err := d.Process(context.Background(), &keyedAction{perform: func() {
+			close(started)
+			<-gate
+		}})
+		processed <- err
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, d.Stop(ctx), context.DeadlineExceeded)
+
+	close(gate)
+	require.NoError(t, <-processed)
+	assert.NoError(t, d.Stop(context.Background()))
+	assert.Equal(t, uint64(1), d.Stats().Succeeded)
+}
+
+func TestOperatorDelegator_Process_ChecksPermission(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+	}{
+		{"no ledger role", context.Background(), ErrPermissionDenied},
+		{"viewer", requestctx.WithLedgerRole(context.Background(), ledger.RoleViewer), ErrPermissionDenied},
+		{"editor", requestctx.WithLedgerRole(context.Background(), ledger.RoleEditor), nil},
+		{"owner", requestctx.WithLedgerRole(context.Background(), ledger.RoleOwner), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockStorage := &MockIStorage{}
+			d := NewOperatorDelegator(mockStorage, 1, DefaultQueueCapacity)
+			d.Start()
+			defer d.Stop(context.Background())
+
+			wt := newTestWriter(&mockTx{})
+			mockStorage.On("Write", mock.Anything, mock.Anything).Maybe().Return(wt, nil)
+			mockAction := &actions.MockIAction{}
+			mockAction.EXPECT().RequiredPermission(mock.Anything).Return(actions.PermissionEdit)
+			mockAction.On("Perform", mock.Anything, wt).Maybe().Return(nil, nil)
+
+			_, err := d.Process(tt.ctx, mockAction)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockStorage.AssertNotCalled(t, "Write", mock.Anything, mock.Anything)
+				mockAction.AssertNotCalled(t, "Perform", mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			mockAction.AssertCalled(t, "Perform", mock.Anything, wt)
+		})
+	}
+}
+
+func TestOperatorDelegator_Process_ChecksPermissionForRequestActor(t *testing.T) {
+	mockStorage := &MockIStorage{}
+	d := NewOperatorDelegator(mockStorage, 1, DefaultQueueCapacity)
+	viewerID := uuid.Must(uuid.NewV4())
+	ctx := requestctx.WithLedgerRole(context.Background(), ledger.RoleViewer)
+	ctx = requestctx.WithActorID(ctx, viewerID)
+
+	_, err := d.Process(ctx, &actions.RemoveLedgerMember{UserID: uuid.Must(uuid.NewV4())})
+
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+	mockStorage.AssertNotCalled(t, "Write", mock.Anything, mock.Anything)
 }
@@ -11,7 +11,6 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/carson-networks/budget-server/internal/operator/actions"
-	"github.com/carson-networks/budget-server/internal/storage"
 )
 
 func TestOperator_processItem_Success(t *testing.T) {
@@ -20,14 +19,14 @@ func TestOperator_processItem_Success(t *testing.T) {
 	mockAction := &actions.MockIAction{}
 
 	tx := &mockTx{}
-	wt := storage.NewWriterForTestWithTx(tx)
+	wt := newTestWriter(tx)
 
 	mockStorage.EXPECT().
-		Write(mock.Anything).
+		Write(mock.Anything, mock.Anything).
 		Return(wt, nil)
 	mockAction.EXPECT().
 		Perform(mock.Anything, wt).
-		Return(nil)
+		Return(nil, nil)
 
 	queue := make(chan ActionItem, 1)
 	op := NewOperator(mockStorage, queue)
@@ -53,7 +52,7 @@ func TestOperator_processItem_WriteFails(t *testing.T) {
 	mockAction := &actions.MockIAction{}
 
 	mockStorage.EXPECT().
-		Write(mock.Anything).
+		Write(mock.Anything, mock.Anything).
 		Return(nil, writeErr)
 
 	queue := make(chan ActionItem, 1)
@@ -78,14 +77,14 @@ func TestOperator_processItem_PerformFails(t *testing.T) {
 	mockAction := &actions.MockIAction{}
 
 	tx := &mockTx{}
-	wt := storage.NewWriterForTestWithTx(tx)
+	wt := newTestWriter(tx)
 
 	mockStorage.EXPECT().
-		Write(mock.Anything).
+		Write(mock.Anything, mock.Anything).
 		Return(wt, nil)
 	mockAction.EXPECT().
 		Perform(mock.Anything, wt).
-		Return(performErr)
+		Return(nil, performErr)
 
 	queue := make(chan ActionItem, 1)
 	op := NewOperator(mockStorage, queue)
@@ -111,14 +110,14 @@ func TestOperator_processItem_CommitFails(t *testing.T) {
 	mockAction := &actions.MockIAction{}
 
 	tx := &mockTx{commitErr: commitErr}
-	wt := storage.NewWriterForTestWithTx(tx)
+	wt := newTestWriter(tx)
 
 	mockStorage.EXPECT().
-		Write(mock.Anything).
+		Write(mock.Anything, mock.Anything).
 		Return(wt, nil)
 	mockAction.EXPECT().
 		Perform(mock.Anything, wt).
-		Return(nil)
+		Return(nil, nil)
 
 	queue := make(chan ActionItem, 1)
 	op := NewOperator(mockStorage, queue)
@@ -151,3 +150,25 @@ func TestOperator_Run_ExitsOnClosedChannel(t *testing.T) {
 	close(queue)
 	wg.Wait()
 }
+
+func TestOperator_processItem_SkipsCancelledItem(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mockStorage := &MockIStorage{}
+	mockAction := &actions.MockIAction{}
+
+	queue := make(chan ActionItem, 1)
+	op := NewOperator(mockStorage, queue)
+
+	respCh := make(chan ActionItemResponse, 1)
+	queue <- ActionItem{ctx: ctx, action: mockAction, response: respCh}
+	close(queue)
+
+	op.Run()
+
+	resp := <-respCh
+	assert.ErrorIs(t, resp.err, context.Canceled)
+	assert.Equal(t, uint64(1), op.metrics.skipped.Load())
+	mockStorage.AssertNotCalled(t, "Write", mock.Anything, mock.Anything)
+	mockAction.AssertNotCalled(t, "Perform", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,34 @@
+package operator
+
+import (
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	defaultMaxAttempts = 5
+	baseBackoff        = 10 * time.Millisecond
+	maxBackoff         = 500 * time.Millisecond
+)
+
+// retryableCodes are the Postgres errors after which the whole transaction
+// can be run again: serialization_failure and deadlock_detected.
+var retryableCodes = map[pq.ErrorCode]bool{
+	"40001": true,
+	"40P01": true,
+}
+
+func isRetryable(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && retryableCodes[pqErr.Code]
+}
+
+// jitteredBackoff returns a random delay up to an exponentially growing cap,
+// so concurrent transactions that conflicted do not retry in lockstep.
+func jitteredBackoff(attempt int) time.Duration {
+	ceiling := min(baseBackoff<<(attempt-1), maxBackoff)
+	return rand.N(ceiling) + 1
+}
@@ -0,0 +1,128 @@
+package operator
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/operator/actions"
+	"github.com/carson-networks/budget-server/internal/storage"
+)
+
+// serializableAction is an action that asks for SERIALIZABLE isolation.
+type serializableAction struct {
+	actions.IAction
+}
+
+func (a *serializableAction) IsolationLevel() sql.IsolationLevel {
+	return sql.LevelSerializable
+}
+
+func newRetryTestOperator(mockStorage *MockIStorage, maxAttempts int) *Operator {
+	op := NewOperator(mockStorage, nil)
+	op.maxAttempts = maxAttempts
+	op.backoff = func(int) time.Duration { return 0 }
+	return op
+}
+
+func runItem(op *Operator, action actions.IAction) ActionItemResponse {
+	respCh := make(chan ActionItemResponse, 1)
+	op.processItem(ActionItem{ctx: context.Background(), action: action, response: respCh})
+	return <-respCh
+}
+
+func TestOperator_processItem_RetriesSerializationFailure(t *testing.T) {
+	first := &mockTx{}
+	second := &mockTx{}
+	mockStorage := &MockIStorage{}
+	mockStorage.EXPECT().Write(mock.Anything, mock.Anything).Return(newTestWriter(first), nil).Once()
+	mockStorage.EXPECT().Write(mock.Anything, mock.Anything).Return(newTestWriter(second), nil).Once()
+	mockAction := &actions.MockIAction{}
+	mockAction.EXPECT().Perform(mock.Anything, mock.Anything).Return(nil, &pq.Error{Code: "40001"}).Once()
+	mockAction.EXPECT().Perform(mock.Anything, mock.Anything).Return("done", nil).Once()
+
+	op := newRetryTestOperator(mockStorage, 3)
+	resp := runItem(op, mockAction)
+
+	require.NoError(t, resp.err)
+	assert.Equal(t, "done", resp.result)
+	assert.True(t, first.rollbackCalled)
+	assert.True(t, second.commitCalled)
+	assert.Equal(t, uint64(1), op.metrics.retried.Load())
+	mockAction.AssertExpectations(t)
+}
+
+func TestOperator_processItem_RetriesDeadlockOnCommit(t *testing.T) {
+	deadlocked := &mockTx{commitErr: &pq.Error{Code: "40P01"}}
+	mockStorage := &MockIStorage{}
+	mockStorage.EXPECT().Write(mock.Anything, mock.Anything).Return(newTestWriter(deadlocked), nil).Once()
+	mockStorage.EXPECT().Write(mock.Anything, mock.Anything).Return(newTestWriter(&mockTx{}), nil).Once()
+	mockAction := &actions.MockIAction{}
+	mockAction.EXPECT().Perform(mock.Anything, mock.Anything).Return(nil, nil).Twice()
+
+	resp := runItem(newRetryTestOperator(mockStorage, 3), mockAction)
+
+	require.NoError(t, resp.err)
+	mockAction.AssertExpectations(t)
+}
+
+func TestOperator_processItem_GivesUpAfterMaxAttempts(t *testing.T) {
+	mockStorage := &MockIStorage{}
+	mockStorage.EXPECT().
+		Write(mock.Anything, mock.Anything).
+		RunAndReturn(func(context.Context, sql.IsolationLevel) (*storage.Writer, error) {
+			return newTestWriter(&mockTx{}), nil
+		})
+	conflict := &pq.Error{Code: "40001"}
+	mockAction := &actions.MockIAction{}
+	mockAction.EXPECT().Perform(mock.Anything, mock.Anything).Return(nil, conflict).Times(3)
+
+	resp := runItem(newRetryTestOperator(mockStorage, 3), mockAction)
+
+	assert.ErrorIs(t, resp.err, conflict)
+	mockAction.AssertExpectations(t)
+}
+
+func TestOperator_processItem_DoesNotRetryOtherErrors(t *testing.T) {
+	performErr := errors.New("perform failed")
+	mockStorage := &MockIStorage{}
+	mockStorage.EXPECT().Write(mock.Anything, mock.Anything).Return(newTestWriter(&mockTx{}), nil).Once()
+	mockAction := &actions.MockIAction{}
+	mockAction.EXPECT().Perform(mock.Anything, mock.Anything).Return(nil, performErr).Once()
+
+	resp := runItem(newRetryTestOperator(mockStorage, 3), mockAction)
+
+	assert.ErrorIs(t, resp.err, performErr)
+	mockAction.AssertExpectations(t)
+}
+
+func TestOperator_processItem_UsesActionIsolationLevel(t *testing.T) {
+	mockStorage := &MockIStorage{}
+	mockStorage.EXPECT().
+		Write(mock.Anything, sql.LevelSerializable).
+		Return(newTestWriter(&mockTx{}), nil).Once()
+	mockAction := &actions.MockIAction{}
+	mockAction.EXPECT().Perform(mock.Anything, mock.Anything).Return(nil, nil).Once()
+
+	resp := runItem(newRetryTestOperator(mockStorage, 3), &serializableAction{IAction: mockAction})
+
+	require.NoError(t, resp.err)
+	mockStorage.AssertExpectations(t)
+}
+
+func TestJitteredBackoff_Bounds(t *testing.T) {
+	for attempt := 1; attempt <= 10; attempt++ {
+		for range 20 {
+			d := jitteredBackoff(attempt)
+			assert.Positive(t, d)
+			assert.LessOrEqual(t, d, maxBackoff)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package operator
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Stats is a snapshot of the delegator's queue and processing counters.
+type Stats struct {
+	Workers        int     `json:"workers"`
+	WorkersRunning int     `json:"workersRunning"`
+	QueueDepth     int     `json:"queueDepth"`
+	QueueCapacity  int     `json:"queueCapacity"`
+	Enqueued       uint64  `json:"enqueued"`
+	Rejected       uint64  `json:"rejected"`
+	Skipped        uint64  `json:"skipped"`
+	Succeeded      uint64  `json:"succeeded"`
+	Failed         uint64  `json:"failed"`
+	Retried        uint64  `json:"retried"`
+	AvgQueueWaitMs float64 `json:"avgQueueWaitMs"`
+	AvgProcessMs   float64 `json:"avgProcessMs"`
+}
+
+// metrics holds the counters shared by the delegator and its workers.
+type metrics struct {
+	enqueued  atomic.Uint64
+	rejected  atomic.Uint64
+	skipped   atomic.Uint64
+	succeeded atomic.Uint64
+	failed    atomic.Uint64
+	retried   atomic.Uint64
+
+	queueWaitNanos atomic.Int64
+	processNanos   atomic.Int64
+}
+
+// observe records a processed item that waited in the queue for wait and
+// then took process to perform and commit.
+func (m *metrics) observe(wait time.Duration, process time.Duration, err error) {
+	m.queueWaitNanos.Add(int64(wait))
+	m.processNanos.Add(int64(process))
+	if err != nil {
+		m.failed.Add(1)
+	} else {
+		m.succeeded.Add(1)
+	}
+}
+
+func (m *metrics) snapshot() Stats {
+	stats := Stats{
+		Enqueued:  m.enqueued.Load(),
+		Rejected:  m.rejected.Load(),
+		Skipped:   m.skipped.Load(),
+		Succeeded: m.succeeded.Load(),
+		Failed:    m.failed.Load(),
+		Retried:   m.retried.Load(),
+	}
+	if processed := stats.Succeeded + stats.Failed; processed > 0 {
+		stats.AvgQueueWaitMs = durationMs(m.queueWaitNanos.Load()) / float64(processed)
+		stats.AvgProcessMs = durationMs(m.processNanos.Load()) / float64(processed)
+	}
+	return stats
+}
+
+func durationMs(nanos int64) float64 {
+	return float64(nanos) / float64(time.Millisecond)
+}
@@ -0,0 +1,60 @@
+package pagination
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const (
+	// DefaultLimit is the page size used when the caller does not specify one.
+	DefaultLimit = 20
+	// MaxLimit is the largest page size a caller may request.
+	MaxLimit = 100
+)
+
+// TimeKey positions a row in a (created_at, id) ordering.
+type TimeKey struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// NameKey positions a row in a (name, id) ordering.
+type NameKey struct {
+	Name string    `json:"name"`
+	ID   uuid.UUID `json:"id"`
+}
+
+// Cursor identifies the last row of a page and carries the limit so
+// subsequent pages are fetched with the same page size. Filter is the
+// FilterHash of the listing filter the cursor was issued for.
+type Cursor[K any] struct {
+	After  K      `json:"after"`
+	Limit  int    `json:"limit"`
+	Filter string `json:"filter,omitempty"`
+}
+
+// Limit returns the page size to use for a requested limit, applying the
+// default when unset and clamping to MaxLimit.
+func Limit(requested int) int {
+	if requested <= 0 {
+		return DefaultLimit
+	}
+	if requested > MaxLimit {
+		return MaxLimit
+	}
+	return requested
+}
+
+// NextPage trims rows fetched with limit+1 down to limit. When there are more
+// rows than the limit it returns a cursor positioned after the last kept row.
+func NextPage[T any, K any](rows []T, limit int, key func(T) K) ([]T, *Cursor[K]) {
+	if len(rows) <= limit {
+		return rows, nil
+	}
+	rows = rows[:limit]
+	return rows, &Cursor[K]{
+		After: key(rows[len(rows)-1]),
+		Limit: limit,
+	}
+}
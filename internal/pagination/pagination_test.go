@@ -0,0 +1,106 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimit(t *testing.T) {
+	assert.Equal(t, DefaultLimit, Limit(0))
+	assert.Equal(t, DefaultLimit, Limit(-5))
+	assert.Equal(t, 10, Limit(10))
+	assert.Equal(t, MaxLimit, Limit(MaxLimit+1))
+}
+
+func TestNextPage_LastPage(t *testing.T) {
+	rows, next := NextPage([]int{1, 2}, 2, func(i int) int { return i })
+	assert.Equal(t, []int{1, 2}, rows)
+	assert.Nil(t, next)
+}
+
+func TestNextPage_MoreRows(t *testing.T) {
+	rows, next := NextPage([]int{1, 2, 3}, 2, func(i int) int { return i * 10 })
+	assert.Equal(t, []int{1, 2}, rows)
+	require.NotNil(t, next)
+	assert.Equal(t, 20, next.After)
+	assert.Equal(t, 2, next.Limit)
+}
+
+func TestCodec_RoundTrip(t *testing.T) {
+	codec := NewCodec([]byte("secret"))
+	cursor := &Cursor[TimeKey]{
+		After: TimeKey{
+			CreatedAt: time.Date(2025, 6, 1, 12, 0, 0, 123456000, time.UTC),
+			ID:        uuid.Must(uuid.NewV4()),
+		},
+		Limit: 10,
+	}
+
+	token, err := Encode(codec, "transactions", "", cursor)
+	require.NoError(t, err)
+
+	decoded, err := Decode[TimeKey](codec, "transactions", "", token)
+	require.NoError(t, err)
+	assert.Equal(t, cursor.Limit, decoded.Limit)
+	assert.Equal(t, cursor.After.ID, decoded.After.ID)
+	assert.True(t, cursor.After.CreatedAt.Equal(decoded.After.CreatedAt))
+}
+
+func TestCodec_RejectsTamperedToken(t *testing.T) {
+	codec := NewCodec([]byte("secret"))
+	token, err := Encode(codec, "accounts", "", &Cursor[NameKey]{After: NameKey{Name: "a"}, Limit: 5})
+	require.NoError(t, err)
+
+	forged, err := Encode(NewCodec([]byte("other")), "accounts", "", &Cursor[NameKey]{After: NameKey{Name: "z"}, Limit: 5})
+	require.NoError(t, err)
+
+	_, err = Decode[NameKey](codec, "accounts", "", forged)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	_, err = Decode[NameKey](codec, "accounts", "", token+"x")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	_, err = Decode[NameKey](codec, "accounts", "", "not-a-token")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestCodec_RejectsOtherScope(t *testing.T) {
+	codec := NewCodec([]byte("secret"))
+	token, err := Encode(codec, "accounts", "", &Cursor[NameKey]{After: NameKey{Name: "a"}, Limit: 5})
+	require.NoError(t, err)
+
+	_, err = Decode[NameKey](codec, "categories", "", token)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestCodec_RejectsOtherFilter(t *testing.T) {
+	codec := NewCodec([]byte("secret"))
+	issuedFor, err := FilterHash(map[string]string{"accountID": "a"})
+	require.NoError(t, err)
+	other, err := FilterHash(map[string]string{"accountID": "b"})
+	require.NoError(t, err)
+	require.NotEqual(t, issuedFor, other)
+
+	token, err := Encode(codec, "transactions", issuedFor, &Cursor[TimeKey]{Limit: 5})
+	require.NoError(t, err)
+
+	decoded, err := Decode[TimeKey](codec, "transactions", issuedFor, token)
+	require.NoError(t, err)
+	assert.Equal(t, 5, decoded.Limit)
+
+	_, err = Decode[TimeKey](codec, "transactions", other, token)
+	assert.ErrorIs(t, err, ErrFilterMismatch)
+
+	_, err = Decode[TimeKey](codec, "transactions", "", token)
+	assert.ErrorIs(t, err, ErrFilterMismatch)
+}
+
+func TestFilterHash_NilIsEmpty(t *testing.T) {
+	hash, err := FilterHash(nil)
+	require.NoError(t, err)
+	assert.Empty(t, hash)
+}
@@ -0,0 +1,87 @@
+// Package requestctx carries who made a request, which request it was and
+// which ledger it acts on, with the caller's role there, through its context
+// so writes can be attributed in the audit log, storage can scope queries to
+// the ledger and the operator can check the caller may submit an action.
+package requestctx
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+)
+
+// Anonymous is the actor of requests that did not identify themselves.
+const Anonymous = "anonymous"
+
+// ErrNoLedger is returned by LedgerID outside of a request for a ledger.
+// Storage returns it rather than run an unscoped query.
+var ErrNoLedger = errors.New("no ledger in context")
+
+type contextKey int
+
+const (
+	requestIDKey contextKey = iota
+	actorKey
+	actorIDKey
+	ledgerIDKey
+	ledgerRoleKey
+)
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestID returns the request's ID, or "" outside of a request.
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
+
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// Actor returns who made the request, or Anonymous when unknown.
+func Actor(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey).(string); ok && actor != "" {
+		return actor
+	}
+	return Anonymous
+}
+
+func WithActorID(ctx context.Context, userID uuid.UUID) context.Context {
+	return context.WithValue(ctx, actorIDKey, userID)
+}
+
+// ActorID returns the ID of the user who made the request, or false when
+// the request did not identify one.
+func ActorID(ctx context.Context) (uuid.UUID, bool) {
+	userID, ok := ctx.Value(actorIDKey).(uuid.UUID)
+	return userID, ok && userID != uuid.Nil
+}
+
+func WithLedgerID(ctx context.Context, ledgerID uuid.UUID) context.Context {
+	return context.WithValue(ctx, ledgerIDKey, ledgerID)
+}
+
+// LedgerID returns the ledger the request acts on, or ErrNoLedger.
+func LedgerID(ctx context.Context) (uuid.UUID, error) {
+	if ledgerID, ok := ctx.Value(ledgerIDKey).(uuid.UUID); ok && ledgerID != uuid.Nil {
+		return ledgerID, nil
+	}
+	return uuid.Nil, ErrNoLedger
+}
+
+func WithLedgerRole(ctx context.Context, role ledger.Role) context.Context {
+	return context.WithValue(ctx, ledgerRoleKey, role)
+}
+
+// LedgerRole returns the caller's role in the request's ledger, or false
+// when the request has none.
+func LedgerRole(ctx context.Context) (ledger.Role, bool) {
+	role, ok := ctx.Value(ledgerRoleKey).(ledger.Role)
+	return role, ok
+}
@@ -0,0 +1,57 @@
+package requestctx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+)
+
+func TestRequestID(t *testing.T) {
+	assert.Empty(t, RequestID(context.Background()))
+	assert.Equal(t, "req-1", RequestID(WithRequestID(context.Background(), "req-1")))
+}
+
+func TestActor(t *testing.T) {
+	assert.Equal(t, Anonymous, Actor(context.Background()))
+	assert.Equal(t, Anonymous, Actor(WithActor(context.Background(), "")))
+	assert.Equal(t, "sam", Actor(WithActor(context.Background(), "sam")))
+}
+
+func TestActorID(t *testing.T) {
+	_, ok := ActorID(context.Background())
+	assert.False(t, ok)
+	_, ok = ActorID(WithActorID(context.Background(), uuid.Nil))
+	assert.False(t, ok)
+
+	userID := uuid.Must(uuid.NewV4())
+	got, ok := ActorID(WithActorID(context.Background(), userID))
+	assert.True(t, ok)
+	assert.Equal(t, userID, got)
+}
+
+func TestLedgerID(t *testing.T) {
+	_, err := LedgerID(context.Background())
+	assert.ErrorIs(t, err, ErrNoLedger)
+
+	_, err = LedgerID(WithLedgerID(context.Background(), uuid.Nil))
+	assert.ErrorIs(t, err, ErrNoLedger)
+
+	ledgerID := uuid.Must(uuid.NewV4())
+	got, err := LedgerID(WithLedgerID(context.Background(), ledgerID))
+	require.NoError(t, err)
+	assert.Equal(t, ledgerID, got)
+}
+
+func TestLedgerRole(t *testing.T) {
+	_, ok := LedgerRole(context.Background())
+	assert.False(t, ok)
+
+	role, ok := LedgerRole(WithLedgerRole(context.Background(), ledger.RoleViewer))
+	assert.True(t, ok)
+	assert.Equal(t, ledger.RoleViewer, role)
+}
@@ -0,0 +1,42 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stephenafamo/bob"
+
+	"github.com/carson-networks/budget-server/internal/storage/storagetest"
+)
+
+func TestLedgerIsolation(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	tests := map[string]func(ctx context.Context, exec bob.Executor) error{
+		"List": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).List(ctx, &AccountFilter{})
+			return err
+		},
+		"FindByID": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).FindByID(ctx, id)
+			return err
+		},
+		"FindByIDForUpdate": func(ctx context.Context, exec bob.Executor) error {
+			_, err := (&Writer{Reader{exec: exec}}).FindByIDForUpdate(ctx, id)
+			return err
+		},
+		"Create": func(ctx context.Context, exec bob.Executor) error {
+			_, err := (&Writer{Reader{exec: exec}}).Create(ctx, "Checking", AccountTypeCash, "", decimal.Zero)
+			return err
+		},
+		"UpdateBalance": func(ctx context.Context, exec bob.Executor) error {
+			return (&Writer{Reader{exec: exec}}).UpdateBalance(ctx, id, decimal.NewFromInt(1))
+		},
+	}
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			storagetest.AssertLedgerScoped(t, call)
+		})
+	}
+}
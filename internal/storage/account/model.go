@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/carson-networks/budget-server/internal/pagination"
 	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
 	"github.com/gofrs/uuid/v5"
 	"github.com/shopspring/decimal"
@@ -21,21 +22,16 @@ type Account struct {
 }
 
 // AccountFilter specifies filters for listing accounts.
+// Accounts are ordered by (name, id); After resumes after that position.
 type AccountFilter struct {
-	Limit  int
-	Offset int
-}
-
-// AccountCursor identifies a position in a paginated result set.
-type AccountCursor struct {
-	Position int
-	Limit    int
+	Limit int
+	After *pagination.NameKey
 }
 
 // AccountListResult contains a page of accounts and an optional next cursor.
 type AccountListResult struct {
 	Accounts   []*Account
-	NextCursor *AccountCursor
+	NextCursor *pagination.Cursor[pagination.NameKey]
 }
 
 // AccountCreate is the input for creating a new account.
@@ -78,3 +74,7 @@ func bobAccountToAccount(row *bobgen.Account) *Account {
 		CreatedAt:       row.CreatedAt,
 	}
 }
+
+func accountNameKey(a *Account) pagination.NameKey {
+	return pagination.NameKey{Name: a.Name, ID: a.ID}
+}
@@ -3,9 +3,12 @@ package account
 import (
 	"context"
 
+	"github.com/carson-networks/budget-server/internal/pagination"
+	"github.com/carson-networks/budget-server/internal/requestctx"
 	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
 	"github.com/gofrs/uuid/v5"
 	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
 	"github.com/stephenafamo/bob/dialect/psql/dialect"
 	"github.com/stephenafamo/bob/dialect/psql/sm"
 )
@@ -18,22 +21,30 @@ func NewReader(exec bob.Executor) *Reader {
 	return &Reader{exec: exec}
 }
 
+// List returns a page of the request ledger's accounts.
 func (r *Reader) List(ctx context.Context, filter *AccountFilter) (*AccountListResult, error) {
-	limit := 20
-	offset := 0
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := pagination.DefaultLimit
+	queryMods := []bob.Mod[*dialect.SelectQuery]{bobgen.SelectWhere.Accounts.LedgerID.EQ(ledgerID)}
 	if filter != nil {
-		if filter.Limit > 0 {
-			limit = filter.Limit
+		limit = pagination.Limit(filter.Limit)
+		if filter.After != nil {
+			queryMods = append(queryMods, sm.Where(
+				psql.Group(bobgen.Accounts.Columns.Name, bobgen.Accounts.Columns.ID).
+					GT(psql.ArgGroup(filter.After.Name, filter.After.ID)),
+			))
 		}
-		offset = filter.Offset
 	}
 
-	queryMods := []bob.Mod[*dialect.SelectQuery]{
-		sm.Limit(limit + 1),
-		sm.Offset(offset),
+	queryMods = append(queryMods,
+		sm.Limit(limit+1),
 		sm.OrderBy(bobgen.Accounts.Columns.Name).Asc(),
 		sm.OrderBy(bobgen.Accounts.Columns.ID).Asc(),
-	}
+	)
 	rows, err := bobgen.Accounts.Query(queryMods...).All(ctx, r.exec)
 	if err != nil {
 		return nil, err
@@ -43,24 +54,25 @@ func (r *Reader) List(ctx context.Context, filter *AccountFilter) (*AccountListR
 		return &AccountListResult{Accounts: nil, NextCursor: nil}, nil
 	}
 
-	var nextCursor *AccountCursor
-	if len(rows) > limit {
-		rows = rows[:limit]
-		nextCursor = &AccountCursor{
-			Position: offset + limit,
-			Limit:    limit,
-		}
-	}
-
 	result := make([]*Account, len(rows))
 	for i, row := range rows {
 		result[i] = bobAccountToAccount(row)
 	}
+	result, nextCursor := pagination.NextPage(result, limit, accountNameKey)
 	return &AccountListResult{Accounts: result, NextCursor: nextCursor}, nil
 }
 
+// FindByID returns the account, or sql.ErrNoRows when the request ledger
+// has none with that ID.
 func (r *Reader) FindByID(ctx context.Context, id uuid.UUID) (*Account, error) {
-	row, err := bobgen.FindAccount(ctx, r.exec, id)
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := bobgen.Accounts.Query(
+		bobgen.SelectWhere.Accounts.LedgerID.EQ(ledgerID),
+		bobgen.SelectWhere.Accounts.ID.EQ(id),
+	).One(ctx, r.exec)
 	if err != nil {
 		return nil, err
 	}
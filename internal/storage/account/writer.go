@@ -4,24 +4,24 @@ import (
 	"context"
 
 	"github.com/aarondl/opt/omit"
+	"github.com/carson-networks/budget-server/internal/requestctx"
 	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
 	"github.com/gofrs/uuid/v5"
 	"github.com/shopspring/decimal"
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/psql"
-	"github.com/stephenafamo/bob/dialect/psql/dialect"
 	"github.com/stephenafamo/bob/dialect/psql/sm"
 	"github.com/stephenafamo/bob/dialect/psql/um"
 )
 
+// Writer writes the request ledger's accounts. Its queries go through the
+// embedded Reader's executor, which is the transaction.
 type Writer struct {
-	tx bob.Tx
 	Reader
 }
 
 func NewWriter(tx bob.Tx) *Writer {
 	return &Writer{
-		tx: tx,
 		Reader: Reader{
 			exec: tx,
 		},
@@ -29,39 +29,55 @@ func NewWriter(tx bob.Tx) *Writer {
 }
 
 func (w *Writer) FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*Account, error) {
-	var queryMods []bob.Mod[*dialect.SelectQuery]
-	queryMods = append(queryMods,
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := bobgen.Accounts.Query(
+		bobgen.SelectWhere.Accounts.LedgerID.EQ(ledgerID),
 		bobgen.SelectWhere.Accounts.ID.EQ(id),
 		sm.ForUpdate(),
-	)
-	bobgen.Accounts.Query()
-
-	row, err := bobgen.FindAccount(ctx, w.tx, id)
+	).One(ctx, w.exec)
 	if err != nil {
 		return nil, err
 	}
 	return bobAccountToAccount(row), nil
 }
 
-func (w *Writer) Create(ctx context.Context, name string, accountType AccountType, accountSubType string, startingBalance decimal.Decimal) error {
+func (w *Writer) Create(ctx context.Context, name string, accountType AccountType, accountSubType string, startingBalance decimal.Decimal) (*Account, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	setter := &bobgen.AccountSetter{
 		Name:            omit.From(name),
 		Type:            omit.From(int16(accountType)),
 		SubType:         omit.From(accountSubType),
 		Balance:         omit.From(startingBalance),
 		StartingBalance: omit.From(startingBalance),
+		LedgerID:        omit.From(ledgerID),
 	}
-	_, err := bobgen.Accounts.Insert(setter).One(ctx, w.tx)
+	row, err := bobgen.Accounts.Insert(setter).One(ctx, w.exec)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return err
+	return bobAccountToAccount(row), nil
 }
 
 func (w *Writer) UpdateBalance(ctx context.Context, id uuid.UUID, balance decimal.Decimal) error {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return err
+	}
 	setter := bobgen.AccountSetter{
 		Balance: omit.From(balance),
 	}
-	_, err := bobgen.Accounts.Update(setter.UpdateMod(), um.Where(bobgen.Accounts.Columns.ID.EQ(psql.Arg(id)))).Exec(ctx, w.tx)
+	_, err = bobgen.Accounts.Update(
+		setter.UpdateMod(),
+		um.Where(psql.And(
+			bobgen.Accounts.Columns.LedgerID.EQ(psql.Arg(ledgerID)),
+			bobgen.Accounts.Columns.ID.EQ(psql.Arg(id)),
+		)),
+	).Exec(ctx, w.exec)
 	return err
 }
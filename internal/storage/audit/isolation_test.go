@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aarondl/opt/null"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage/storagetest"
+)
+
+func TestLedgerIsolation(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	tests := map[string]func(ctx context.Context, exec bob.Executor) error{
+		"List": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).List(ctx, &RecordFilter{Entity: EntityAccount, EntityID: &id, Limit: 10})
+			return err
+		},
+		"GetByID": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).GetByID(ctx, id)
+			return err
+		},
+	}
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			storagetest.AssertLedgerScoped(t, call)
+		})
+	}
+}
+
+func TestWriter_Insert_TagsLedger(t *testing.T) {
+	ledgerID := uuid.Must(uuid.NewV4())
+	rec := &storagetest.Recorder{}
+	w := &Writer{Reader{exec: rec}}
+
+	err := w.Insert(requestctx.WithLedgerID(context.Background(), ledgerID), &RecordCreate{ActionType: "CreateAccount"})
+	assert.ErrorIs(t, err, storagetest.ErrNotExecuted)
+	require.Len(t, rec.Queries, 1)
+	assert.Contains(t, rec.Queries[0].Args, null.From(ledgerID))
+
+	// Actions outside any ledger, such as creating a user, are still audited.
+	rec.Queries = nil
+	err = w.Insert(context.Background(), &RecordCreate{ActionType: "CreateUser"})
+	assert.ErrorIs(t, err, storagetest.ErrNotExecuted)
+	require.Len(t, rec.Queries, 1)
+	assert.NotContains(t, rec.Queries[0].Args, null.From(ledgerID))
+}
@@ -0,0 +1,94 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/gofrs/uuid/v5"
+)
+
+// Entity names the kind of row a Change is about.
+type Entity string
+
+const (
+	EntityAccount     Entity = "account"
+	EntityTransaction Entity = "transaction"
+	EntityCategory    Entity = "category"
+	EntityWebhook     Entity = "webhook"
+	EntityUser        Entity = "user"
+	EntityToken       Entity = "token"
+	EntityLedger      Entity = "ledger"
+	// EntityLedgerMember changes are identified by the member's user ID;
+	// the ledger is the record's.
+	EntityLedgerMember Entity = "ledger_member"
+)
+
+// Entities lists every entity changes are recorded for.
+var Entities = []Entity{
+	EntityAccount, EntityTransaction, EntityCategory, EntityWebhook,
+	EntityUser, EntityToken, EntityLedger, EntityLedgerMember,
+}
+
+// Change is one row an action created, updated or deleted. Before is nil
+// for created rows and After is nil for deleted ones. Changes are stored as
+// JSON, so these tags are the storage format.
+type Change struct {
+	Entity   Entity          `json:"entity"`
+	EntityID uuid.UUID       `json:"entityID"`
+	Before   json.RawMessage `json:"before,omitempty"`
+	After    json.RawMessage `json:"after,omitempty"`
+}
+
+// Record is the audit entry for one committed action.
+type Record struct {
+	ID         uuid.UUID
+	ActionType string
+	Actor      string
+	RequestID  string
+	Changes    []Change
+	CreatedAt  time.Time
+	// Inverse is the encoded action that reverses this one, or nil when it
+	// cannot be undone.
+	Inverse json.RawMessage
+}
+
+// RecordCreate is the input for writing an audit record.
+type RecordCreate struct {
+	ActionType string
+	Actor      string
+	RequestID  string
+	Changes    []Change
+	Inverse    json.RawMessage
+	// LedgerID is the ledger the action changed when it is not the
+	// request's, such as a membership change; nil records the request's.
+	LedgerID *uuid.UUID
+}
+
+// RecordFilter selects audit records, newest first. With EntityID set only
+// records that changed that row of Entity are returned.
+type RecordFilter struct {
+	Entity    Entity
+	EntityID  *uuid.UUID
+	RequestID string
+	Limit     int
+}
+
+func bobRecordToRecord(row *bobgen.AuditRecord) (*Record, error) {
+	var changes []Change
+	if err := json.Unmarshal(row.Changes.Val, &changes); err != nil {
+		return nil, err
+	}
+	record := &Record{
+		ID:         row.ID,
+		ActionType: row.ActionType,
+		Actor:      row.Actor,
+		RequestID:  row.RequestID,
+		Changes:    changes,
+		CreatedAt:  row.CreatedAt,
+	}
+	if inverse, ok := row.Inverse.Get(); ok {
+		record.Inverse = inverse.Val
+	}
+	return record, nil
+}
@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+type Reader struct {
+	exec bob.Executor
+}
+
+func NewReader(exec bob.Executor) *Reader {
+	return &Reader{exec: exec}
+}
+
+// List returns up to filter.Limit of the request ledger's records matching
+// the filter, newest first.
+func (r *Reader) List(ctx context.Context, filter *RecordFilter) ([]*Record, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mods := []bob.Mod[*dialect.SelectQuery]{
+		sm.Where(bobgen.AuditRecords.Columns.LedgerID.EQ(psql.Arg(ledgerID))),
+		sm.OrderBy(bobgen.AuditRecords.Columns.CreatedAt).Desc(),
+		sm.OrderBy(bobgen.AuditRecords.Columns.ID).Desc(),
+		sm.Limit(filter.Limit),
+	}
+	if filter.RequestID != "" {
+		mods = append(mods, sm.Where(bobgen.AuditRecords.Columns.RequestID.EQ(psql.Arg(filter.RequestID))))
+	}
+	if filter.Entity != "" {
+		contains, err := changeContainment(filter)
+		if err != nil {
+			return nil, err
+		}
+		mods = append(mods, sm.Where(psql.Raw("? @> ?::jsonb", bobgen.AuditRecords.Columns.Changes, psql.Arg(contains))))
+	}
+
+	rows, err := bobgen.AuditRecords.Query(mods...).All(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*Record, len(rows))
+	for i, row := range rows {
+		if records[i], err = bobRecordToRecord(row); err != nil {
+			return nil, err
+		}
+	}
+	return records, nil
+}
+
+// GetByID returns the record, or sql.ErrNoRows when the request ledger has
+// none with that ID.
+func (r *Reader) GetByID(ctx context.Context, id uuid.UUID) (*Record, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := bobgen.AuditRecords.Query(
+		sm.Where(bobgen.AuditRecords.Columns.LedgerID.EQ(psql.Arg(ledgerID))),
+		sm.Where(bobgen.AuditRecords.Columns.ID.EQ(psql.Arg(id))),
+	).One(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+	return bobRecordToRecord(row)
+}
+
+// changeContainment builds the JSON array a record's changes must contain
+// to match the filter.
+func changeContainment(filter *RecordFilter) (string, error) {
+	change := map[string]any{"entity": filter.Entity}
+	if filter.EntityID != nil {
+		change["entityID"] = filter.EntityID
+	}
+	data, err := json.Marshal([]any{change})
+	return string(data), err
+}
@@ -0,0 +1,54 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/types"
+)
+
+type Writer struct {
+	Reader
+}
+
+func NewWriter(tx bob.Tx) *Writer {
+	return &Writer{
+		Reader: Reader{
+			exec: tx,
+		},
+	}
+}
+
+// Insert writes the record, tagged with create.LedgerID, or else the
+// request's ledger if it has one.
+func (w *Writer) Insert(ctx context.Context, create *RecordCreate) error {
+	changes := create.Changes
+	if changes == nil {
+		changes = []Change{}
+	}
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+	setter := &bobgen.AuditRecordSetter{
+		ActionType: omit.From(create.ActionType),
+		Actor:      omit.From(create.Actor),
+		RequestID:  omit.From(create.RequestID),
+		Changes:    omit.From(types.NewJSON[json.RawMessage](data)),
+	}
+	if create.LedgerID != nil {
+		setter.LedgerID = omitnull.From(*create.LedgerID)
+	} else if ledgerID, err := requestctx.LedgerID(ctx); err == nil {
+		setter.LedgerID = omitnull.From(ledgerID)
+	}
+	if create.Inverse != nil {
+		setter.Inverse = omitnull.From(types.NewJSON(create.Inverse))
+	}
+	_, err = bobgen.AuditRecords.Insert(setter).Exec(ctx, w.exec)
+	return err
+}
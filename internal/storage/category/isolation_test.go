@@ -0,0 +1,53 @@
+package category
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+
+	"github.com/carson-networks/budget-server/internal/storage/storagetest"
+)
+
+func TestLedgerIsolation(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	name := "Groceries"
+	tests := map[string]func(ctx context.Context, exec bob.Executor) error{
+		"List": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).List(ctx, &CategoryFilter{})
+			return err
+		},
+		"ListAll": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).ListAll(ctx, &CategoryFilter{ParentID: &id})
+			return err
+		},
+		"GetByID": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).GetByID(ctx, id)
+			return err
+		},
+		"HasChildren": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).HasChildren(ctx, id)
+			return err
+		},
+		"Create": func(ctx context.Context, exec bob.Executor) error {
+			_, err := (&Writer{Reader{exec: exec}}).Create(ctx, &CategoryCreate{Name: name, IsParent: true})
+			return err
+		},
+		"Restore": func(ctx context.Context, exec bob.Executor) error {
+			_, err := (&Writer{Reader{exec: exec}}).Restore(ctx, &Category{ID: id, Name: name, IsParent: true})
+			return err
+		},
+		"Update": func(ctx context.Context, exec bob.Executor) error {
+			return (&Writer{Reader{exec: exec}}).Update(ctx, id, &CategoryUpdate{Name: &name})
+		},
+		"Delete": func(ctx context.Context, exec bob.Executor) error {
+			return (&Writer{Reader{exec: exec}}).Delete(ctx, id)
+		},
+	}
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			storagetest.AssertLedgerScoped(t, call)
+		})
+	}
+}
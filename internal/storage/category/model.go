@@ -3,8 +3,10 @@ package category
 import (
 	"time"
 
+	"github.com/carson-networks/budget-server/internal/pagination"
 	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
 	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
 )
 
 type CategoryType int16
@@ -14,7 +16,8 @@ const (
 	CatergoryType_Expense
 )
 
-// Category represents a category record.
+// Category represents a category record. MonthlyBudget is the amount
+// planned per month for the category itself, or nil when it has none.
 type Category struct {
 	ID               uuid.UUID
 	Name             string
@@ -22,27 +25,23 @@ type Category struct {
 	ParentCategoryID *uuid.UUID
 	IsDisabled       bool
 	CategoryType     CategoryType
+	MonthlyBudget    *decimal.Decimal
 	CreatedAt        time.Time
 }
 
 // CategoryFilter specifies filters for listing categories.
+// Categories are ordered by (name, id); After resumes after that position.
 type CategoryFilter struct {
 	Limit      int
-	Offset     int
+	After      *pagination.NameKey
 	ParentID   *uuid.UUID
 	IsDisabled *bool
 }
 
-// CategoryCursor identifies a position in a paginated result set.
-type CategoryCursor struct {
-	Position int
-	Limit    int
-}
-
 // CategoryListResult contains a page of categories and an optional next cursor.
 type CategoryListResult struct {
 	Categories []*Category
-	NextCursor *CategoryCursor
+	NextCursor *pagination.Cursor[pagination.NameKey]
 }
 
 // CategoryCreate is the input for creating a category.
@@ -52,14 +51,18 @@ type CategoryCreate struct {
 	ParentCategoryID *uuid.UUID // required when IsParent is false; nil for root groups
 	IsDisabled       bool
 	CategoryType     CategoryType
+	MonthlyBudget    *decimal.Decimal
 }
 
 // CategoryUpdate is the input for updating a category (mutable fields only).
+// ClearMonthlyBudget removes the budget and takes precedence over MonthlyBudget.
 type CategoryUpdate struct {
-	Name             *string
-	ParentCategoryID *uuid.UUID
-	IsDisabled       *bool
-	CategoryType     *CategoryType
+	Name               *string
+	ParentCategoryID   *uuid.UUID
+	IsDisabled         *bool
+	CategoryType       *CategoryType
+	MonthlyBudget      *decimal.Decimal
+	ClearMonthlyBudget bool
 }
 
 func bobCategoryToCategory(row *bobgen.Category) *Category {
@@ -68,6 +71,11 @@ func bobCategoryToCategory(row *bobgen.Category) *Category {
 		id := row.ParentID.MustGet()
 		parentCategoryID = &id
 	}
+	var monthlyBudget *decimal.Decimal
+	if row.MonthlyBudget.IsValue() {
+		budget := row.MonthlyBudget.MustGet()
+		monthlyBudget = &budget
+	}
 	return &Category{
 		ID:               row.ID,
 		Name:             row.Name,
@@ -75,6 +83,11 @@ func bobCategoryToCategory(row *bobgen.Category) *Category {
 		ParentCategoryID: parentCategoryID,
 		IsDisabled:       row.IsDisabled,
 		CategoryType:     CategoryType(row.CategoryType),
+		MonthlyBudget:    monthlyBudget,
 		CreatedAt:        row.CreatedAt,
 	}
 }
+
+func categoryNameKey(c *Category) pagination.NameKey {
+	return pagination.NameKey{Name: c.Name, ID: c.ID}
+}
@@ -3,6 +3,8 @@ package category
 import (
 	"context"
 
+	"github.com/carson-networks/budget-server/internal/pagination"
+	"github.com/carson-networks/budget-server/internal/requestctx"
 	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
 	"github.com/gofrs/uuid/v5"
 	"github.com/stephenafamo/bob"
@@ -20,34 +22,28 @@ func NewReader(exec bob.Executor) *Reader {
 	return &Reader{exec: exec}
 }
 
+// List returns a page of the request ledger's categories.
 func (r *Reader) List(ctx context.Context, filter *CategoryFilter) (*CategoryListResult, error) {
-	limit := 20
-	offset := 0
-	if filter != nil {
-		if filter.Limit > 0 {
-			limit = filter.Limit
-		}
-		offset = filter.Offset
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	var queryMods []bob.Mod[*dialect.SelectQuery]
+	limit := pagination.DefaultLimit
+	whereMods := []mods.Where[*dialect.SelectQuery]{bobgen.SelectWhere.Categories.LedgerID.EQ(ledgerID)}
 	if filter != nil {
-		var whereMods []mods.Where[*dialect.SelectQuery]
-		if filter.ParentID != nil {
-			whereMods = append(whereMods, bobgen.SelectWhere.Categories.ParentID.EQ(*filter.ParentID))
-		}
-		if filter.IsDisabled != nil {
-			whereMods = append(whereMods, bobgen.SelectWhere.Categories.IsDisabled.EQ(*filter.IsDisabled))
-		}
-		if len(whereMods) == 1 {
-			queryMods = append(queryMods, whereMods[0])
-		} else if len(whereMods) > 1 {
-			queryMods = append(queryMods, psql.WhereAnd(whereMods...))
+		limit = pagination.Limit(filter.Limit)
+		whereMods = append(whereMods, filterWhereMods(filter)...)
+		if filter.After != nil {
+			whereMods = append(whereMods, sm.Where(
+				psql.Group(bobgen.Categories.Columns.Name, bobgen.Categories.Columns.ID).
+					GT(psql.ArgGroup(filter.After.Name, filter.After.ID)),
+			))
 		}
 	}
+	queryMods := combineWhereMods(whereMods)
 	queryMods = append(queryMods,
 		sm.Limit(limit+1),
-		sm.Offset(offset),
 		sm.OrderBy(bobgen.Categories.Columns.Name).Asc(),
 		sm.OrderBy(bobgen.Categories.Columns.ID).Asc(),
 	)
@@ -61,26 +57,91 @@ func (r *Reader) List(ctx context.Context, filter *CategoryFilter) (*CategoryLis
 		return &CategoryListResult{Categories: nil, NextCursor: nil}, nil
 	}
 
-	var nextCursor *CategoryCursor
-	if len(rows) > limit {
-		rows = rows[:limit]
-		nextCursor = &CategoryCursor{
-			Position: offset + limit,
-			Limit:    limit,
-		}
+	result := make([]*Category, len(rows))
+	for i, row := range rows {
+		result[i] = bobCategoryToCategory(row)
+	}
+	result, nextCursor := pagination.NextPage(result, limit, categoryNameKey)
+	return &CategoryListResult{Categories: result, NextCursor: nextCursor}, nil
+}
+
+// ListAll returns every category in the request ledger matching the filter
+// ordered by name, ignoring pagination. Categories are a small, bounded set so
+// callers that need the whole hierarchy (e.g. the tree endpoint) can load it
+// in one query.
+func (r *Reader) ListAll(ctx context.Context, filter *CategoryFilter) ([]*Category, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	whereMods := []mods.Where[*dialect.SelectQuery]{bobgen.SelectWhere.Categories.LedgerID.EQ(ledgerID)}
+	if filter != nil {
+		whereMods = append(whereMods, filterWhereMods(filter)...)
+	}
+	queryMods := combineWhereMods(whereMods)
+	queryMods = append(queryMods,
+		sm.OrderBy(bobgen.Categories.Columns.Name).Asc(),
+		sm.OrderBy(bobgen.Categories.Columns.ID).Asc(),
+	)
+
+	rows, err := bobgen.Categories.Query(queryMods...).All(ctx, r.exec)
+	if err != nil {
+		return nil, err
 	}
 
 	result := make([]*Category, len(rows))
 	for i, row := range rows {
 		result[i] = bobCategoryToCategory(row)
 	}
-	return &CategoryListResult{Categories: result, NextCursor: nextCursor}, nil
+	return result, nil
+}
+
+func filterWhereMods(filter *CategoryFilter) []mods.Where[*dialect.SelectQuery] {
+	var whereMods []mods.Where[*dialect.SelectQuery]
+	if filter.ParentID != nil {
+		whereMods = append(whereMods, bobgen.SelectWhere.Categories.ParentID.EQ(*filter.ParentID))
+	}
+	if filter.IsDisabled != nil {
+		whereMods = append(whereMods, bobgen.SelectWhere.Categories.IsDisabled.EQ(*filter.IsDisabled))
+	}
+	return whereMods
 }
 
+func combineWhereMods(whereMods []mods.Where[*dialect.SelectQuery]) []bob.Mod[*dialect.SelectQuery] {
+	if len(whereMods) == 1 {
+		return []bob.Mod[*dialect.SelectQuery]{whereMods[0]}
+	} else if len(whereMods) > 1 {
+		return []bob.Mod[*dialect.SelectQuery]{psql.WhereAnd(whereMods...)}
+	}
+	return nil
+}
+
+// GetByID returns the category, or sql.ErrNoRows when the request ledger
+// has none with that ID.
 func (r *Reader) GetByID(ctx context.Context, id uuid.UUID) (*Category, error) {
-	row, err := bobgen.FindCategory(ctx, r.exec, id)
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := bobgen.Categories.Query(
+		bobgen.SelectWhere.Categories.LedgerID.EQ(ledgerID),
+		bobgen.SelectWhere.Categories.ID.EQ(id),
+	).One(ctx, r.exec)
 	if err != nil {
 		return nil, err
 	}
 	return bobCategoryToCategory(row), nil
 }
+
+// HasChildren reports whether any category has id as its parent.
+func (r *Reader) HasChildren(ctx context.Context, id uuid.UUID) (bool, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return false, err
+	}
+	return bobgen.Categories.Query(
+		bobgen.SelectWhere.Categories.LedgerID.EQ(ledgerID),
+		bobgen.SelectWhere.Categories.ParentID.EQ(id),
+	).Exists(ctx, r.exec)
+}
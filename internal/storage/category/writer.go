@@ -6,48 +6,86 @@ import (
 
 	"github.com/aarondl/opt/omit"
 	"github.com/aarondl/opt/omitnull"
+	"github.com/carson-networks/budget-server/internal/requestctx"
 	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
 	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
 	"github.com/stephenafamo/bob/dialect/psql/um"
 )
 
+// Writer writes the request ledger's categories. Its queries go through the
+// embedded Reader's executor, which is the transaction.
 type Writer struct {
-	tx bob.Tx
 	Reader
 }
 
 func NewWriter(tx bob.Tx) *Writer {
 	return &Writer{
-		tx: tx,
 		Reader: Reader{
 			exec: tx,
 		},
 	}
 }
 
-func (w *Writer) Create(ctx context.Context, create *CategoryCreate) error {
+func (w *Writer) Create(ctx context.Context, create *CategoryCreate) (*Category, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	setter := &bobgen.CategorySetter{
 		Name:             omit.From(create.Name),
 		IsGroup:          omit.From(create.IsParent),
 		ShouldBeBudgeted: omit.From(true),
 		IsDisabled:       omit.From(create.IsDisabled),
 		CategoryType:     omit.From(int16(create.CategoryType)),
+		MonthlyBudget:    omitnull.FromPtr(create.MonthlyBudget),
+		LedgerID:         omit.From(ledgerID),
 	}
 	if create.ParentCategoryID != nil {
 		setter.ParentID = omitnull.From(*create.ParentCategoryID)
 	} else if create.ParentCategoryID == nil && create.IsParent == false {
-		return errors.New("parentID must be set if IsParent is false")
+		return nil, errors.New("parentID must be set if IsParent is false")
 	}
-	_, err := bobgen.Categories.Insert(setter).One(ctx, w.tx)
+	row, err := bobgen.Categories.Insert(setter).One(ctx, w.exec)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	return bobCategoryToCategory(row), nil
+}
+
+// Restore re-inserts a deleted category with its original ID and attributes.
+func (w *Writer) Restore(ctx context.Context, cat *Category) (*Category, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	setter := &bobgen.CategorySetter{
+		ID:               omit.From(cat.ID),
+		Name:             omit.From(cat.Name),
+		IsGroup:          omit.From(cat.IsParent),
+		ShouldBeBudgeted: omit.From(true),
+		IsDisabled:       omit.From(cat.IsDisabled),
+		CategoryType:     omit.From(int16(cat.CategoryType)),
+		ParentID:         omitnull.FromPtr(cat.ParentCategoryID),
+		MonthlyBudget:    omitnull.FromPtr(cat.MonthlyBudget),
+		CreatedAt:        omit.From(cat.CreatedAt),
+		LedgerID:         omit.From(ledgerID),
+	}
+	row, err := bobgen.Categories.Insert(setter).One(ctx, w.exec)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return bobCategoryToCategory(row), nil
 }
 
 func (w *Writer) Update(ctx context.Context, id uuid.UUID, update *CategoryUpdate) error {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return err
+	}
 	setter := bobgen.CategorySetter{}
 	if update.Name != nil {
 		setter.Name = omit.From(*update.Name)
@@ -61,12 +99,34 @@ func (w *Writer) Update(ctx context.Context, id uuid.UUID, update *CategoryUpdat
 	if update.CategoryType != nil {
 		setter.CategoryType = omit.From(int16(*update.CategoryType))
 	}
+	if update.ClearMonthlyBudget {
+		setter.MonthlyBudget = omitnull.FromPtr[decimal.Decimal](nil)
+	} else if update.MonthlyBudget != nil {
+		setter.MonthlyBudget = omitnull.From(*update.MonthlyBudget)
+	}
 	if len(setter.SetColumns()) == 0 {
 		return nil
 	}
-	_, err := bobgen.Categories.Update(
+	_, err = bobgen.Categories.Update(
 		setter.UpdateMod(),
-		um.Where(bobgen.Categories.Columns.ID.EQ(psql.Arg(id))),
-	).Exec(ctx, w.tx)
+		um.Where(psql.And(
+			bobgen.Categories.Columns.LedgerID.EQ(psql.Arg(ledgerID)),
+			bobgen.Categories.Columns.ID.EQ(psql.Arg(id)),
+		)),
+	).Exec(ctx, w.exec)
+	return err
+}
+
+func (w *Writer) Delete(ctx context.Context, id uuid.UUID) error {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = bobgen.Categories.Delete(
+		dm.Where(psql.And(
+			bobgen.Categories.Columns.LedgerID.EQ(psql.Arg(ledgerID)),
+			bobgen.Categories.Columns.ID.EQ(psql.Arg(id)),
+		)),
+	).Exec(ctx, w.exec)
 	return err
 }
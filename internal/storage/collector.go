@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// totalsTimeout bounds the count queries run on each scrape.
+const totalsTimeout = 2 * time.Second
+
+// totalsQuery counts rows across every ledger; it runs outside any
+// request, so it is not ledger-scoped.
+const totalsQuery = `SELECT
+	(SELECT count(*) FROM ledgers),
+	(SELECT count(*) FROM accounts),
+	(SELECT count(*) FROM transactions)`
+
+var (
+	ledgersDesc = prometheus.NewDesc("budget_ledgers",
+		"Ledgers across all users.", nil, nil)
+	accountsDesc = prometheus.NewDesc("budget_accounts",
+		"Accounts across all ledgers.", nil, nil)
+	transactionsDesc = prometheus.NewDesc("budget_transactions",
+		"Transactions across all ledgers.", nil, nil)
+)
+
+// totalsCollector reports row counts on each scrape.
+type totalsCollector struct {
+	storage *Storage
+}
+
+func (c totalsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- ledgersDesc
+	ch <- accountsDesc
+	ch <- transactionsDesc
+}
+
+func (c totalsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), totalsTimeout)
+	defer cancel()
+
+	var ledgers, accounts, transactions int64
+	err := c.storage.sql.QueryRowContext(ctx, totalsQuery).Scan(&ledgers, &accounts, &transactions)
+	if err != nil {
+		for _, desc := range []*prometheus.Desc{ledgersDesc, accountsDesc, transactionsDesc} {
+			ch <- prometheus.NewInvalidMetric(desc, err)
+		}
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(ledgersDesc, prometheus.GaugeValue, float64(ledgers))
+	ch <- prometheus.MustNewConstMetric(accountsDesc, prometheus.GaugeValue, float64(accounts))
+	ch <- prometheus.MustNewConstMetric(transactionsDesc, prometheus.GaugeValue, float64(transactions))
+}
+
+// Collectors returns Prometheus collectors for the connection pool and for
+// ledger, account and transaction totals.
+func (s *Storage) Collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		collectors.NewDBStatsCollector(s.sql.DB, "budget"),
+		totalsCollector{storage: s},
+	}
+}
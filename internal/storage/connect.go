@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/carson-networks/budget-server/internal/config"
+)
+
+const (
+	firstPingBackoff = 250 * time.Millisecond
+	maxPingBackoff   = 5 * time.Second
+)
+
+// Open returns a pool for the configured database, tuned by the Postgres*
+// pool settings. It waits up to PostgresConnectTimeout for Postgres to
+// accept connections, so the server can start alongside its database.
+func Open(ctx context.Context, env *config.Config) (*sql.DB, error) {
+	connector, err := pq.NewConnector(env.PostgresConnString())
+	if err != nil {
+		return nil, fmt.Errorf("postgres connection settings: %w", err)
+	}
+
+	var db *sql.DB
+	if env.PostgresStatementTimeout > 0 {
+		db = sql.OpenDB(&timeoutConnector{Connector: connector, statementTimeout: env.PostgresStatementTimeout})
+	} else {
+		db = sql.OpenDB(connector)
+	}
+	db.SetMaxOpenConns(env.PostgresMaxOpenConns)
+	db.SetMaxIdleConns(env.PostgresMaxIdleConns)
+	db.SetConnMaxLifetime(env.PostgresConnMaxLifetime)
+
+	pingCtx, cancel := context.WithTimeout(ctx, env.PostgresConnectTimeout)
+	defer cancel()
+	if err := pingUntilReady(pingCtx, db.PingContext, firstPingBackoff); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("postgres not reachable within %s: %w", env.PostgresConnectTimeout, err)
+	}
+	return db, nil
+}
+
+// pingUntilReady calls ping until it succeeds, backing off exponentially
+// from backoff, and returns the last error once ctx ends.
+func pingUntilReady(ctx context.Context, ping func(context.Context) error, backoff time.Duration) error {
+	for {
+		err := ping(ctx)
+		if err == nil {
+			return nil
+		}
+		logrus.WithError(err).WithField("retryIn", backoff.String()).Warn("Storage.Open: postgres not ready")
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxPingBackoff)
+	}
+}
+
+// timeoutConnector sets statement_timeout on every connection it opens.
+type timeoutConnector struct {
+	driver.Connector
+	statementTimeout time.Duration
+}
+
+func (c *timeoutConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	execer, ok := conn.(driver.ExecerContext)
+	if !ok {
+		_ = conn.Close()
+		return nil, errors.New("postgres driver connection cannot execute statements")
+	}
+	set := fmt.Sprintf("SET statement_timeout = %d", c.statementTimeout.Milliseconds())
+	if _, err := execer.ExecContext(ctx, set, nil); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("set statement_timeout: %w", err)
+	}
+	return conn, nil
+}
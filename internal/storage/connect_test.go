@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPingUntilReady_RetriesUntilUp(t *testing.T) {
+	calls := 0
+	ping := func(context.Context) error {
+		calls++
+		if calls < 3 {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+
+	err := pingUntilReady(context.Background(), ping, time.Millisecond)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestPingUntilReady_GivesUpWithLastError(t *testing.T) {
+	down := errors.New("connection refused")
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := pingUntilReady(ctx, func(context.Context) error { return down }, time.Millisecond)
+
+	assert.ErrorIs(t, err, down)
+}
+
+type fakeConn struct {
+	driver.Conn
+	execErr error
+	execs   []string
+	closed  bool
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.execs = append(c.execs, query)
+	return driver.RowsAffected(0), c.execErr
+}
+
+func (c *fakeConn) Close() error {
+	c.closed = true
+	return nil
+}
+
+type fakeConnector struct {
+	driver.Connector
+	conn *fakeConn
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.conn, nil
+}
+
+func TestTimeoutConnector_SetsStatementTimeout(t *testing.T) {
+	conn := &fakeConn{}
+	connector := &timeoutConnector{Connector: &fakeConnector{conn: conn}, statementTimeout: 1500 * time.Millisecond}
+
+	got, err := connector.Connect(context.Background())
+
+	require.NoError(t, err)
+	assert.Same(t, conn, got)
+	assert.Equal(t, []string{"SET statement_timeout = 1500"}, conn.execs)
+	assert.False(t, conn.closed)
+}
+
+func TestTimeoutConnector_ClosesOnFailure(t *testing.T) {
+	conn := &fakeConn{execErr: errors.New("permission denied")}
+	connector := &timeoutConnector{Connector: &fakeConnector{conn: conn}, statementTimeout: time.Second}
+
+	_, err := connector.Connect(context.Background())
+
+	assert.ErrorIs(t, err, conn.execErr)
+	assert.True(t, conn.closed)
+}
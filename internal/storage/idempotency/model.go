@@ -0,0 +1,34 @@
+package idempotency
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+)
+
+// ErrKeyExists is returned by Insert when another transaction has already
+// stored the key.
+var ErrKeyExists = errors.New("idempotency key already exists")
+
+// Record is the stored outcome of a request made with an Idempotency-Key.
+// Keys are unique within a Scope, which names the ledger or user the request
+// was made for, so clients cannot collide with each other's keys.
+type Record struct {
+	Scope       string
+	Key         string
+	RequestHash []byte
+	Response    json.RawMessage
+	CreatedAt   time.Time
+}
+
+func bobIdempotencyKeyToRecord(row *bobgen.IdempotencyKey) *Record {
+	return &Record{
+		Scope:       row.Scope,
+		Key:         row.Key,
+		RequestHash: row.RequestHash,
+		Response:    row.Response.Val,
+		CreatedAt:   row.CreatedAt,
+	}
+}
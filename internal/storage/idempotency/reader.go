@@ -0,0 +1,26 @@
+package idempotency
+
+import (
+	"context"
+
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/stephenafamo/bob"
+)
+
+type Reader struct {
+	exec bob.Executor
+}
+
+func NewReader(exec bob.Executor) *Reader {
+	return &Reader{exec: exec}
+}
+
+// Get returns the record stored for key within scope, or sql.ErrNoRows when
+// there is none.
+func (r *Reader) Get(ctx context.Context, scope, key string) (*Record, error) {
+	row, err := bobgen.FindIdempotencyKey(ctx, r.exec, scope, key)
+	if err != nil {
+		return nil, err
+	}
+	return bobIdempotencyKeyToRecord(row), nil
+}
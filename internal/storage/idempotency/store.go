@@ -0,0 +1,29 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+)
+
+// Store is used by the expiry sweep, outside of any action's transaction,
+// to drop records in every scope once they are too old to be replayed.
+type Store struct {
+	exec bob.Executor
+}
+
+func NewStore(exec bob.Executor) *Store {
+	return &Store{exec: exec}
+}
+
+// DeleteBefore removes every record stored before cutoff and returns how
+// many were removed.
+func (s *Store) DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	return bobgen.IdempotencyKeys.Delete(
+		dm.Where(bobgen.IdempotencyKeys.Columns.CreatedAt.LT(psql.Arg(cutoff))),
+	).Exec(ctx, s.exec)
+}
@@ -0,0 +1,44 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen/dberrors"
+	"github.com/lib/pq"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/types"
+)
+
+type Writer struct {
+	tx bob.Tx
+	Reader
+}
+
+func NewWriter(tx bob.Tx) *Writer {
+	return &Writer{
+		tx: tx,
+		Reader: Reader{
+			exec: tx,
+		},
+	}
+}
+
+// Insert stores a record. It returns ErrKeyExists when the key was stored in
+// the same scope by a concurrent transaction that committed first.
+func (w *Writer) Insert(ctx context.Context, record *Record) error {
+	setter := &bobgen.IdempotencyKeySetter{
+		Scope:       omit.From(record.Scope),
+		Key:         omit.From(record.Key),
+		RequestHash: omit.From(record.RequestHash),
+		Response:    omit.From(types.NewJSON(record.Response)),
+	}
+	_, err := bobgen.IdempotencyKeys.Insert(setter).Exec(ctx, w.tx)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && dberrors.IdempotencyKeyErrors.ErrUniqueIdempotencyKeysPkey.Is(pqErr) {
+		return ErrKeyExists
+	}
+	return err
+}
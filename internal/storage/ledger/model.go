@@ -0,0 +1,97 @@
+package ledger
+
+import (
+	"time"
+
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/gofrs/uuid/v5"
+)
+
+// Ledger is a household's books. Accounts, categories, transactions and
+// webhooks each belong to exactly one ledger.
+type Ledger struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
+// Role is what a member may do in a ledger. Each role may do everything the
+// roles before it may.
+type Role int16
+
+const (
+	// RoleViewer members may read the ledger.
+	RoleViewer Role = iota
+	// RoleEditor members may also change it.
+	RoleEditor
+	// RoleOwner members may also manage its members.
+	RoleOwner
+)
+
+// Roles lists every role, least privileged first.
+var Roles = []Role{RoleViewer, RoleEditor, RoleOwner}
+
+// Allows reports whether a member with role r may do what required needs.
+func (r Role) Allows(required Role) bool {
+	return r >= required
+}
+
+func (r Role) String() string {
+	switch r {
+	case RoleViewer:
+		return "viewer"
+	case RoleEditor:
+		return "editor"
+	case RoleOwner:
+		return "owner"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseRole returns the role named s.
+func ParseRole(s string) (Role, bool) {
+	for _, role := range Roles {
+		if role.String() == s {
+			return role, true
+		}
+	}
+	return 0, false
+}
+
+// Membership grants a user a role in a ledger.
+type Membership struct {
+	LedgerID  uuid.UUID
+	UserID    uuid.UUID
+	Role      Role
+	CreatedAt time.Time
+}
+
+// Member is a ledger's membership along with the member's username.
+type Member struct {
+	Membership
+	Username string
+}
+
+// UserLedger is a ledger along with the user's role in it.
+type UserLedger struct {
+	Ledger
+	Role Role
+}
+
+func bobLedgerToLedger(row *bobgen.Ledger) *Ledger {
+	return &Ledger{
+		ID:        row.ID,
+		Name:      row.Name,
+		CreatedAt: row.CreatedAt,
+	}
+}
+
+func bobMemberToMembership(row *bobgen.LedgerMember) *Membership {
+	return &Membership{
+		LedgerID:  row.LedgerID,
+		UserID:    row.UserID,
+		Role:      Role(row.Role),
+		CreatedAt: row.CreatedAt,
+	}
+}
@@ -0,0 +1,76 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+type Reader struct {
+	exec bob.Executor
+}
+
+func NewReader(exec bob.Executor) *Reader {
+	return &Reader{exec: exec}
+}
+
+// GetMembership returns the user's membership of the ledger, or
+// sql.ErrNoRows when they are not a member.
+func (r *Reader) GetMembership(ctx context.Context, ledgerID uuid.UUID, userID uuid.UUID) (*Membership, error) {
+	row, err := bobgen.FindLedgerMember(ctx, r.exec, ledgerID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return bobMemberToMembership(row), nil
+}
+
+// ListForUser returns the ledgers the user is a member of, in the order they
+// joined them.
+func (r *Reader) ListForUser(ctx context.Context, userID uuid.UUID) ([]*UserLedger, error) {
+	rows, err := bobgen.LedgerMembers.Query(
+		bobgen.Preload.LedgerMember.Ledger(),
+		sm.Where(bobgen.LedgerMembers.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.OrderBy(bobgen.LedgerMembers.Columns.CreatedAt),
+		sm.OrderBy(bobgen.LedgerMembers.Columns.LedgerID),
+	).All(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+
+	ledgers := make([]*UserLedger, len(rows))
+	for i, row := range rows {
+		ledgers[i] = &UserLedger{Ledger: *bobLedgerToLedger(row.R.Ledger), Role: Role(row.Role)}
+	}
+	return ledgers, nil
+}
+
+// ListMembers returns the ledger's members in the order they joined.
+func (r *Reader) ListMembers(ctx context.Context, ledgerID uuid.UUID) ([]*Member, error) {
+	rows, err := bobgen.LedgerMembers.Query(
+		bobgen.Preload.LedgerMember.User(),
+		sm.Where(bobgen.LedgerMembers.Columns.LedgerID.EQ(psql.Arg(ledgerID))),
+		sm.OrderBy(bobgen.LedgerMembers.Columns.CreatedAt),
+		sm.OrderBy(bobgen.LedgerMembers.Columns.UserID),
+	).All(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*Member, len(rows))
+	for i, row := range rows {
+		members[i] = &Member{Membership: *bobMemberToMembership(row), Username: row.R.User.Username}
+	}
+	return members, nil
+}
+
+// CountOwners returns how many owners the ledger has.
+func (r *Reader) CountOwners(ctx context.Context, ledgerID uuid.UUID) (int64, error) {
+	return bobgen.LedgerMembers.Query(
+		sm.Where(bobgen.LedgerMembers.Columns.LedgerID.EQ(psql.Arg(ledgerID))),
+		sm.Where(bobgen.LedgerMembers.Columns.Role.EQ(psql.Arg(int16(RoleOwner)))),
+	).Count(ctx, r.exec)
+}
@@ -0,0 +1,86 @@
+package ledger
+
+import (
+	"context"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+type Writer struct {
+	Reader
+}
+
+func NewWriter(tx bob.Tx) *Writer {
+	return &Writer{
+		Reader: Reader{
+			exec: tx,
+		},
+	}
+}
+
+// Create inserts a ledger with no members.
+func (w *Writer) Create(ctx context.Context, name string) (*Ledger, error) {
+	row, err := bobgen.Ledgers.Insert(&bobgen.LedgerSetter{
+		Name: omit.From(name),
+	}).One(ctx, w.exec)
+	if err != nil {
+		return nil, err
+	}
+	return bobLedgerToLedger(row), nil
+}
+
+// SetMember adds the user to the ledger with role, or changes their role if
+// they are already a member.
+func (w *Writer) SetMember(ctx context.Context, ledgerID uuid.UUID, userID uuid.UUID, role Role) (*Membership, error) {
+	row, err := bobgen.LedgerMembers.Insert(
+		&bobgen.LedgerMemberSetter{
+			LedgerID: omit.From(ledgerID),
+			UserID:   omit.From(userID),
+			Role:     omit.From(int16(role)),
+		},
+		im.OnConflict("ledger_id", "user_id").DoUpdate(im.SetExcluded("role")),
+	).One(ctx, w.exec)
+	if err != nil {
+		return nil, err
+	}
+	return bobMemberToMembership(row), nil
+}
+
+// RemoveMember removes the user from the ledger and reports whether they
+// were a member.
+func (w *Writer) RemoveMember(ctx context.Context, ledgerID uuid.UUID, userID uuid.UUID) (bool, error) {
+	deleted, err := bobgen.LedgerMembers.Delete(
+		dm.Where(bobgen.LedgerMembers.Columns.LedgerID.EQ(psql.Arg(ledgerID))),
+		dm.Where(bobgen.LedgerMembers.Columns.UserID.EQ(psql.Arg(userID))),
+	).Exec(ctx, w.exec)
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+// ClaimUnowned makes the user the owner of every ledger that has no members,
+// such as the one holding data recorded before any user existed, and returns
+// the memberships it created.
+func (w *Writer) ClaimUnowned(ctx context.Context, userID uuid.UUID) ([]*Membership, error) {
+	rows, err := bobgen.Ledgers.Query(
+		sm.Where(psql.Raw("NOT EXISTS (SELECT 1 FROM ledger_members WHERE ledger_members.ledger_id = ?)", bobgen.Ledgers.Columns.ID)),
+	).All(ctx, w.exec)
+	if err != nil {
+		return nil, err
+	}
+	claimed := make([]*Membership, len(rows))
+	for i, row := range rows {
+		if claimed[i], err = w.SetMember(ctx, row.ID, userID, RoleOwner); err != nil {
+			return nil, err
+		}
+	}
+	return claimed, nil
+}
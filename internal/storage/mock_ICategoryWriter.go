@@ -26,21 +26,33 @@ func (_m *MockICategoryWriter) EXPECT() *MockICategoryWriter_Expecter {
 }
 
 // Create provides a mock function with given fields: ctx, create
-func (_m *MockICategoryWriter) Create(ctx context.Context, create *category.CategoryCreate) error {
+func (_m *MockICategoryWriter) Create(ctx context.Context, create *category.CategoryCreate) (*category.Category, error) {
 	ret := _m.Called(ctx, create)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *category.CategoryCreate) error); ok {
+	var r0 *category.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *category.CategoryCreate) (*category.Category, error)); ok {
+		return rf(ctx, create)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *category.CategoryCreate) *category.Category); ok {
 		r0 = rf(ctx, create)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.Category)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *category.CategoryCreate) error); ok {
+		r1 = rf(ctx, create)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // MockICategoryWriter_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
@@ -62,12 +74,59 @@ func (_c *MockICategoryWriter_Create_Call) Run(run func(ctx context.Context, cre
 	return _c
 }
 
-func (_c *MockICategoryWriter_Create_Call) Return(_a0 error) *MockICategoryWriter_Create_Call {
+func (_c *MockICategoryWriter_Create_Call) Return(_a0 *category.Category, _a1 error) *MockICategoryWriter_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockICategoryWriter_Create_Call) RunAndReturn(run func(context.Context, *category.CategoryCreate) (*category.Category, error)) *MockICategoryWriter_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockICategoryWriter) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockICategoryWriter_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockICategoryWriter_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockICategoryWriter_Expecter) Delete(ctx interface{}, id interface{}) *MockICategoryWriter_Delete_Call {
+	return &MockICategoryWriter_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockICategoryWriter_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockICategoryWriter_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockICategoryWriter_Delete_Call) Return(_a0 error) *MockICategoryWriter_Delete_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockICategoryWriter_Create_Call) RunAndReturn(run func(context.Context, *category.CategoryCreate) error) *MockICategoryWriter_Create_Call {
+func (_c *MockICategoryWriter_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockICategoryWriter_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -131,6 +190,181 @@ func (_c *MockICategoryWriter_GetByID_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// HasChildren provides a mock function with given fields: ctx, id
+func (_m *MockICategoryWriter) HasChildren(ctx context.Context, id uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasChildren")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockICategoryWriter_HasChildren_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasChildren'
+type MockICategoryWriter_HasChildren_Call struct {
+	*mock.Call
+}
+
+// HasChildren is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockICategoryWriter_Expecter) HasChildren(ctx interface{}, id interface{}) *MockICategoryWriter_HasChildren_Call {
+	return &MockICategoryWriter_HasChildren_Call{Call: _e.mock.On("HasChildren", ctx, id)}
+}
+
+func (_c *MockICategoryWriter_HasChildren_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockICategoryWriter_HasChildren_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockICategoryWriter_HasChildren_Call) Return(_a0 bool, _a1 error) *MockICategoryWriter_HasChildren_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockICategoryWriter_HasChildren_Call) RunAndReturn(run func(context.Context, uuid.UUID) (bool, error)) *MockICategoryWriter_HasChildren_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAll provides a mock function with given fields: ctx, filter
+func (_m *MockICategoryWriter) ListAll(ctx context.Context, filter *category.CategoryFilter) ([]*category.Category, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAll")
+	}
+
+	var r0 []*category.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *category.CategoryFilter) ([]*category.Category, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *category.CategoryFilter) []*category.Category); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*category.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *category.CategoryFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockICategoryWriter_ListAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAll'
+type MockICategoryWriter_ListAll_Call struct {
+	*mock.Call
+}
+
+// ListAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *category.CategoryFilter
+func (_e *MockICategoryWriter_Expecter) ListAll(ctx interface{}, filter interface{}) *MockICategoryWriter_ListAll_Call {
+	return &MockICategoryWriter_ListAll_Call{Call: _e.mock.On("ListAll", ctx, filter)}
+}
+
+func (_c *MockICategoryWriter_ListAll_Call) Run(run func(ctx context.Context, filter *category.CategoryFilter)) *MockICategoryWriter_ListAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*category.CategoryFilter))
+	})
+	return _c
+}
+
+func (_c *MockICategoryWriter_ListAll_Call) Return(_a0 []*category.Category, _a1 error) *MockICategoryWriter_ListAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockICategoryWriter_ListAll_Call) RunAndReturn(run func(context.Context, *category.CategoryFilter) ([]*category.Category, error)) *MockICategoryWriter_ListAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, cat
+func (_m *MockICategoryWriter) Restore(ctx context.Context, cat *category.Category) (*category.Category, error) {
+	ret := _m.Called(ctx, cat)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 *category.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *category.Category) (*category.Category, error)); ok {
+		return rf(ctx, cat)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *category.Category) *category.Category); ok {
+		r0 = rf(ctx, cat)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*category.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *category.Category) error); ok {
+		r1 = rf(ctx, cat)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockICategoryWriter_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockICategoryWriter_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cat *category.Category
+func (_e *MockICategoryWriter_Expecter) Restore(ctx interface{}, cat interface{}) *MockICategoryWriter_Restore_Call {
+	return &MockICategoryWriter_Restore_Call{Call: _e.mock.On("Restore", ctx, cat)}
+}
+
+func (_c *MockICategoryWriter_Restore_Call) Run(run func(ctx context.Context, cat *category.Category)) *MockICategoryWriter_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*category.Category))
+	})
+	return _c
+}
+
+func (_c *MockICategoryWriter_Restore_Call) Return(_a0 *category.Category, _a1 error) *MockICategoryWriter_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockICategoryWriter_Restore_Call) RunAndReturn(run func(context.Context, *category.Category) (*category.Category, error)) *MockICategoryWriter_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, id, update
 func (_m *MockICategoryWriter) Update(ctx context.Context, id uuid.UUID, update *category.CategoryUpdate) error {
 	ret := _m.Called(ctx, id, update)
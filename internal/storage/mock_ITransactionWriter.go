@@ -24,24 +24,187 @@ func (_m *MockITransactionWriter) EXPECT() *MockITransactionWriter_Expecter {
 	return &MockITransactionWriter_Expecter{mock: &_m.Mock}
 }
 
+// Delete provides a mock function with given fields: ctx, id
+func (_m *MockITransactionWriter) Delete(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockITransactionWriter_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockITransactionWriter_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockITransactionWriter_Expecter) Delete(ctx interface{}, id interface{}) *MockITransactionWriter_Delete_Call {
+	return &MockITransactionWriter_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockITransactionWriter_Delete_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockITransactionWriter_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockITransactionWriter_Delete_Call) Return(_a0 error) *MockITransactionWriter_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockITransactionWriter_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MockITransactionWriter_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExistsForCategory provides a mock function with given fields: ctx, categoryID
+func (_m *MockITransactionWriter) ExistsForCategory(ctx context.Context, categoryID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistsForCategory")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, categoryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(ctx, categoryID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockITransactionWriter_ExistsForCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistsForCategory'
+type MockITransactionWriter_ExistsForCategory_Call struct {
+	*mock.Call
+}
+
+// ExistsForCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - categoryID uuid.UUID
+func (_e *MockITransactionWriter_Expecter) ExistsForCategory(ctx interface{}, categoryID interface{}) *MockITransactionWriter_ExistsForCategory_Call {
+	return &MockITransactionWriter_ExistsForCategory_Call{Call: _e.mock.On("ExistsForCategory", ctx, categoryID)}
+}
+
+func (_c *MockITransactionWriter_ExistsForCategory_Call) Run(run func(ctx context.Context, categoryID uuid.UUID)) *MockITransactionWriter_ExistsForCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockITransactionWriter_ExistsForCategory_Call) Return(_a0 bool, _a1 error) *MockITransactionWriter_ExistsForCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockITransactionWriter_ExistsForCategory_Call) RunAndReturn(run func(context.Context, uuid.UUID) (bool, error)) *MockITransactionWriter_ExistsForCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function with given fields: ctx, id
+func (_m *MockITransactionWriter) FindByID(ctx context.Context, id uuid.UUID) (*transaction.Transaction, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *transaction.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*transaction.Transaction, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *transaction.Transaction); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*transaction.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockITransactionWriter_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type MockITransactionWriter_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockITransactionWriter_Expecter) FindByID(ctx interface{}, id interface{}) *MockITransactionWriter_FindByID_Call {
+	return &MockITransactionWriter_FindByID_Call{Call: _e.mock.On("FindByID", ctx, id)}
+}
+
+func (_c *MockITransactionWriter_FindByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockITransactionWriter_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockITransactionWriter_FindByID_Call) Return(_a0 *transaction.Transaction, _a1 error) *MockITransactionWriter_FindByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockITransactionWriter_FindByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*transaction.Transaction, error)) *MockITransactionWriter_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Insert provides a mock function with given fields: ctx, create
-func (_m *MockITransactionWriter) Insert(ctx context.Context, create *transaction.TransactionCreate) (uuid.UUID, error) {
+func (_m *MockITransactionWriter) Insert(ctx context.Context, create *transaction.TransactionCreate) (*transaction.Transaction, error) {
 	ret := _m.Called(ctx, create)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Insert")
 	}
 
-	var r0 uuid.UUID
+	var r0 *transaction.Transaction
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *transaction.TransactionCreate) (uuid.UUID, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *transaction.TransactionCreate) (*transaction.Transaction, error)); ok {
 		return rf(ctx, create)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *transaction.TransactionCreate) uuid.UUID); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *transaction.TransactionCreate) *transaction.Transaction); ok {
 		r0 = rf(ctx, create)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(uuid.UUID)
+			r0 = ret.Get(0).(*transaction.Transaction)
 		}
 	}
 
@@ -73,12 +236,188 @@ func (_c *MockITransactionWriter_Insert_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockITransactionWriter_Insert_Call) Return(_a0 uuid.UUID, _a1 error) *MockITransactionWriter_Insert_Call {
+func (_c *MockITransactionWriter_Insert_Call) Return(_a0 *transaction.Transaction, _a1 error) *MockITransactionWriter_Insert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockITransactionWriter_Insert_Call) RunAndReturn(run func(context.Context, *transaction.TransactionCreate) (*transaction.Transaction, error)) *MockITransactionWriter_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByCategory provides a mock function with given fields: ctx, categoryID
+func (_m *MockITransactionWriter) ListByCategory(ctx context.Context, categoryID uuid.UUID) ([]*transaction.Transaction, error) {
+	ret := _m.Called(ctx, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByCategory")
+	}
+
+	var r0 []*transaction.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*transaction.Transaction, error)); ok {
+		return rf(ctx, categoryID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*transaction.Transaction); ok {
+		r0 = rf(ctx, categoryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*transaction.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockITransactionWriter_ListByCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByCategory'
+type MockITransactionWriter_ListByCategory_Call struct {
+	*mock.Call
+}
+
+// ListByCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - categoryID uuid.UUID
+func (_e *MockITransactionWriter_Expecter) ListByCategory(ctx interface{}, categoryID interface{}) *MockITransactionWriter_ListByCategory_Call {
+	return &MockITransactionWriter_ListByCategory_Call{Call: _e.mock.On("ListByCategory", ctx, categoryID)}
+}
+
+func (_c *MockITransactionWriter_ListByCategory_Call) Run(run func(ctx context.Context, categoryID uuid.UUID)) *MockITransactionWriter_ListByCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockITransactionWriter_ListByCategory_Call) Return(_a0 []*transaction.Transaction, _a1 error) *MockITransactionWriter_ListByCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockITransactionWriter_ListByCategory_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*transaction.Transaction, error)) *MockITransactionWriter_ListByCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveToCategory provides a mock function with given fields: ctx, ids, from, to
+func (_m *MockITransactionWriter) MoveToCategory(ctx context.Context, ids []uuid.UUID, from uuid.UUID, to uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, ids, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveToCategory")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, uuid.UUID, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, ids, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, uuid.UUID, uuid.UUID) int64); ok {
+		r0 = rf(ctx, ids, from, to)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, ids, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockITransactionWriter_MoveToCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveToCategory'
+type MockITransactionWriter_MoveToCategory_Call struct {
+	*mock.Call
+}
+
+// MoveToCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []uuid.UUID
+//   - from uuid.UUID
+//   - to uuid.UUID
+func (_e *MockITransactionWriter_Expecter) MoveToCategory(ctx interface{}, ids interface{}, from interface{}, to interface{}) *MockITransactionWriter_MoveToCategory_Call {
+	return &MockITransactionWriter_MoveToCategory_Call{Call: _e.mock.On("MoveToCategory", ctx, ids, from, to)}
+}
+
+func (_c *MockITransactionWriter_MoveToCategory_Call) Run(run func(ctx context.Context, ids []uuid.UUID, from uuid.UUID, to uuid.UUID)) *MockITransactionWriter_MoveToCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockITransactionWriter_MoveToCategory_Call) Return(_a0 int64, _a1 error) *MockITransactionWriter_MoveToCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockITransactionWriter_MoveToCategory_Call) RunAndReturn(run func(context.Context, []uuid.UUID, uuid.UUID, uuid.UUID) (int64, error)) *MockITransactionWriter_MoveToCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReassignCategory provides a mock function with given fields: ctx, from, to
+func (_m *MockITransactionWriter) ReassignCategory(ctx context.Context, from uuid.UUID, to uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReassignCategory")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) int64); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockITransactionWriter_ReassignCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReassignCategory'
+type MockITransactionWriter_ReassignCategory_Call struct {
+	*mock.Call
+}
+
+// ReassignCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from uuid.UUID
+//   - to uuid.UUID
+func (_e *MockITransactionWriter_Expecter) ReassignCategory(ctx interface{}, from interface{}, to interface{}) *MockITransactionWriter_ReassignCategory_Call {
+	return &MockITransactionWriter_ReassignCategory_Call{Call: _e.mock.On("ReassignCategory", ctx, from, to)}
+}
+
+func (_c *MockITransactionWriter_ReassignCategory_Call) Run(run func(ctx context.Context, from uuid.UUID, to uuid.UUID)) *MockITransactionWriter_ReassignCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockITransactionWriter_ReassignCategory_Call) Return(_a0 int64, _a1 error) *MockITransactionWriter_ReassignCategory_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockITransactionWriter_Insert_Call) RunAndReturn(run func(context.Context, *transaction.TransactionCreate) (uuid.UUID, error)) *MockITransactionWriter_Insert_Call {
+func (_c *MockITransactionWriter_ReassignCategory_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (int64, error)) *MockITransactionWriter_ReassignCategory_Call {
 	_c.Call.Return(run)
 	return _c
 }
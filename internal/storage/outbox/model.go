@@ -0,0 +1,61 @@
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/gofrs/uuid/v5"
+)
+
+// Event is a domain event recorded by an action in the same transaction as
+// the change it describes. IDs increase in the order events were recorded.
+type Event struct {
+	ID          int64
+	Type        string
+	AggregateID uuid.UUID
+	// LedgerID is the ledger the event happened in; nil for events outside
+	// any ledger.
+	LedgerID  *uuid.UUID
+	Payload   json.RawMessage
+	CreatedAt time.Time
+}
+
+// EventCreate is the input for recording an event.
+type EventCreate struct {
+	Type        string
+	AggregateID uuid.UUID
+	Payload     json.RawMessage
+}
+
+func bobOutboxEventToEvent(row *bobgen.OutboxEvent) *Event {
+	event := &Event{
+		ID:          row.ID,
+		Type:        row.EventType,
+		AggregateID: row.AggregateID,
+		Payload:     row.Payload.Val,
+		CreatedAt:   row.CreatedAt,
+	}
+	if row.LedgerID.IsValue() {
+		ledgerID := row.LedgerID.MustGet()
+		event.LedgerID = &ledgerID
+	}
+	return event
+}
+
+// Horizon is what a reader could see of the outbox at one moment: the
+// highest event ID committed, and the transactions still running then,
+// every one of which has an ID in [XMin, XMax).
+type Horizon struct {
+	MaxID int64  `db:"max_id"`
+	XMin  uint64 `db:"xmin"`
+	XMax  uint64 `db:"xmax"`
+}
+
+// SettledBy reports whether every transaction running at h had ended by
+// later. A transaction that recorded an event at or below h.MaxID, but
+// hadn't committed, was one of them, so once h is settled no event at or
+// below h.MaxID can still appear.
+func (h Horizon) SettledBy(later Horizon) bool {
+	return later.XMin >= h.XMax
+}
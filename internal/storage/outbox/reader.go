@@ -0,0 +1,95 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/scan"
+)
+
+type Reader struct {
+	exec bob.Executor
+}
+
+func NewReader(exec bob.Executor) *Reader {
+	return &Reader{exec: exec}
+}
+
+// ListAfter returns up to limit events with an ID greater than after and at
+// most through, oldest first. Transactions can commit out of ID order, so
+// only a through that has settled (see Horizon) rules out an event still
+// appearing in the range.
+func (r *Reader) ListAfter(ctx context.Context, after int64, through int64, limit int) ([]*Event, error) {
+	rows, err := bobgen.OutboxEvents.Query(
+		sm.Where(psql.And(
+			bobgen.OutboxEvents.Columns.ID.GT(psql.Arg(after)),
+			bobgen.OutboxEvents.Columns.ID.LTE(psql.Arg(through)),
+		)),
+		sm.OrderBy(bobgen.OutboxEvents.Columns.ID),
+		sm.Limit(limit),
+	).All(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*Event, len(rows))
+	for i, row := range rows {
+		events[i] = bobOutboxEventToEvent(row)
+	}
+	return events, nil
+}
+
+// ListAfterInLedger is ListAfter for the events of one ledger.
+func (r *Reader) ListAfterInLedger(ctx context.Context, ledgerID uuid.UUID, after int64, through int64, limit int) ([]*Event, error) {
+	rows, err := bobgen.OutboxEvents.Query(
+		sm.Where(psql.And(
+			bobgen.OutboxEvents.Columns.LedgerID.EQ(psql.Arg(ledgerID)),
+			bobgen.OutboxEvents.Columns.ID.GT(psql.Arg(after)),
+			bobgen.OutboxEvents.Columns.ID.LTE(psql.Arg(through)),
+		)),
+		sm.OrderBy(bobgen.OutboxEvents.Columns.ID),
+		sm.Limit(limit),
+	).All(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*Event, len(rows))
+	for i, row := range rows {
+		events[i] = bobOutboxEventToEvent(row)
+	}
+	return events, nil
+}
+
+// horizonQuery reads the highest event ID and the running transactions from
+// one snapshot. xid8 has no cast to bigint, so it goes through text.
+const horizonQuery = `SELECT
+	(SELECT coalesce(max(id), 0) FROM outbox_events) AS max_id,
+	pg_snapshot_xmin(s)::text::bigint AS xmin,
+	pg_snapshot_xmax(s)::text::bigint AS xmax
+FROM pg_current_snapshot() AS s`
+
+// Horizon returns what the outbox looks like now. Run outside of a
+// transaction so it sees the latest commits.
+func (r *Reader) Horizon(ctx context.Context) (Horizon, error) {
+	return bob.One(ctx, r.exec, psql.RawQuery(horizonQuery), scan.StructMapper[Horizon]())
+}
+
+// GetCursor returns the ID of the last event delivered to sink, or 0 when
+// nothing has been delivered yet.
+func (r *Reader) GetCursor(ctx context.Context, sink string) (int64, error) {
+	row, err := bobgen.FindOutboxCursor(ctx, r.exec, sink)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return row.Position, nil
+}
@@ -0,0 +1,87 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/types"
+)
+
+type Writer struct {
+	tx bob.Tx
+	Reader
+}
+
+func NewWriter(tx bob.Tx) *Writer {
+	return &Writer{
+		tx: tx,
+		Reader: Reader{
+			exec: tx,
+		},
+	}
+}
+
+// Append records events in the writer's transaction, in order, in the
+// request's ledger if it has one.
+//
+// Append makes sure the transaction has an ID before it takes event IDs, so
+// that a reader's Horizon counts it as running for as long as its events
+// could still commit below one already visible.
+func (w *Writer) Append(ctx context.Context, events ...*EventCreate) error {
+	if len(events) == 0 {
+		return nil
+	}
+	if _, err := psql.RawQuery("SELECT pg_current_xact_id()").Exec(ctx, w.tx); err != nil {
+		return err
+	}
+	ledgerID, ledgerErr := requestctx.LedgerID(ctx)
+	for _, event := range events {
+		setter := &bobgen.OutboxEventSetter{
+			EventType:   omit.From(event.Type),
+			AggregateID: omit.From(event.AggregateID),
+			Payload:     omit.From(types.NewJSON(event.Payload)),
+		}
+		if ledgerErr == nil {
+			setter.LedgerID = omitnull.From(ledgerID)
+		}
+		if _, err := bobgen.OutboxEvents.Insert(setter).Exec(ctx, w.tx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Store is used by the dispatcher, outside of any action's transaction, to
+// read events and track how far each sink has got.
+type Store struct {
+	exec bob.Executor
+	Reader
+}
+
+func NewStore(exec bob.Executor) *Store {
+	return &Store{
+		exec: exec,
+		Reader: Reader{
+			exec: exec,
+		},
+	}
+}
+
+// SaveCursor records position as the last event delivered to sink.
+func (s *Store) SaveCursor(ctx context.Context, sink string, position int64) error {
+	setter := &bobgen.OutboxCursorSetter{
+		Sink:     omit.From(sink),
+		Position: omit.From(position),
+	}
+	_, err := bobgen.OutboxCursors.Insert(
+		setter,
+		im.OnConflict("sink").DoUpdate(im.SetExcluded("position", "updated_at")),
+	).Exec(ctx, s.exec)
+	return err
+}
@@ -2,8 +2,12 @@ package storage
 
 import (
 	"github.com/carson-networks/budget-server/internal/storage/account"
+	"github.com/carson-networks/budget-server/internal/storage/audit"
 	"github.com/carson-networks/budget-server/internal/storage/category"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
 	"github.com/carson-networks/budget-server/internal/storage/transaction"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
 	"github.com/stephenafamo/bob"
 )
 
@@ -11,6 +15,10 @@ type Reader struct {
 	Accounts     *account.Reader
 	Transactions *transaction.Reader
 	Categories   *category.Reader
+	Webhooks     *webhook.Reader
+	Audit        *audit.Reader
+	Users        *user.Reader
+	Ledgers      *ledger.Reader
 }
 
 func NewReader(exec bob.Executor) *Reader {
@@ -18,5 +26,9 @@ func NewReader(exec bob.Executor) *Reader {
 		Accounts:     account.NewReader(exec),
 		Transactions: transaction.NewReader(exec),
 		Categories:   category.NewReader(exec),
+		Webhooks:     webhook.NewReader(exec),
+		Audit:        audit.NewReader(exec),
+		Users:        user.NewReader(exec),
+		Ledgers:      ledger.NewReader(exec),
 	}
 }
@@ -5,6 +5,7 @@ package bobgen
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"time"
 
@@ -18,6 +19,9 @@ import (
 	"github.com/stephenafamo/bob/dialect/psql/sm"
 	"github.com/stephenafamo/bob/dialect/psql/um"
 	"github.com/stephenafamo/bob/expr"
+	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/bob/orm"
+	"github.com/stephenafamo/bob/types/pgtypes"
 )
 
 // Account is an object representing the database table.
@@ -29,6 +33,9 @@ type Account struct {
 	Balance         decimal.Decimal `db:"balance" `
 	StartingBalance decimal.Decimal `db:"starting_balance" `
 	CreatedAt       time.Time       `db:"created_at" `
+	LedgerID        uuid.UUID       `db:"ledger_id" `
+
+	R accountR `db:"-" `
 }
 
 // AccountSlice is an alias for a slice of pointers to Account.
@@ -41,10 +48,16 @@ var Accounts = psql.NewTablex[*Account, AccountSlice, *AccountSetter]("", "accou
 // AccountsQuery is a query on the accounts table
 type AccountsQuery = *psql.ViewQuery[*Account, AccountSlice]
 
+// accountR is where relationships are stored.
+type accountR struct {
+	Ledger       *Ledger          // accounts.fk_accounts_ledger_id
+	Transactions TransactionSlice // transactions.fk_transactions_account_id
+}
+
 func buildAccountColumns(alias string) accountColumns {
 	return accountColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "name", "type", "sub_type", "balance", "starting_balance", "created_at",
+			"id", "name", "type", "sub_type", "balance", "starting_balance", "created_at", "ledger_id",
 		).WithParent("accounts"),
 		tableAlias:      alias,
 		ID:              psql.Quote(alias, "id"),
@@ -54,6 +67,7 @@ func buildAccountColumns(alias string) accountColumns {
 		Balance:         psql.Quote(alias, "balance"),
 		StartingBalance: psql.Quote(alias, "starting_balance"),
 		CreatedAt:       psql.Quote(alias, "created_at"),
+		LedgerID:        psql.Quote(alias, "ledger_id"),
 	}
 }
 
@@ -67,6 +81,7 @@ type accountColumns struct {
 	Balance         psql.Expression
 	StartingBalance psql.Expression
 	CreatedAt       psql.Expression
+	LedgerID        psql.Expression
 }
 
 func (c accountColumns) Alias() string {
@@ -88,10 +103,11 @@ type AccountSetter struct {
 	Balance         omit.Val[decimal.Decimal] `db:"balance" `
 	StartingBalance omit.Val[decimal.Decimal] `db:"starting_balance" `
 	CreatedAt       omit.Val[time.Time]       `db:"created_at" `
+	LedgerID        omit.Val[uuid.UUID]       `db:"ledger_id" `
 }
 
 func (s AccountSetter) SetColumns() []string {
-	vals := make([]string, 0, 7)
+	vals := make([]string, 0, 8)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
@@ -113,6 +129,9 @@ func (s AccountSetter) SetColumns() []string {
 	if s.CreatedAt.IsValue() {
 		vals = append(vals, "created_at")
 	}
+	if s.LedgerID.IsValue() {
+		vals = append(vals, "ledger_id")
+	}
 	return vals
 }
 
@@ -138,6 +157,9 @@ func (s AccountSetter) Overwrite(t *Account) {
 	if s.CreatedAt.IsValue() {
 		t.CreatedAt = s.CreatedAt.MustGet()
 	}
+	if s.LedgerID.IsValue() {
+		t.LedgerID = s.LedgerID.MustGet()
+	}
 }
 
 func (s *AccountSetter) Apply(q *dialect.InsertQuery) {
@@ -146,7 +168,7 @@ func (s *AccountSetter) Apply(q *dialect.InsertQuery) {
 	})
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 7)
+		vals := make([]bob.Expression, 8)
 		if s.ID.IsValue() {
 			vals[0] = psql.Arg(s.ID.MustGet())
 		} else {
@@ -189,6 +211,12 @@ func (s *AccountSetter) Apply(q *dialect.InsertQuery) {
 			vals[6] = psql.Raw("DEFAULT")
 		}
 
+		if s.LedgerID.IsValue() {
+			vals[7] = psql.Arg(s.LedgerID.MustGet())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
 		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
 	}))
 }
@@ -198,7 +226,7 @@ func (s AccountSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s AccountSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 7)
+	exprs := make([]bob.Expression, 0, 8)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -249,6 +277,13 @@ func (s AccountSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if s.LedgerID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "ledger_id")...),
+			psql.Arg(s.LedgerID),
+		}})
+	}
+
 	return exprs
 }
 
@@ -310,6 +345,7 @@ func (o *Account) Update(ctx context.Context, exec bob.Executor, s *AccountSette
 		return err
 	}
 
+	o.R = v.R
 	*o = *v
 
 	return nil
@@ -329,7 +365,7 @@ func (o *Account) Reload(ctx context.Context, exec bob.Executor) error {
 	if err != nil {
 		return err
 	}
-
+	o2.R = o.R
 	*o = *o2
 
 	return nil
@@ -376,7 +412,7 @@ func (o AccountSlice) copyMatchingRows(from ...*Account) {
 			if new.ID != old.ID {
 				continue
 			}
-
+			new.R = old.R
 			o[i] = new
 			break
 		}
@@ -474,6 +510,176 @@ func (o AccountSlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 	return nil
 }
 
+// Ledger starts a query for related objects on ledgers
+func (o *Account) Ledger(mods ...bob.Mod[*dialect.SelectQuery]) LedgersQuery {
+	return Ledgers.Query(append(mods,
+		sm.Where(Ledgers.Columns.ID.EQ(psql.Arg(o.LedgerID))),
+	)...)
+}
+
+func (os AccountSlice) Ledger(mods ...bob.Mod[*dialect.SelectQuery]) LedgersQuery {
+	pkLedgerID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkLedgerID = append(pkLedgerID, o.LedgerID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkLedgerID), "uuid[]")),
+	))
+
+	return Ledgers.Query(append(mods,
+		sm.Where(psql.Group(Ledgers.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Transactions starts a query for related objects on transactions
+func (o *Account) Transactions(mods ...bob.Mod[*dialect.SelectQuery]) TransactionsQuery {
+	return Transactions.Query(append(mods,
+		sm.Where(Transactions.Columns.LedgerID.EQ(psql.Arg(o.LedgerID))), sm.Where(Transactions.Columns.AccountID.EQ(psql.Arg(o.ID))),
+	)...)
+}
+
+func (os AccountSlice) Transactions(mods ...bob.Mod[*dialect.SelectQuery]) TransactionsQuery {
+	pkLedgerID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+
+	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkLedgerID = append(pkLedgerID, o.LedgerID)
+		pkID = append(pkID, o.ID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkLedgerID), "uuid[]")),
+		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
+	))
+
+	return Transactions.Query(append(mods,
+		sm.Where(psql.Group(Transactions.Columns.LedgerID, Transactions.Columns.AccountID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+func attachAccountLedger0(ctx context.Context, exec bob.Executor, count int, account0 *Account, ledger1 *Ledger) (*Account, error) {
+	setter := &AccountSetter{
+		LedgerID: omit.From(ledger1.ID),
+	}
+
+	err := account0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachAccountLedger0: %w", err)
+	}
+
+	return account0, nil
+}
+
+func (account0 *Account) InsertLedger(ctx context.Context, exec bob.Executor, related *LedgerSetter) error {
+	var err error
+
+	ledger1, err := Ledgers.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachAccountLedger0(ctx, exec, 1, account0, ledger1)
+	if err != nil {
+		return err
+	}
+
+	account0.R.Ledger = ledger1
+
+	ledger1.R.Accounts = append(ledger1.R.Accounts, account0)
+
+	return nil
+}
+
+func (account0 *Account) AttachLedger(ctx context.Context, exec bob.Executor, ledger1 *Ledger) error {
+	var err error
+
+	_, err = attachAccountLedger0(ctx, exec, 1, account0, ledger1)
+	if err != nil {
+		return err
+	}
+
+	account0.R.Ledger = ledger1
+
+	ledger1.R.Accounts = append(ledger1.R.Accounts, account0)
+
+	return nil
+}
+
+func insertAccountTransactions0(ctx context.Context, exec bob.Executor, transactions1 []*TransactionSetter, account0 *Account) (TransactionSlice, error) {
+	for i := range transactions1 {
+		transactions1[i].LedgerID = omit.From(account0.LedgerID)
+		transactions1[i].AccountID = omit.From(account0.ID)
+	}
+
+	ret, err := Transactions.Insert(bob.ToMods(transactions1...)).All(ctx, exec)
+	if err != nil {
+		return ret, fmt.Errorf("insertAccountTransactions0: %w", err)
+	}
+
+	return ret, nil
+}
+
+func attachAccountTransactions0(ctx context.Context, exec bob.Executor, count int, transactions1 TransactionSlice, account0 *Account) (TransactionSlice, error) {
+	setter := &TransactionSetter{
+		LedgerID:  omit.From(account0.LedgerID),
+		AccountID: omit.From(account0.ID),
+	}
+
+	err := transactions1.UpdateAll(ctx, exec, *setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachAccountTransactions0: %w", err)
+	}
+
+	return transactions1, nil
+}
+
+func (account0 *Account) InsertTransactions(ctx context.Context, exec bob.Executor, related ...*TransactionSetter) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+
+	transactions1, err := insertAccountTransactions0(ctx, exec, related, account0)
+	if err != nil {
+		return err
+	}
+
+	account0.R.Transactions = append(account0.R.Transactions, transactions1...)
+
+	for _, rel := range transactions1 {
+		rel.R.Account = account0
+	}
+	return nil
+}
+
+func (account0 *Account) AttachTransactions(ctx context.Context, exec bob.Executor, related ...*Transaction) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	transactions1 := TransactionSlice(related)
+
+	_, err = attachAccountTransactions0(ctx, exec, len(related), transactions1, account0)
+	if err != nil {
+		return err
+	}
+
+	account0.R.Transactions = append(account0.R.Transactions, transactions1...)
+
+	for _, rel := range related {
+		rel.R.Account = account0
+	}
+
+	return nil
+}
+
 type accountWhere[Q psql.Filterable] struct {
 	ID              psql.WhereMod[Q, uuid.UUID]
 	Name            psql.WhereMod[Q, string]
@@ -482,6 +688,7 @@ type accountWhere[Q psql.Filterable] struct {
 	Balance         psql.WhereMod[Q, decimal.Decimal]
 	StartingBalance psql.WhereMod[Q, decimal.Decimal]
 	CreatedAt       psql.WhereMod[Q, time.Time]
+	LedgerID        psql.WhereMod[Q, uuid.UUID]
 }
 
 func (accountWhere[Q]) AliasedAs(alias string) accountWhere[Q] {
@@ -497,5 +704,255 @@ func buildAccountWhere[Q psql.Filterable](cols accountColumns) accountWhere[Q] {
 		Balance:         psql.Where[Q, decimal.Decimal](cols.Balance),
 		StartingBalance: psql.Where[Q, decimal.Decimal](cols.StartingBalance),
 		CreatedAt:       psql.Where[Q, time.Time](cols.CreatedAt),
+		LedgerID:        psql.Where[Q, uuid.UUID](cols.LedgerID),
+	}
+}
+
+func (o *Account) Preload(name string, retrieved any) error {
+	if o == nil {
+		return nil
+	}
+
+	switch name {
+	case "Ledger":
+		rel, ok := retrieved.(*Ledger)
+		if !ok {
+			return fmt.Errorf("account cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Ledger = rel
+
+		if rel != nil {
+			rel.R.Accounts = AccountSlice{o}
+		}
+		return nil
+	case "Transactions":
+		rels, ok := retrieved.(TransactionSlice)
+		if !ok {
+			return fmt.Errorf("account cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Transactions = rels
+
+		for _, rel := range rels {
+			if rel != nil {
+				rel.R.Account = o
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("account has no relationship %q", name)
+	}
+}
+
+type accountPreloader struct {
+	Ledger func(...psql.PreloadOption) psql.Preloader
+}
+
+func buildAccountPreloader() accountPreloader {
+	return accountPreloader{
+		Ledger: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Ledger, LedgerSlice](psql.PreloadRel{
+				Name: "Ledger",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Accounts,
+						To:          Ledgers,
+						FromColumns: []string{"ledger_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Ledgers.Columns.Names(), opts...)
+		},
+	}
+}
+
+type accountThenLoader[Q orm.Loadable] struct {
+	Ledger       func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Transactions func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+}
+
+func buildAccountThenLoader[Q orm.Loadable]() accountThenLoader[Q] {
+	type LedgerLoadInterface interface {
+		LoadLedger(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type TransactionsLoadInterface interface {
+		LoadTransactions(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+
+	return accountThenLoader[Q]{
+		Ledger: thenLoadBuilder[Q](
+			"Ledger",
+			func(ctx context.Context, exec bob.Executor, retrieved LedgerLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadLedger(ctx, exec, mods...)
+			},
+		),
+		Transactions: thenLoadBuilder[Q](
+			"Transactions",
+			func(ctx context.Context, exec bob.Executor, retrieved TransactionsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadTransactions(ctx, exec, mods...)
+			},
+		),
+	}
+}
+
+// LoadLedger loads the account's Ledger into the .R struct
+func (o *Account) LoadLedger(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Ledger = nil
+
+	related, err := o.Ledger(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Accounts = AccountSlice{o}
+
+	o.R.Ledger = related
+	return nil
+}
+
+// LoadLedger loads the account's Ledger into the .R struct
+func (os AccountSlice) LoadLedger(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	ledgers, err := os.Ledger(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range ledgers {
+
+			if !(o.LedgerID == rel.ID) {
+				continue
+			}
+
+			rel.R.Accounts = append(rel.R.Accounts, o)
+
+			o.R.Ledger = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadTransactions loads the account's Transactions into the .R struct
+func (o *Account) LoadTransactions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Transactions = nil
+
+	related, err := o.Transactions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range related {
+		rel.R.Account = o
+	}
+
+	o.R.Transactions = related
+	return nil
+}
+
+// LoadTransactions loads the account's Transactions into the .R struct
+func (os AccountSlice) LoadTransactions(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	transactions, err := os.Transactions(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		o.R.Transactions = nil
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range transactions {
+
+			if !(o.LedgerID == rel.LedgerID) {
+				continue
+			}
+
+			if !(o.ID == rel.AccountID) {
+				continue
+			}
+
+			rel.R.Account = o
+
+			o.R.Transactions = append(o.R.Transactions, rel)
+		}
+	}
+
+	return nil
+}
+
+type accountJoins[Q dialect.Joinable] struct {
+	typ          string
+	Ledger       modAs[Q, ledgerColumns]
+	Transactions modAs[Q, transactionColumns]
+}
+
+func (j accountJoins[Q]) aliasedAs(alias string) accountJoins[Q] {
+	return buildAccountJoins[Q](buildAccountColumns(alias), j.typ)
+}
+
+func buildAccountJoins[Q dialect.Joinable](cols accountColumns, typ string) accountJoins[Q] {
+	return accountJoins[Q]{
+		typ: typ,
+		Ledger: modAs[Q, ledgerColumns]{
+			c: Ledgers.Columns,
+			f: func(to ledgerColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Ledgers.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.LedgerID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Transactions: modAs[Q, transactionColumns]{
+			c: Transactions.Columns,
+			f: func(to transactionColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Transactions.Name().As(to.Alias())).On(
+						to.LedgerID.EQ(cols.LedgerID), to.AccountID.EQ(cols.ID),
+					))
+				}
+
+				return mods
+			},
+		},
 	}
 }
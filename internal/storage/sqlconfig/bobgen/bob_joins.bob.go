@@ -32,8 +32,16 @@ func (j joinSet[Q]) AliasedAs(alias string) joinSet[Q] {
 }
 
 type joins[Q dialect.Joinable] struct {
-	Categories   joinSet[categoryJoins[Q]]
-	Transactions joinSet[transactionJoins[Q]]
+	Accounts          joinSet[accountJoins[Q]]
+	AuditRecords      joinSet[auditRecordJoins[Q]]
+	AuthTokens        joinSet[authTokenJoins[Q]]
+	Categories        joinSet[categoryJoins[Q]]
+	LedgerMembers     joinSet[ledgerMemberJoins[Q]]
+	Ledgers           joinSet[ledgerJoins[Q]]
+	Transactions      joinSet[transactionJoins[Q]]
+	Users             joinSet[userJoins[Q]]
+	WebhookDeliveries joinSet[webhookDeliveryJoins[Q]]
+	Webhooks          joinSet[webhookJoins[Q]]
 }
 
 func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q](c C, f F) joinSet[Q] {
@@ -46,8 +54,16 @@ func buildJoinSet[Q interface{ aliasedAs(string) Q }, C any, F func(C, string) Q
 
 func getJoins[Q dialect.Joinable]() joins[Q] {
 	return joins[Q]{
-		Categories:   buildJoinSet[categoryJoins[Q]](Categories.Columns, buildCategoryJoins),
-		Transactions: buildJoinSet[transactionJoins[Q]](Transactions.Columns, buildTransactionJoins),
+		Accounts:          buildJoinSet[accountJoins[Q]](Accounts.Columns, buildAccountJoins),
+		AuditRecords:      buildJoinSet[auditRecordJoins[Q]](AuditRecords.Columns, buildAuditRecordJoins),
+		AuthTokens:        buildJoinSet[authTokenJoins[Q]](AuthTokens.Columns, buildAuthTokenJoins),
+		Categories:        buildJoinSet[categoryJoins[Q]](Categories.Columns, buildCategoryJoins),
+		LedgerMembers:     buildJoinSet[ledgerMemberJoins[Q]](LedgerMembers.Columns, buildLedgerMemberJoins),
+		Ledgers:           buildJoinSet[ledgerJoins[Q]](Ledgers.Columns, buildLedgerJoins),
+		Transactions:      buildJoinSet[transactionJoins[Q]](Transactions.Columns, buildTransactionJoins),
+		Users:             buildJoinSet[userJoins[Q]](Users.Columns, buildUserJoins),
+		WebhookDeliveries: buildJoinSet[webhookDeliveryJoins[Q]](WebhookDeliveries.Columns, buildWebhookDeliveryJoins),
+		Webhooks:          buildJoinSet[webhookJoins[Q]](Webhooks.Columns, buildWebhookJoins),
 	}
 }
 
@@ -17,14 +17,30 @@ import (
 var Preload = getPreloaders()
 
 type preloaders struct {
-	Category    categoryPreloader
-	Transaction transactionPreloader
+	Account         accountPreloader
+	AuditRecord     auditRecordPreloader
+	AuthToken       authTokenPreloader
+	Category        categoryPreloader
+	LedgerMember    ledgerMemberPreloader
+	Ledger          ledgerPreloader
+	Transaction     transactionPreloader
+	User            userPreloader
+	WebhookDelivery webhookDeliveryPreloader
+	Webhook         webhookPreloader
 }
 
 func getPreloaders() preloaders {
 	return preloaders{
-		Category:    buildCategoryPreloader(),
-		Transaction: buildTransactionPreloader(),
+		Account:         buildAccountPreloader(),
+		AuditRecord:     buildAuditRecordPreloader(),
+		AuthToken:       buildAuthTokenPreloader(),
+		Category:        buildCategoryPreloader(),
+		LedgerMember:    buildLedgerMemberPreloader(),
+		Ledger:          buildLedgerPreloader(),
+		Transaction:     buildTransactionPreloader(),
+		User:            buildUserPreloader(),
+		WebhookDelivery: buildWebhookDeliveryPreloader(),
+		Webhook:         buildWebhookPreloader(),
 	}
 }
 
@@ -35,14 +51,30 @@ var (
 )
 
 type thenLoaders[Q orm.Loadable] struct {
-	Category    categoryThenLoader[Q]
-	Transaction transactionThenLoader[Q]
+	Account         accountThenLoader[Q]
+	AuditRecord     auditRecordThenLoader[Q]
+	AuthToken       authTokenThenLoader[Q]
+	Category        categoryThenLoader[Q]
+	LedgerMember    ledgerMemberThenLoader[Q]
+	Ledger          ledgerThenLoader[Q]
+	Transaction     transactionThenLoader[Q]
+	User            userThenLoader[Q]
+	WebhookDelivery webhookDeliveryThenLoader[Q]
+	Webhook         webhookThenLoader[Q]
 }
 
 func getThenLoaders[Q orm.Loadable]() thenLoaders[Q] {
 	return thenLoaders[Q]{
-		Category:    buildCategoryThenLoader[Q](),
-		Transaction: buildTransactionThenLoader[Q](),
+		Account:         buildAccountThenLoader[Q](),
+		AuditRecord:     buildAuditRecordThenLoader[Q](),
+		AuthToken:       buildAuthTokenThenLoader[Q](),
+		Category:        buildCategoryThenLoader[Q](),
+		LedgerMember:    buildLedgerMemberThenLoader[Q](),
+		Ledger:          buildLedgerThenLoader[Q](),
+		Transaction:     buildTransactionThenLoader[Q](),
+		User:            buildUserThenLoader[Q](),
+		WebhookDelivery: buildWebhookDeliveryThenLoader[Q](),
+		Webhook:         buildWebhookThenLoader[Q](),
 	}
 }
 
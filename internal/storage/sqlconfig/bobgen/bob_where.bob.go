@@ -17,17 +17,47 @@ var (
 )
 
 func Where[Q psql.Filterable]() struct {
-	Accounts     accountWhere[Q]
-	Categories   categoryWhere[Q]
-	Transactions transactionWhere[Q]
+	Accounts          accountWhere[Q]
+	AuditRecords      auditRecordWhere[Q]
+	AuthTokens        authTokenWhere[Q]
+	Categories        categoryWhere[Q]
+	IdempotencyKeys   idempotencyKeyWhere[Q]
+	LedgerMembers     ledgerMemberWhere[Q]
+	Ledgers           ledgerWhere[Q]
+	OutboxCursors     outboxCursorWhere[Q]
+	OutboxEvents      outboxEventWhere[Q]
+	Transactions      transactionWhere[Q]
+	Users             userWhere[Q]
+	WebhookDeliveries webhookDeliveryWhere[Q]
+	Webhooks          webhookWhere[Q]
 } {
 	return struct {
-		Accounts     accountWhere[Q]
-		Categories   categoryWhere[Q]
-		Transactions transactionWhere[Q]
+		Accounts          accountWhere[Q]
+		AuditRecords      auditRecordWhere[Q]
+		AuthTokens        authTokenWhere[Q]
+		Categories        categoryWhere[Q]
+		IdempotencyKeys   idempotencyKeyWhere[Q]
+		LedgerMembers     ledgerMemberWhere[Q]
+		Ledgers           ledgerWhere[Q]
+		OutboxCursors     outboxCursorWhere[Q]
+		OutboxEvents      outboxEventWhere[Q]
+		Transactions      transactionWhere[Q]
+		Users             userWhere[Q]
+		WebhookDeliveries webhookDeliveryWhere[Q]
+		Webhooks          webhookWhere[Q]
 	}{
-		Accounts:     buildAccountWhere[Q](Accounts.Columns),
-		Categories:   buildCategoryWhere[Q](Categories.Columns),
-		Transactions: buildTransactionWhere[Q](Transactions.Columns),
+		Accounts:          buildAccountWhere[Q](Accounts.Columns),
+		AuditRecords:      buildAuditRecordWhere[Q](AuditRecords.Columns),
+		AuthTokens:        buildAuthTokenWhere[Q](AuthTokens.Columns),
+		Categories:        buildCategoryWhere[Q](Categories.Columns),
+		IdempotencyKeys:   buildIdempotencyKeyWhere[Q](IdempotencyKeys.Columns),
+		LedgerMembers:     buildLedgerMemberWhere[Q](LedgerMembers.Columns),
+		Ledgers:           buildLedgerWhere[Q](Ledgers.Columns),
+		OutboxCursors:     buildOutboxCursorWhere[Q](OutboxCursors.Columns),
+		OutboxEvents:      buildOutboxEventWhere[Q](OutboxEvents.Columns),
+		Transactions:      buildTransactionWhere[Q](Transactions.Columns),
+		Users:             buildUserWhere[Q](Users.Columns),
+		WebhookDeliveries: buildWebhookDeliveryWhere[Q](WebhookDeliveries.Columns),
+		Webhooks:          buildWebhookWhere[Q](Webhooks.Columns),
 	}
 }
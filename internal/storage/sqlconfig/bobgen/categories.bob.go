@@ -13,6 +13,7 @@ import (
 	"github.com/aarondl/opt/omit"
 	"github.com/aarondl/opt/omitnull"
 	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/psql"
 	"github.com/stephenafamo/bob/dialect/psql/dialect"
@@ -27,14 +28,16 @@ import (
 
 // Category is an object representing the database table.
 type Category struct {
-	ID               uuid.UUID           `db:"id,pk" `
-	Name             string              `db:"name" `
-	IsGroup          bool                `db:"is_group" `
-	ParentID         null.Val[uuid.UUID] `db:"parent_id" `
-	ShouldBeBudgeted bool                `db:"should_be_budgeted" `
-	IsDisabled       bool                `db:"is_disabled" `
-	CategoryType     int16               `db:"category_type" `
-	CreatedAt        time.Time           `db:"created_at" `
+	ID               uuid.UUID                 `db:"id,pk" `
+	Name             string                    `db:"name" `
+	IsGroup          bool                      `db:"is_group" `
+	ParentID         null.Val[uuid.UUID]       `db:"parent_id" `
+	ShouldBeBudgeted bool                      `db:"should_be_budgeted" `
+	IsDisabled       bool                      `db:"is_disabled" `
+	CategoryType     int16                     `db:"category_type" `
+	CreatedAt        time.Time                 `db:"created_at" `
+	LedgerID         uuid.UUID                 `db:"ledger_id" `
+	MonthlyBudget    null.Val[decimal.Decimal] `db:"monthly_budget" `
 
 	R categoryR `db:"-" `
 }
@@ -51,15 +54,16 @@ type CategoriesQuery = *psql.ViewQuery[*Category, CategorySlice]
 
 // categoryR is where relationships are stored.
 type categoryR struct {
-	Parent         *Category        // categories.fk_categories_parent
-	ReverseParents CategorySlice    // categories.fk_categories_parent__self_join_reverse
-	Transactions   TransactionSlice // transactions.fk_transactions_category_id
+	Ledger            *Ledger          // categories.fk_categories_ledger_id
+	Category          *Category        // categories.fk_categories_parent
+	ReverseCategories CategorySlice    // categories.fk_categories_parent__self_join_reverse
+	Transactions      TransactionSlice // transactions.fk_transactions_category_id
 }
 
 func buildCategoryColumns(alias string) categoryColumns {
 	return categoryColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "name", "is_group", "parent_id", "should_be_budgeted", "is_disabled", "category_type", "created_at",
+			"id", "name", "is_group", "parent_id", "should_be_budgeted", "is_disabled", "category_type", "created_at", "ledger_id", "monthly_budget",
 		).WithParent("categories"),
 		tableAlias:       alias,
 		ID:               psql.Quote(alias, "id"),
@@ -70,6 +74,8 @@ func buildCategoryColumns(alias string) categoryColumns {
 		IsDisabled:       psql.Quote(alias, "is_disabled"),
 		CategoryType:     psql.Quote(alias, "category_type"),
 		CreatedAt:        psql.Quote(alias, "created_at"),
+		LedgerID:         psql.Quote(alias, "ledger_id"),
+		MonthlyBudget:    psql.Quote(alias, "monthly_budget"),
 	}
 }
 
@@ -84,6 +90,8 @@ type categoryColumns struct {
 	IsDisabled       psql.Expression
 	CategoryType     psql.Expression
 	CreatedAt        psql.Expression
+	LedgerID         psql.Expression
+	MonthlyBudget    psql.Expression
 }
 
 func (c categoryColumns) Alias() string {
@@ -98,18 +106,20 @@ func (categoryColumns) AliasedAs(alias string) categoryColumns {
 // All values are optional, and do not have to be set
 // Generated columns are not included
 type CategorySetter struct {
-	ID               omit.Val[uuid.UUID]     `db:"id,pk" `
-	Name             omit.Val[string]        `db:"name" `
-	IsGroup          omit.Val[bool]          `db:"is_group" `
-	ParentID         omitnull.Val[uuid.UUID] `db:"parent_id" `
-	ShouldBeBudgeted omit.Val[bool]          `db:"should_be_budgeted" `
-	IsDisabled       omit.Val[bool]          `db:"is_disabled" `
-	CategoryType     omit.Val[int16]         `db:"category_type" `
-	CreatedAt        omit.Val[time.Time]     `db:"created_at" `
+	ID               omit.Val[uuid.UUID]           `db:"id,pk" `
+	Name             omit.Val[string]              `db:"name" `
+	IsGroup          omit.Val[bool]                `db:"is_group" `
+	ParentID         omitnull.Val[uuid.UUID]       `db:"parent_id" `
+	ShouldBeBudgeted omit.Val[bool]                `db:"should_be_budgeted" `
+	IsDisabled       omit.Val[bool]                `db:"is_disabled" `
+	CategoryType     omit.Val[int16]               `db:"category_type" `
+	CreatedAt        omit.Val[time.Time]           `db:"created_at" `
+	LedgerID         omit.Val[uuid.UUID]           `db:"ledger_id" `
+	MonthlyBudget    omitnull.Val[decimal.Decimal] `db:"monthly_budget" `
 }
 
 func (s CategorySetter) SetColumns() []string {
-	vals := make([]string, 0, 8)
+	vals := make([]string, 0, 10)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
@@ -134,6 +144,12 @@ func (s CategorySetter) SetColumns() []string {
 	if s.CreatedAt.IsValue() {
 		vals = append(vals, "created_at")
 	}
+	if s.LedgerID.IsValue() {
+		vals = append(vals, "ledger_id")
+	}
+	if !s.MonthlyBudget.IsUnset() {
+		vals = append(vals, "monthly_budget")
+	}
 	return vals
 }
 
@@ -162,6 +178,12 @@ func (s CategorySetter) Overwrite(t *Category) {
 	if s.CreatedAt.IsValue() {
 		t.CreatedAt = s.CreatedAt.MustGet()
 	}
+	if s.LedgerID.IsValue() {
+		t.LedgerID = s.LedgerID.MustGet()
+	}
+	if !s.MonthlyBudget.IsUnset() {
+		t.MonthlyBudget = s.MonthlyBudget.MustGetNull()
+	}
 }
 
 func (s *CategorySetter) Apply(q *dialect.InsertQuery) {
@@ -170,7 +192,7 @@ func (s *CategorySetter) Apply(q *dialect.InsertQuery) {
 	})
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 8)
+		vals := make([]bob.Expression, 10)
 		if s.ID.IsValue() {
 			vals[0] = psql.Arg(s.ID.MustGet())
 		} else {
@@ -219,6 +241,18 @@ func (s *CategorySetter) Apply(q *dialect.InsertQuery) {
 			vals[7] = psql.Raw("DEFAULT")
 		}
 
+		if s.LedgerID.IsValue() {
+			vals[8] = psql.Arg(s.LedgerID.MustGet())
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
+		if !s.MonthlyBudget.IsUnset() {
+			vals[9] = psql.Arg(s.MonthlyBudget.MustGetNull())
+		} else {
+			vals[9] = psql.Raw("DEFAULT")
+		}
+
 		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
 	}))
 }
@@ -228,7 +262,7 @@ func (s CategorySetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s CategorySetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 8)
+	exprs := make([]bob.Expression, 0, 10)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -286,6 +320,20 @@ func (s CategorySetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if s.LedgerID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "ledger_id")...),
+			psql.Arg(s.LedgerID),
+		}})
+	}
+
+	if !s.MonthlyBudget.IsUnset() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "monthly_budget")...),
+			psql.Arg(s.MonthlyBudget),
+		}})
+	}
+
 	return exprs
 }
 
@@ -512,92 +560,177 @@ func (o CategorySlice) ReloadAll(ctx context.Context, exec bob.Executor) error {
 	return nil
 }
 
-// Parent starts a query for related objects on categories
-func (o *Category) Parent(mods ...bob.Mod[*dialect.SelectQuery]) CategoriesQuery {
+// Ledger starts a query for related objects on ledgers
+func (o *Category) Ledger(mods ...bob.Mod[*dialect.SelectQuery]) LedgersQuery {
+	return Ledgers.Query(append(mods,
+		sm.Where(Ledgers.Columns.ID.EQ(psql.Arg(o.LedgerID))),
+	)...)
+}
+
+func (os CategorySlice) Ledger(mods ...bob.Mod[*dialect.SelectQuery]) LedgersQuery {
+	pkLedgerID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkLedgerID = append(pkLedgerID, o.LedgerID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkLedgerID), "uuid[]")),
+	))
+
+	return Ledgers.Query(append(mods,
+		sm.Where(psql.Group(Ledgers.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
+// Category starts a query for related objects on categories
+func (o *Category) Category(mods ...bob.Mod[*dialect.SelectQuery]) CategoriesQuery {
 	return Categories.Query(append(mods,
-		sm.Where(Categories.Columns.ID.EQ(psql.Arg(o.ParentID))),
+		sm.Where(Categories.Columns.LedgerID.EQ(psql.Arg(o.LedgerID))), sm.Where(Categories.Columns.ID.EQ(psql.Arg(o.ParentID))),
 	)...)
 }
 
-func (os CategorySlice) Parent(mods ...bob.Mod[*dialect.SelectQuery]) CategoriesQuery {
+func (os CategorySlice) Category(mods ...bob.Mod[*dialect.SelectQuery]) CategoriesQuery {
+	pkLedgerID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+
 	pkParentID := make(pgtypes.Array[null.Val[uuid.UUID]], 0, len(os))
 	for _, o := range os {
 		if o == nil {
 			continue
 		}
+		pkLedgerID = append(pkLedgerID, o.LedgerID)
 		pkParentID = append(pkParentID, o.ParentID)
 	}
 	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkLedgerID), "uuid[]")),
 		psql.F("unnest", psql.Cast(psql.Arg(pkParentID), "uuid[]")),
 	))
 
 	return Categories.Query(append(mods,
-		sm.Where(psql.Group(Categories.Columns.ID).OP("IN", PKArgExpr)),
+		sm.Where(psql.Group(Categories.Columns.LedgerID, Categories.Columns.ID).OP("IN", PKArgExpr)),
 	)...)
 }
 
-// ReverseParents starts a query for related objects on categories
-func (o *Category) ReverseParents(mods ...bob.Mod[*dialect.SelectQuery]) CategoriesQuery {
+// ReverseCategories starts a query for related objects on categories
+func (o *Category) ReverseCategories(mods ...bob.Mod[*dialect.SelectQuery]) CategoriesQuery {
 	return Categories.Query(append(mods,
-		sm.Where(Categories.Columns.ParentID.EQ(psql.Arg(o.ID))),
+		sm.Where(Categories.Columns.LedgerID.EQ(psql.Arg(o.LedgerID))), sm.Where(Categories.Columns.ParentID.EQ(psql.Arg(o.ID))),
 	)...)
 }
 
-func (os CategorySlice) ReverseParents(mods ...bob.Mod[*dialect.SelectQuery]) CategoriesQuery {
+func (os CategorySlice) ReverseCategories(mods ...bob.Mod[*dialect.SelectQuery]) CategoriesQuery {
+	pkLedgerID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+
 	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
 	for _, o := range os {
 		if o == nil {
 			continue
 		}
+		pkLedgerID = append(pkLedgerID, o.LedgerID)
 		pkID = append(pkID, o.ID)
 	}
 	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkLedgerID), "uuid[]")),
 		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
 	))
 
 	return Categories.Query(append(mods,
-		sm.Where(psql.Group(Categories.Columns.ParentID).OP("IN", PKArgExpr)),
+		sm.Where(psql.Group(Categories.Columns.LedgerID, Categories.Columns.ParentID).OP("IN", PKArgExpr)),
 	)...)
 }
 
 // Transactions starts a query for related objects on transactions
 func (o *Category) Transactions(mods ...bob.Mod[*dialect.SelectQuery]) TransactionsQuery {
 	return Transactions.Query(append(mods,
-		sm.Where(Transactions.Columns.CategoryID.EQ(psql.Arg(o.ID))),
+		sm.Where(Transactions.Columns.LedgerID.EQ(psql.Arg(o.LedgerID))), sm.Where(Transactions.Columns.CategoryID.EQ(psql.Arg(o.ID))),
 	)...)
 }
 
 func (os CategorySlice) Transactions(mods ...bob.Mod[*dialect.SelectQuery]) TransactionsQuery {
+	pkLedgerID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+
 	pkID := make(pgtypes.Array[uuid.UUID], 0, len(os))
 	for _, o := range os {
 		if o == nil {
 			continue
 		}
+		pkLedgerID = append(pkLedgerID, o.LedgerID)
 		pkID = append(pkID, o.ID)
 	}
 	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkLedgerID), "uuid[]")),
 		psql.F("unnest", psql.Cast(psql.Arg(pkID), "uuid[]")),
 	))
 
 	return Transactions.Query(append(mods,
-		sm.Where(psql.Group(Transactions.Columns.CategoryID).OP("IN", PKArgExpr)),
+		sm.Where(psql.Group(Transactions.Columns.LedgerID, Transactions.Columns.CategoryID).OP("IN", PKArgExpr)),
 	)...)
 }
 
-func attachCategoryParent0(ctx context.Context, exec bob.Executor, count int, category0 *Category, category1 *Category) (*Category, error) {
+func attachCategoryLedger0(ctx context.Context, exec bob.Executor, count int, category0 *Category, ledger1 *Ledger) (*Category, error) {
+	setter := &CategorySetter{
+		LedgerID: omit.From(ledger1.ID),
+	}
+
+	err := category0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachCategoryLedger0: %w", err)
+	}
+
+	return category0, nil
+}
+
+func (category0 *Category) InsertLedger(ctx context.Context, exec bob.Executor, related *LedgerSetter) error {
+	var err error
+
+	ledger1, err := Ledgers.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachCategoryLedger0(ctx, exec, 1, category0, ledger1)
+	if err != nil {
+		return err
+	}
+
+	category0.R.Ledger = ledger1
+
+	ledger1.R.Categories = append(ledger1.R.Categories, category0)
+
+	return nil
+}
+
+func (category0 *Category) AttachLedger(ctx context.Context, exec bob.Executor, ledger1 *Ledger) error {
+	var err error
+
+	_, err = attachCategoryLedger0(ctx, exec, 1, category0, ledger1)
+	if err != nil {
+		return err
+	}
+
+	category0.R.Ledger = ledger1
+
+	ledger1.R.Categories = append(ledger1.R.Categories, category0)
+
+	return nil
+}
+
+func attachCategoryCategory0(ctx context.Context, exec bob.Executor, count int, category0 *Category, category1 *Category) (*Category, error) {
 	setter := &CategorySetter{
+		LedgerID: omit.From(category1.LedgerID),
 		ParentID: omitnull.From(category1.ID),
 	}
 
 	err := category0.Update(ctx, exec, setter)
 	if err != nil {
-		return nil, fmt.Errorf("attachCategoryParent0: %w", err)
+		return nil, fmt.Errorf("attachCategoryCategory0: %w", err)
 	}
 
 	return category0, nil
 }
 
-func (category0 *Category) InsertParent(ctx context.Context, exec bob.Executor, related *CategorySetter) error {
+func (category0 *Category) InsertCategory(ctx context.Context, exec bob.Executor, related *CategorySetter) error {
 	var err error
 
 	category1, err := Categories.Insert(related).One(ctx, exec)
@@ -605,80 +738,82 @@ func (category0 *Category) InsertParent(ctx context.Context, exec bob.Executor,
 		return fmt.Errorf("inserting related objects: %w", err)
 	}
 
-	_, err = attachCategoryParent0(ctx, exec, 1, category0, category1)
+	_, err = attachCategoryCategory0(ctx, exec, 1, category0, category1)
 	if err != nil {
 		return err
 	}
 
-	category0.R.Parent = category1
+	category0.R.Category = category1
 
-	category1.R.Parent = category0
+	category1.R.Category = category0
 
 	return nil
 }
 
-func (category0 *Category) AttachParent(ctx context.Context, exec bob.Executor, category1 *Category) error {
+func (category0 *Category) AttachCategory(ctx context.Context, exec bob.Executor, category1 *Category) error {
 	var err error
 
-	_, err = attachCategoryParent0(ctx, exec, 1, category0, category1)
+	_, err = attachCategoryCategory0(ctx, exec, 1, category0, category1)
 	if err != nil {
 		return err
 	}
 
-	category0.R.Parent = category1
+	category0.R.Category = category1
 
-	category1.R.Parent = category0
+	category1.R.Category = category0
 
 	return nil
 }
 
-func insertCategoryReverseParents0(ctx context.Context, exec bob.Executor, categories1 []*CategorySetter, category0 *Category) (CategorySlice, error) {
+func insertCategoryReverseCategories0(ctx context.Context, exec bob.Executor, categories1 []*CategorySetter, category0 *Category) (CategorySlice, error) {
 	for i := range categories1 {
+		categories1[i].LedgerID = omit.From(category0.LedgerID)
 		categories1[i].ParentID = omitnull.From(category0.ID)
 	}
 
 	ret, err := Categories.Insert(bob.ToMods(categories1...)).All(ctx, exec)
 	if err != nil {
-		return ret, fmt.Errorf("insertCategoryReverseParents0: %w", err)
+		return ret, fmt.Errorf("insertCategoryReverseCategories0: %w", err)
 	}
 
 	return ret, nil
 }
 
-func attachCategoryReverseParents0(ctx context.Context, exec bob.Executor, count int, categories1 CategorySlice, category0 *Category) (CategorySlice, error) {
+func attachCategoryReverseCategories0(ctx context.Context, exec bob.Executor, count int, categories1 CategorySlice, category0 *Category) (CategorySlice, error) {
 	setter := &CategorySetter{
+		LedgerID: omit.From(category0.LedgerID),
 		ParentID: omitnull.From(category0.ID),
 	}
 
 	err := categories1.UpdateAll(ctx, exec, *setter)
 	if err != nil {
-		return nil, fmt.Errorf("attachCategoryReverseParents0: %w", err)
+		return nil, fmt.Errorf("attachCategoryReverseCategories0: %w", err)
 	}
 
 	return categories1, nil
 }
 
-func (category0 *Category) InsertReverseParents(ctx context.Context, exec bob.Executor, related ...*CategorySetter) error {
+func (category0 *Category) InsertReverseCategories(ctx context.Context, exec bob.Executor, related ...*CategorySetter) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 
-	categories1, err := insertCategoryReverseParents0(ctx, exec, related, category0)
+	categories1, err := insertCategoryReverseCategories0(ctx, exec, related, category0)
 	if err != nil {
 		return err
 	}
 
-	category0.R.ReverseParents = append(category0.R.ReverseParents, categories1...)
+	category0.R.ReverseCategories = append(category0.R.ReverseCategories, categories1...)
 
 	for _, rel := range categories1 {
-		rel.R.ReverseParents = append(rel.R.ReverseParents, category0)
+		rel.R.ReverseCategories = append(rel.R.ReverseCategories, category0)
 	}
 	return nil
 }
 
-func (category0 *Category) AttachReverseParents(ctx context.Context, exec bob.Executor, related ...*Category) error {
+func (category0 *Category) AttachReverseCategories(ctx context.Context, exec bob.Executor, related ...*Category) error {
 	if len(related) == 0 {
 		return nil
 	}
@@ -686,15 +821,15 @@ func (category0 *Category) AttachReverseParents(ctx context.Context, exec bob.Ex
 	var err error
 	categories1 := CategorySlice(related)
 
-	_, err = attachCategoryReverseParents0(ctx, exec, len(related), categories1, category0)
+	_, err = attachCategoryReverseCategories0(ctx, exec, len(related), categories1, category0)
 	if err != nil {
 		return err
 	}
 
-	category0.R.ReverseParents = append(category0.R.ReverseParents, categories1...)
+	category0.R.ReverseCategories = append(category0.R.ReverseCategories, categories1...)
 
 	for _, rel := range related {
-		rel.R.ReverseParents = append(rel.R.ReverseParents, category0)
+		rel.R.ReverseCategories = append(rel.R.ReverseCategories, category0)
 	}
 
 	return nil
@@ -702,6 +837,7 @@ func (category0 *Category) AttachReverseParents(ctx context.Context, exec bob.Ex
 
 func insertCategoryTransactions0(ctx context.Context, exec bob.Executor, transactions1 []*TransactionSetter, category0 *Category) (TransactionSlice, error) {
 	for i := range transactions1 {
+		transactions1[i].LedgerID = omit.From(category0.LedgerID)
 		transactions1[i].CategoryID = omit.From(category0.ID)
 	}
 
@@ -715,6 +851,7 @@ func insertCategoryTransactions0(ctx context.Context, exec bob.Executor, transac
 
 func attachCategoryTransactions0(ctx context.Context, exec bob.Executor, count int, transactions1 TransactionSlice, category0 *Category) (TransactionSlice, error) {
 	setter := &TransactionSetter{
+		LedgerID:   omit.From(category0.LedgerID),
 		CategoryID: omit.From(category0.ID),
 	}
 
@@ -741,7 +878,7 @@ func (category0 *Category) InsertTransactions(ctx context.Context, exec bob.Exec
 	category0.R.Transactions = append(category0.R.Transactions, transactions1...)
 
 	for _, rel := range transactions1 {
-		_ = rel // Transaction in this package has no R.Category field
+		rel.R.Category = category0
 	}
 	return nil
 }
@@ -762,7 +899,7 @@ func (category0 *Category) AttachTransactions(ctx context.Context, exec bob.Exec
 	category0.R.Transactions = append(category0.R.Transactions, transactions1...)
 
 	for _, rel := range related {
-		_ = rel // Transaction in this package has no R.Category field
+		rel.R.Category = category0
 	}
 
 	return nil
@@ -777,6 +914,8 @@ type categoryWhere[Q psql.Filterable] struct {
 	IsDisabled       psql.WhereMod[Q, bool]
 	CategoryType     psql.WhereMod[Q, int16]
 	CreatedAt        psql.WhereMod[Q, time.Time]
+	LedgerID         psql.WhereMod[Q, uuid.UUID]
+	MonthlyBudget    psql.WhereNullMod[Q, decimal.Decimal]
 }
 
 func (categoryWhere[Q]) AliasedAs(alias string) categoryWhere[Q] {
@@ -793,6 +932,8 @@ func buildCategoryWhere[Q psql.Filterable](cols categoryColumns) categoryWhere[Q
 		IsDisabled:       psql.Where[Q, bool](cols.IsDisabled),
 		CategoryType:     psql.Where[Q, int16](cols.CategoryType),
 		CreatedAt:        psql.Where[Q, time.Time](cols.CreatedAt),
+		LedgerID:         psql.Where[Q, uuid.UUID](cols.LedgerID),
+		MonthlyBudget:    psql.WhereNull[Q, decimal.Decimal](cols.MonthlyBudget),
 	}
 }
 
@@ -802,29 +943,41 @@ func (o *Category) Preload(name string, retrieved any) error {
 	}
 
 	switch name {
-	case "Parent":
+	case "Ledger":
+		rel, ok := retrieved.(*Ledger)
+		if !ok {
+			return fmt.Errorf("category cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Ledger = rel
+
+		if rel != nil {
+			rel.R.Categories = CategorySlice{o}
+		}
+		return nil
+	case "Category":
 		rel, ok := retrieved.(*Category)
 		if !ok {
 			return fmt.Errorf("category cannot load %T as %q", retrieved, name)
 		}
 
-		o.R.Parent = rel
+		o.R.Category = rel
 
 		if rel != nil {
-			rel.R.Parent = o
+			rel.R.Category = o
 		}
 		return nil
-	case "ReverseParents":
+	case "ReverseCategories":
 		rels, ok := retrieved.(CategorySlice)
 		if !ok {
 			return fmt.Errorf("category cannot load %T as %q", retrieved, name)
 		}
 
-		o.R.ReverseParents = rels
+		o.R.ReverseCategories = rels
 
 		for _, rel := range rels {
 			if rel != nil {
-				rel.R.ReverseParents = CategorySlice{o}
+				rel.R.ReverseCategories = CategorySlice{o}
 			}
 		}
 		return nil
@@ -838,7 +991,7 @@ func (o *Category) Preload(name string, retrieved any) error {
 
 		for _, rel := range rels {
 			if rel != nil {
-				_ = rel // Transaction in this package has no R.Category field
+				rel.R.Category = o
 			}
 		}
 		return nil
@@ -848,20 +1001,34 @@ func (o *Category) Preload(name string, retrieved any) error {
 }
 
 type categoryPreloader struct {
-	Parent func(...psql.PreloadOption) psql.Preloader
+	Ledger   func(...psql.PreloadOption) psql.Preloader
+	Category func(...psql.PreloadOption) psql.Preloader
 }
 
 func buildCategoryPreloader() categoryPreloader {
 	return categoryPreloader{
-		Parent: func(opts ...psql.PreloadOption) psql.Preloader {
+		Ledger: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Ledger, LedgerSlice](psql.PreloadRel{
+				Name: "Ledger",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Categories,
+						To:          Ledgers,
+						FromColumns: []string{"ledger_id"},
+						ToColumns:   []string{"id"},
+					},
+				},
+			}, Ledgers.Columns.Names(), opts...)
+		},
+		Category: func(opts ...psql.PreloadOption) psql.Preloader {
 			return psql.Preload[*Category, CategorySlice](psql.PreloadRel{
-				Name: "Parent",
+				Name: "Category",
 				Sides: []psql.PreloadSide{
 					{
 						From:        Categories,
 						To:          Categories,
-						FromColumns: []string{"parent_id"},
-						ToColumns:   []string{"id"},
+						FromColumns: []string{"ledger_id", "parent_id"},
+						ToColumns:   []string{"ledger_id", "id"},
 					},
 				},
 			}, Categories.Columns.Names(), opts...)
@@ -870,33 +1037,43 @@ func buildCategoryPreloader() categoryPreloader {
 }
 
 type categoryThenLoader[Q orm.Loadable] struct {
-	Parent         func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
-	ReverseParents func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
-	Transactions   func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Ledger            func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Category          func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	ReverseCategories func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
+	Transactions      func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
 }
 
 func buildCategoryThenLoader[Q orm.Loadable]() categoryThenLoader[Q] {
-	type ParentLoadInterface interface {
-		LoadParent(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	type LedgerLoadInterface interface {
+		LoadLedger(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 	}
-	type ReverseParentsLoadInterface interface {
-		LoadReverseParents(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	type CategoryLoadInterface interface {
+		LoadCategory(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
+	type ReverseCategoriesLoadInterface interface {
+		LoadReverseCategories(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 	}
 	type TransactionsLoadInterface interface {
 		LoadTransactions(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 	}
 
 	return categoryThenLoader[Q]{
-		Parent: thenLoadBuilder[Q](
-			"Parent",
-			func(ctx context.Context, exec bob.Executor, retrieved ParentLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
-				return retrieved.LoadParent(ctx, exec, mods...)
+		Ledger: thenLoadBuilder[Q](
+			"Ledger",
+			func(ctx context.Context, exec bob.Executor, retrieved LedgerLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadLedger(ctx, exec, mods...)
 			},
 		),
-		ReverseParents: thenLoadBuilder[Q](
-			"ReverseParents",
-			func(ctx context.Context, exec bob.Executor, retrieved ReverseParentsLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
-				return retrieved.LoadReverseParents(ctx, exec, mods...)
+		Category: thenLoadBuilder[Q](
+			"Category",
+			func(ctx context.Context, exec bob.Executor, retrieved CategoryLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadCategory(ctx, exec, mods...)
+			},
+		),
+		ReverseCategories: thenLoadBuilder[Q](
+			"ReverseCategories",
+			func(ctx context.Context, exec bob.Executor, retrieved ReverseCategoriesLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadReverseCategories(ctx, exec, mods...)
 			},
 		),
 		Transactions: thenLoadBuilder[Q](
@@ -908,33 +1085,85 @@ func buildCategoryThenLoader[Q orm.Loadable]() categoryThenLoader[Q] {
 	}
 }
 
-// LoadParent loads the category's Parent into the .R struct
-func (o *Category) LoadParent(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadLedger loads the category's Ledger into the .R struct
+func (o *Category) LoadLedger(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.Parent = nil
+	o.R.Ledger = nil
 
-	related, err := o.Parent(mods...).One(ctx, exec)
+	related, err := o.Ledger(mods...).One(ctx, exec)
 	if err != nil {
 		return err
 	}
 
-	related.R.Parent = o
+	related.R.Categories = CategorySlice{o}
 
-	o.R.Parent = related
+	o.R.Ledger = related
 	return nil
 }
 
-// LoadParent loads the category's Parent into the .R struct
-func (os CategorySlice) LoadParent(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadLedger loads the category's Ledger into the .R struct
+func (os CategorySlice) LoadLedger(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	categories, err := os.Parent(mods...).All(ctx, exec)
+	ledgers, err := os.Ledger(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range ledgers {
+
+			if !(o.LedgerID == rel.ID) {
+				continue
+			}
+
+			rel.R.Categories = append(rel.R.Categories, o)
+
+			o.R.Ledger = rel
+			break
+		}
+	}
+
+	return nil
+}
+
+// LoadCategory loads the category's Category into the .R struct
+func (o *Category) LoadCategory(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Category = nil
+
+	related, err := o.Category(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Category = o
+
+	o.R.Category = related
+	return nil
+}
+
+// LoadCategory loads the category's Category into the .R struct
+func (os CategorySlice) LoadCategory(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	categories, err := os.Category(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -945,6 +1174,10 @@ func (os CategorySlice) LoadParent(ctx context.Context, exec bob.Executor, mods
 		}
 
 		for _, rel := range categories {
+
+			if !(o.LedgerID == rel.LedgerID) {
+				continue
+			}
 			if !o.ParentID.IsValue() {
 				continue
 			}
@@ -953,9 +1186,9 @@ func (os CategorySlice) LoadParent(ctx context.Context, exec bob.Executor, mods
 				continue
 			}
 
-			rel.R.Parent = o
+			rel.R.Category = o
 
-			o.R.Parent = rel
+			o.R.Category = rel
 			break
 		}
 	}
@@ -963,35 +1196,35 @@ func (os CategorySlice) LoadParent(ctx context.Context, exec bob.Executor, mods
 	return nil
 }
 
-// LoadReverseParents loads the category's ReverseParents into the .R struct
-func (o *Category) LoadReverseParents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadReverseCategories loads the category's ReverseCategories into the .R struct
+func (o *Category) LoadReverseCategories(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
 		return nil
 	}
 
 	// Reset the relationship
-	o.R.ReverseParents = nil
+	o.R.ReverseCategories = nil
 
-	related, err := o.ReverseParents(mods...).All(ctx, exec)
+	related, err := o.ReverseCategories(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
 
 	for _, rel := range related {
-		rel.R.ReverseParents = CategorySlice{o}
+		rel.R.ReverseCategories = CategorySlice{o}
 	}
 
-	o.R.ReverseParents = related
+	o.R.ReverseCategories = related
 	return nil
 }
 
-// LoadReverseParents loads the category's ReverseParents into the .R struct
-func (os CategorySlice) LoadReverseParents(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+// LoadReverseCategories loads the category's ReverseCategories into the .R struct
+func (os CategorySlice) LoadReverseCategories(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if len(os) == 0 {
 		return nil
 	}
 
-	categories, err := os.ReverseParents(mods...).All(ctx, exec)
+	categories, err := os.ReverseCategories(mods...).All(ctx, exec)
 	if err != nil {
 		return err
 	}
@@ -1001,7 +1234,7 @@ func (os CategorySlice) LoadReverseParents(ctx context.Context, exec bob.Executo
 			continue
 		}
 
-		o.R.ReverseParents = nil
+		o.R.ReverseCategories = nil
 	}
 
 	for _, o := range os {
@@ -1011,6 +1244,10 @@ func (os CategorySlice) LoadReverseParents(ctx context.Context, exec bob.Executo
 
 		for _, rel := range categories {
 
+			if !(o.LedgerID == rel.LedgerID) {
+				continue
+			}
+
 			if !rel.ParentID.IsValue() {
 				continue
 			}
@@ -1018,9 +1255,9 @@ func (os CategorySlice) LoadReverseParents(ctx context.Context, exec bob.Executo
 				continue
 			}
 
-			rel.R.ReverseParents = append(rel.R.ReverseParents, o)
+			rel.R.ReverseCategories = append(rel.R.ReverseCategories, o)
 
-			o.R.ReverseParents = append(o.R.ReverseParents, rel)
+			o.R.ReverseCategories = append(o.R.ReverseCategories, rel)
 		}
 	}
 
@@ -1042,7 +1279,7 @@ func (o *Category) LoadTransactions(ctx context.Context, exec bob.Executor, mods
 	}
 
 	for _, rel := range related {
-		_ = rel // Transaction in this package has no R.Category field
+		rel.R.Category = o
 	}
 
 	o.R.Transactions = related
@@ -1075,11 +1312,15 @@ func (os CategorySlice) LoadTransactions(ctx context.Context, exec bob.Executor,
 
 		for _, rel := range transactions {
 
+			if !(o.LedgerID == rel.LedgerID) {
+				continue
+			}
+
 			if !(o.ID == rel.CategoryID) {
 				continue
 			}
 
-			_ = rel // Transaction in this package has no R.Category field
+			rel.R.Category = o
 
 			o.R.Transactions = append(o.R.Transactions, rel)
 		}
@@ -1089,10 +1330,11 @@ func (os CategorySlice) LoadTransactions(ctx context.Context, exec bob.Executor,
 }
 
 type categoryJoins[Q dialect.Joinable] struct {
-	typ            string
-	Parent         modAs[Q, categoryColumns]
-	ReverseParents modAs[Q, categoryColumns]
-	Transactions   modAs[Q, transactionColumns]
+	typ               string
+	Ledger            modAs[Q, ledgerColumns]
+	Category          modAs[Q, categoryColumns]
+	ReverseCategories modAs[Q, categoryColumns]
+	Transactions      modAs[Q, transactionColumns]
 }
 
 func (j categoryJoins[Q]) aliasedAs(alias string) categoryJoins[Q] {
@@ -1102,28 +1344,42 @@ func (j categoryJoins[Q]) aliasedAs(alias string) categoryJoins[Q] {
 func buildCategoryJoins[Q dialect.Joinable](cols categoryColumns, typ string) categoryJoins[Q] {
 	return categoryJoins[Q]{
 		typ: typ,
-		Parent: modAs[Q, categoryColumns]{
+		Ledger: modAs[Q, ledgerColumns]{
+			c: Ledgers.Columns,
+			f: func(to ledgerColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Ledgers.Name().As(to.Alias())).On(
+						to.ID.EQ(cols.LedgerID),
+					))
+				}
+
+				return mods
+			},
+		},
+		Category: modAs[Q, categoryColumns]{
 			c: Categories.Columns,
 			f: func(to categoryColumns) bob.Mod[Q] {
 				mods := make(mods.QueryMods[Q], 0, 1)
 
 				{
 					mods = append(mods, dialect.Join[Q](typ, Categories.Name().As(to.Alias())).On(
-						to.ID.EQ(cols.ParentID),
+						to.LedgerID.EQ(cols.LedgerID), to.ID.EQ(cols.ParentID),
 					))
 				}
 
 				return mods
 			},
 		},
-		ReverseParents: modAs[Q, categoryColumns]{
+		ReverseCategories: modAs[Q, categoryColumns]{
 			c: Categories.Columns,
 			f: func(to categoryColumns) bob.Mod[Q] {
 				mods := make(mods.QueryMods[Q], 0, 1)
 
 				{
 					mods = append(mods, dialect.Join[Q](typ, Categories.Name().As(to.Alias())).On(
-						to.ParentID.EQ(cols.ID),
+						to.LedgerID.EQ(cols.LedgerID), to.ParentID.EQ(cols.ID),
 					))
 				}
 
@@ -1137,7 +1393,7 @@ func buildCategoryJoins[Q dialect.Joinable](cols categoryColumns, typ string) ca
 
 				{
 					mods = append(mods, dialect.Join[Q](typ, Transactions.Name().As(to.Alias())).On(
-						to.CategoryID.EQ(cols.ID),
+						to.LedgerID.EQ(cols.LedgerID), to.CategoryID.EQ(cols.ID),
 					))
 				}
 
@@ -10,8 +10,17 @@ var AccountErrors = &accountErrors{
 		columns: []string{"id"},
 		s:       "accounts_pkey",
 	},
+
+	ErrUniqueAccountsLedgerIdIdKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "accounts",
+		columns: []string{"ledger_id", "id"},
+		s:       "accounts_ledger_id_id_key",
+	},
 }
 
 type accountErrors struct {
 	ErrUniqueAccountsPkey *UniqueConstraintError
+
+	ErrUniqueAccountsLedgerIdIdKey *UniqueConstraintError
 }
@@ -10,8 +10,17 @@ var CategoryErrors = &categoryErrors{
 		columns: []string{"id"},
 		s:       "categories_pkey",
 	},
+
+	ErrUniqueCategoriesLedgerIdIdKey: &UniqueConstraintError{
+		schema:  "",
+		table:   "categories",
+		columns: []string{"ledger_id", "id"},
+		s:       "categories_ledger_id_id_key",
+	},
 }
 
 type categoryErrors struct {
 	ErrUniqueCategoriesPkey *UniqueConstraintError
+
+	ErrUniqueCategoriesLedgerIdIdKey *UniqueConstraintError
 }
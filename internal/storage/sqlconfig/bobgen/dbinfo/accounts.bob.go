@@ -78,6 +78,15 @@ var Accounts = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		LedgerID: column{
+			Name:      "ledger_id",
+			DBType:    "uuid",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: accountIndexes{
 		AccountsPkey: index{
@@ -97,12 +106,79 @@ var Accounts = Table[
 			Where:         "",
 			Include:       []string{},
 		},
+		AccountsLedgerIDIDKey: index{
+			Type: "btree",
+			Name: "accounts_ledger_id_id_key",
+			Columns: []indexColumn{
+				{
+					Name:         "ledger_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxAccountsLedgerIDNameID: index{
+			Type: "btree",
+			Name: "idx_accounts_ledger_id_name_id",
+			Columns: []indexColumn{
+				{
+					Name:         "ledger_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "name",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false, false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
 	},
 	PrimaryKey: &constraint{
 		Name:    "accounts_pkey",
 		Columns: []string{"id"},
 		Comment: "",
 	},
+	ForeignKeys: accountForeignKeys{
+		AccountsFKAccountsLedgerID: foreignKey{
+			constraint: constraint{
+				Name:    "accounts.fk_accounts_ledger_id",
+				Columns: []string{"ledger_id"},
+				Comment: "",
+			},
+			ForeignTable:   "ledgers",
+			ForeignColumns: []string{"id"},
+		},
+	},
+	Uniques: accountUniques{
+		AccountsLedgerIDIDKey: constraint{
+			Name:    "accounts_ledger_id_id_key",
+			Columns: []string{"ledger_id", "id"},
+			Comment: "",
+		},
+	},
 
 	Comment: "",
 }
@@ -115,34 +191,45 @@ type accountColumns struct {
 	Balance         column
 	StartingBalance column
 	CreatedAt       column
+	LedgerID        column
 }
 
 func (c accountColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.Name, c.Type, c.SubType, c.Balance, c.StartingBalance, c.CreatedAt,
+		c.ID, c.Name, c.Type, c.SubType, c.Balance, c.StartingBalance, c.CreatedAt, c.LedgerID,
 	}
 }
 
 type accountIndexes struct {
-	AccountsPkey index
+	AccountsPkey              index
+	AccountsLedgerIDIDKey     index
+	IdxAccountsLedgerIDNameID index
 }
 
 func (i accountIndexes) AsSlice() []index {
 	return []index{
-		i.AccountsPkey,
+		i.AccountsPkey, i.AccountsLedgerIDIDKey, i.IdxAccountsLedgerIDNameID,
 	}
 }
 
-type accountForeignKeys struct{}
+type accountForeignKeys struct {
+	AccountsFKAccountsLedgerID foreignKey
+}
 
 func (f accountForeignKeys) AsSlice() []foreignKey {
-	return []foreignKey{}
+	return []foreignKey{
+		f.AccountsFKAccountsLedgerID,
+	}
 }
 
-type accountUniques struct{}
+type accountUniques struct {
+	AccountsLedgerIDIDKey constraint
+}
 
 func (u accountUniques) AsSlice() []constraint {
-	return []constraint{}
+	return []constraint{
+		u.AccountsLedgerIDIDKey,
+	}
 }
 
 type accountChecks struct{}
@@ -87,6 +87,24 @@ var Categories = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		LedgerID: column{
+			Name:      "ledger_id",
+			DBType:    "uuid",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		MonthlyBudget: column{
+			Name:      "monthly_budget",
+			DBType:    "numeric",
+			Default:   "NULL",
+			Comment:   "",
+			Nullable:  true,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: categoryIndexes{
 		CategoriesPkey: index{
@@ -106,6 +124,55 @@ var Categories = Table[
 			Where:         "",
 			Include:       []string{},
 		},
+		CategoriesLedgerIDIDKey: index{
+			Type: "btree",
+			Name: "categories_ledger_id_id_key",
+			Columns: []indexColumn{
+				{
+					Name:         "ledger_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        true,
+			Comment:       "",
+			NullsFirst:    []bool{false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
+		IdxCategoriesLedgerIDNameID: index{
+			Type: "btree",
+			Name: "idx_categories_ledger_id_name_id",
+			Columns: []indexColumn{
+				{
+					Name:         "ledger_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "name",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false, false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
 	},
 	PrimaryKey: &constraint{
 		Name:    "categories_pkey",
@@ -113,14 +180,30 @@ var Categories = Table[
 		Comment: "",
 	},
 	ForeignKeys: categoryForeignKeys{
+		CategoriesFKCategoriesLedgerID: foreignKey{
+			constraint: constraint{
+				Name:    "categories.fk_categories_ledger_id",
+				Columns: []string{"ledger_id"},
+				Comment: "",
+			},
+			ForeignTable:   "ledgers",
+			ForeignColumns: []string{"id"},
+		},
 		CategoriesFKCategoriesParent: foreignKey{
 			constraint: constraint{
 				Name:    "categories.fk_categories_parent",
-				Columns: []string{"parent_id"},
+				Columns: []string{"ledger_id", "parent_id"},
 				Comment: "",
 			},
 			ForeignTable:   "categories",
-			ForeignColumns: []string{"id"},
+			ForeignColumns: []string{"ledger_id", "id"},
+		},
+	},
+	Uniques: categoryUniques{
+		CategoriesLedgerIDIDKey: constraint{
+			Name:    "categories_ledger_id_id_key",
+			Columns: []string{"ledger_id", "id"},
+			Comment: "",
 		},
 	},
 
@@ -136,38 +219,47 @@ type categoryColumns struct {
 	IsDisabled       column
 	CategoryType     column
 	CreatedAt        column
+	LedgerID         column
+	MonthlyBudget    column
 }
 
 func (c categoryColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.Name, c.IsGroup, c.ParentID, c.ShouldBeBudgeted, c.IsDisabled, c.CategoryType, c.CreatedAt,
+		c.ID, c.Name, c.IsGroup, c.ParentID, c.ShouldBeBudgeted, c.IsDisabled, c.CategoryType, c.CreatedAt, c.LedgerID, c.MonthlyBudget,
 	}
 }
 
 type categoryIndexes struct {
-	CategoriesPkey index
+	CategoriesPkey              index
+	CategoriesLedgerIDIDKey     index
+	IdxCategoriesLedgerIDNameID index
 }
 
 func (i categoryIndexes) AsSlice() []index {
 	return []index{
-		i.CategoriesPkey,
+		i.CategoriesPkey, i.CategoriesLedgerIDIDKey, i.IdxCategoriesLedgerIDNameID,
 	}
 }
 
 type categoryForeignKeys struct {
-	CategoriesFKCategoriesParent foreignKey
+	CategoriesFKCategoriesLedgerID foreignKey
+	CategoriesFKCategoriesParent   foreignKey
 }
 
 func (f categoryForeignKeys) AsSlice() []foreignKey {
 	return []foreignKey{
-		f.CategoriesFKCategoriesParent,
+		f.CategoriesFKCategoriesLedgerID, f.CategoriesFKCategoriesParent,
 	}
 }
 
-type categoryUniques struct{}
+type categoryUniques struct {
+	CategoriesLedgerIDIDKey constraint
+}
 
 func (u categoryUniques) AsSlice() []constraint {
-	return []constraint{}
+	return []constraint{
+		u.CategoriesLedgerIDIDKey,
+	}
 }
 
 type categoryChecks struct{}
@@ -78,6 +78,24 @@ var Transactions = Table[
 			Generated: false,
 			AutoIncr:  false,
 		},
+		IsRefund: column{
+			Name:      "is_refund",
+			DBType:    "boolean",
+			Default:   "false",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
+		LedgerID: column{
+			Name:      "ledger_id",
+			DBType:    "uuid",
+			Default:   "",
+			Comment:   "",
+			Nullable:  false,
+			Generated: false,
+			AutoIncr:  false,
+		},
 	},
 	Indexes: transactionIndexes{
 		TransactionsPkey: index{
@@ -97,6 +115,33 @@ var Transactions = Table[
 			Where:         "",
 			Include:       []string{},
 		},
+		IdxTransactionsLedgerIDCreatedAtID: index{
+			Type: "btree",
+			Name: "idx_transactions_ledger_id_created_at_id",
+			Columns: []indexColumn{
+				{
+					Name:         "ledger_id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "created_at",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+				{
+					Name:         "id",
+					Desc:         null.FromCond(false, true),
+					IsExpression: false,
+				},
+			},
+			Unique:        false,
+			Comment:       "",
+			NullsFirst:    []bool{false, false, false},
+			NullsDistinct: false,
+			Where:         "",
+			Include:       []string{},
+		},
 	},
 	PrimaryKey: &constraint{
 		Name:    "transactions_pkey",
@@ -104,14 +149,23 @@ var Transactions = Table[
 		Comment: "",
 	},
 	ForeignKeys: transactionForeignKeys{
+		TransactionsFKTransactionsAccountID: foreignKey{
+			constraint: constraint{
+				Name:    "transactions.fk_transactions_account_id",
+				Columns: []string{"ledger_id", "account_id"},
+				Comment: "",
+			},
+			ForeignTable:   "accounts",
+			ForeignColumns: []string{"ledger_id", "id"},
+		},
 		TransactionsFKTransactionsCategoryID: foreignKey{
 			constraint: constraint{
 				Name:    "transactions.fk_transactions_category_id",
-				Columns: []string{"category_id"},
+				Columns: []string{"ledger_id", "category_id"},
 				Comment: "",
 			},
 			ForeignTable:   "categories",
-			ForeignColumns: []string{"id"},
+			ForeignColumns: []string{"ledger_id", "id"},
 		},
 	},
 
@@ -126,31 +180,35 @@ type transactionColumns struct {
 	TransactionName column
 	TransactionDate column
 	CreatedAt       column
+	IsRefund        column
+	LedgerID        column
 }
 
 func (c transactionColumns) AsSlice() []column {
 	return []column{
-		c.ID, c.AccountID, c.CategoryID, c.Amount, c.TransactionName, c.TransactionDate, c.CreatedAt,
+		c.ID, c.AccountID, c.CategoryID, c.Amount, c.TransactionName, c.TransactionDate, c.CreatedAt, c.IsRefund, c.LedgerID,
 	}
 }
 
 type transactionIndexes struct {
-	TransactionsPkey index
+	TransactionsPkey                   index
+	IdxTransactionsLedgerIDCreatedAtID index
 }
 
 func (i transactionIndexes) AsSlice() []index {
 	return []index{
-		i.TransactionsPkey,
+		i.TransactionsPkey, i.IdxTransactionsLedgerIDCreatedAtID,
 	}
 }
 
 type transactionForeignKeys struct {
+	TransactionsFKTransactionsAccountID  foreignKey
 	TransactionsFKTransactionsCategoryID foreignKey
 }
 
 func (f transactionForeignKeys) AsSlice() []foreignKey {
 	return []foreignKey{
-		f.TransactionsFKTransactionsCategoryID,
+		f.TransactionsFKTransactionsAccountID, f.TransactionsFKTransactionsCategoryID,
 	}
 }
 
@@ -33,6 +33,8 @@ type Transaction struct {
 	TransactionName string          `db:"transaction_name" `
 	TransactionDate time.Time       `db:"transaction_date" `
 	CreatedAt       time.Time       `db:"created_at" `
+	IsRefund        bool            `db:"is_refund" `
+	LedgerID        uuid.UUID       `db:"ledger_id" `
 
 	R transactionR `db:"-" `
 }
@@ -49,13 +51,14 @@ type TransactionsQuery = *psql.ViewQuery[*Transaction, TransactionSlice]
 
 // transactionR is where relationships are stored.
 type transactionR struct {
+	Account  *Account  // transactions.fk_transactions_account_id
 	Category *Category // transactions.fk_transactions_category_id
 }
 
 func buildTransactionColumns(alias string) transactionColumns {
 	return transactionColumns{
 		ColumnsExpr: expr.NewColumnsExpr(
-			"id", "account_id", "category_id", "amount", "transaction_name", "transaction_date", "created_at",
+			"id", "account_id", "category_id", "amount", "transaction_name", "transaction_date", "created_at", "is_refund", "ledger_id",
 		).WithParent("transactions"),
 		tableAlias:      alias,
 		ID:              psql.Quote(alias, "id"),
@@ -65,6 +68,8 @@ func buildTransactionColumns(alias string) transactionColumns {
 		TransactionName: psql.Quote(alias, "transaction_name"),
 		TransactionDate: psql.Quote(alias, "transaction_date"),
 		CreatedAt:       psql.Quote(alias, "created_at"),
+		IsRefund:        psql.Quote(alias, "is_refund"),
+		LedgerID:        psql.Quote(alias, "ledger_id"),
 	}
 }
 
@@ -78,6 +83,8 @@ type transactionColumns struct {
 	TransactionName psql.Expression
 	TransactionDate psql.Expression
 	CreatedAt       psql.Expression
+	IsRefund        psql.Expression
+	LedgerID        psql.Expression
 }
 
 func (c transactionColumns) Alias() string {
@@ -99,10 +106,12 @@ type TransactionSetter struct {
 	TransactionName omit.Val[string]          `db:"transaction_name" `
 	TransactionDate omit.Val[time.Time]       `db:"transaction_date" `
 	CreatedAt       omit.Val[time.Time]       `db:"created_at" `
+	IsRefund        omit.Val[bool]            `db:"is_refund" `
+	LedgerID        omit.Val[uuid.UUID]       `db:"ledger_id" `
 }
 
 func (s TransactionSetter) SetColumns() []string {
-	vals := make([]string, 0, 7)
+	vals := make([]string, 0, 9)
 	if s.ID.IsValue() {
 		vals = append(vals, "id")
 	}
@@ -124,6 +133,12 @@ func (s TransactionSetter) SetColumns() []string {
 	if s.CreatedAt.IsValue() {
 		vals = append(vals, "created_at")
 	}
+	if s.IsRefund.IsValue() {
+		vals = append(vals, "is_refund")
+	}
+	if s.LedgerID.IsValue() {
+		vals = append(vals, "ledger_id")
+	}
 	return vals
 }
 
@@ -149,6 +164,12 @@ func (s TransactionSetter) Overwrite(t *Transaction) {
 	if s.CreatedAt.IsValue() {
 		t.CreatedAt = s.CreatedAt.MustGet()
 	}
+	if s.IsRefund.IsValue() {
+		t.IsRefund = s.IsRefund.MustGet()
+	}
+	if s.LedgerID.IsValue() {
+		t.LedgerID = s.LedgerID.MustGet()
+	}
 }
 
 func (s *TransactionSetter) Apply(q *dialect.InsertQuery) {
@@ -157,7 +178,7 @@ func (s *TransactionSetter) Apply(q *dialect.InsertQuery) {
 	})
 
 	q.AppendValues(bob.ExpressionFunc(func(ctx context.Context, w io.StringWriter, d bob.Dialect, start int) ([]any, error) {
-		vals := make([]bob.Expression, 7)
+		vals := make([]bob.Expression, 9)
 		if s.ID.IsValue() {
 			vals[0] = psql.Arg(s.ID.MustGet())
 		} else {
@@ -200,6 +221,18 @@ func (s *TransactionSetter) Apply(q *dialect.InsertQuery) {
 			vals[6] = psql.Raw("DEFAULT")
 		}
 
+		if s.IsRefund.IsValue() {
+			vals[7] = psql.Arg(s.IsRefund.MustGet())
+		} else {
+			vals[7] = psql.Raw("DEFAULT")
+		}
+
+		if s.LedgerID.IsValue() {
+			vals[8] = psql.Arg(s.LedgerID.MustGet())
+		} else {
+			vals[8] = psql.Raw("DEFAULT")
+		}
+
 		return bob.ExpressSlice(ctx, w, d, start, vals, "", ", ", "")
 	}))
 }
@@ -209,7 +242,7 @@ func (s TransactionSetter) UpdateMod() bob.Mod[*dialect.UpdateQuery] {
 }
 
 func (s TransactionSetter) Expressions(prefix ...string) []bob.Expression {
-	exprs := make([]bob.Expression, 0, 7)
+	exprs := make([]bob.Expression, 0, 9)
 
 	if s.ID.IsValue() {
 		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
@@ -260,6 +293,20 @@ func (s TransactionSetter) Expressions(prefix ...string) []bob.Expression {
 		}})
 	}
 
+	if s.IsRefund.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "is_refund")...),
+			psql.Arg(s.IsRefund),
+		}})
+	}
+
+	if s.LedgerID.IsValue() {
+		exprs = append(exprs, expr.Join{Sep: " = ", Exprs: []bob.Expression{
+			psql.Quote(append(prefix, "ledger_id")...),
+			psql.Arg(s.LedgerID),
+		}})
+	}
+
 	return exprs
 }
 
@@ -486,32 +533,114 @@ func (o TransactionSlice) ReloadAll(ctx context.Context, exec bob.Executor) erro
 	return nil
 }
 
+// Account starts a query for related objects on accounts
+func (o *Transaction) Account(mods ...bob.Mod[*dialect.SelectQuery]) AccountsQuery {
+	return Accounts.Query(append(mods,
+		sm.Where(Accounts.Columns.LedgerID.EQ(psql.Arg(o.LedgerID))), sm.Where(Accounts.Columns.ID.EQ(psql.Arg(o.AccountID))),
+	)...)
+}
+
+func (os TransactionSlice) Account(mods ...bob.Mod[*dialect.SelectQuery]) AccountsQuery {
+	pkLedgerID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+
+	pkAccountID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+		pkLedgerID = append(pkLedgerID, o.LedgerID)
+		pkAccountID = append(pkAccountID, o.AccountID)
+	}
+	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkLedgerID), "uuid[]")),
+		psql.F("unnest", psql.Cast(psql.Arg(pkAccountID), "uuid[]")),
+	))
+
+	return Accounts.Query(append(mods,
+		sm.Where(psql.Group(Accounts.Columns.LedgerID, Accounts.Columns.ID).OP("IN", PKArgExpr)),
+	)...)
+}
+
 // Category starts a query for related objects on categories
 func (o *Transaction) Category(mods ...bob.Mod[*dialect.SelectQuery]) CategoriesQuery {
 	return Categories.Query(append(mods,
-		sm.Where(Categories.Columns.ID.EQ(psql.Arg(o.CategoryID))),
+		sm.Where(Categories.Columns.LedgerID.EQ(psql.Arg(o.LedgerID))), sm.Where(Categories.Columns.ID.EQ(psql.Arg(o.CategoryID))),
 	)...)
 }
 
 func (os TransactionSlice) Category(mods ...bob.Mod[*dialect.SelectQuery]) CategoriesQuery {
+	pkLedgerID := make(pgtypes.Array[uuid.UUID], 0, len(os))
+
 	pkCategoryID := make(pgtypes.Array[uuid.UUID], 0, len(os))
 	for _, o := range os {
 		if o == nil {
 			continue
 		}
+		pkLedgerID = append(pkLedgerID, o.LedgerID)
 		pkCategoryID = append(pkCategoryID, o.CategoryID)
 	}
 	PKArgExpr := psql.Select(sm.Columns(
+		psql.F("unnest", psql.Cast(psql.Arg(pkLedgerID), "uuid[]")),
 		psql.F("unnest", psql.Cast(psql.Arg(pkCategoryID), "uuid[]")),
 	))
 
 	return Categories.Query(append(mods,
-		sm.Where(psql.Group(Categories.Columns.ID).OP("IN", PKArgExpr)),
+		sm.Where(psql.Group(Categories.Columns.LedgerID, Categories.Columns.ID).OP("IN", PKArgExpr)),
 	)...)
 }
 
+func attachTransactionAccount0(ctx context.Context, exec bob.Executor, count int, transaction0 *Transaction, account1 *Account) (*Transaction, error) {
+	setter := &TransactionSetter{
+		LedgerID:  omit.From(account1.LedgerID),
+		AccountID: omit.From(account1.ID),
+	}
+
+	err := transaction0.Update(ctx, exec, setter)
+	if err != nil {
+		return nil, fmt.Errorf("attachTransactionAccount0: %w", err)
+	}
+
+	return transaction0, nil
+}
+
+func (transaction0 *Transaction) InsertAccount(ctx context.Context, exec bob.Executor, related *AccountSetter) error {
+	var err error
+
+	account1, err := Accounts.Insert(related).One(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("inserting related objects: %w", err)
+	}
+
+	_, err = attachTransactionAccount0(ctx, exec, 1, transaction0, account1)
+	if err != nil {
+		return err
+	}
+
+	transaction0.R.Account = account1
+
+	account1.R.Transactions = append(account1.R.Transactions, transaction0)
+
+	return nil
+}
+
+func (transaction0 *Transaction) AttachAccount(ctx context.Context, exec bob.Executor, account1 *Account) error {
+	var err error
+
+	_, err = attachTransactionAccount0(ctx, exec, 1, transaction0, account1)
+	if err != nil {
+		return err
+	}
+
+	transaction0.R.Account = account1
+
+	account1.R.Transactions = append(account1.R.Transactions, transaction0)
+
+	return nil
+}
+
 func attachTransactionCategory0(ctx context.Context, exec bob.Executor, count int, transaction0 *Transaction, category1 *Category) (*Transaction, error) {
 	setter := &TransactionSetter{
+		LedgerID:   omit.From(category1.LedgerID),
 		CategoryID: omit.From(category1.ID),
 	}
 
@@ -566,6 +695,8 @@ type transactionWhere[Q psql.Filterable] struct {
 	TransactionName psql.WhereMod[Q, string]
 	TransactionDate psql.WhereMod[Q, time.Time]
 	CreatedAt       psql.WhereMod[Q, time.Time]
+	IsRefund        psql.WhereMod[Q, bool]
+	LedgerID        psql.WhereMod[Q, uuid.UUID]
 }
 
 func (transactionWhere[Q]) AliasedAs(alias string) transactionWhere[Q] {
@@ -581,6 +712,8 @@ func buildTransactionWhere[Q psql.Filterable](cols transactionColumns) transacti
 		TransactionName: psql.Where[Q, string](cols.TransactionName),
 		TransactionDate: psql.Where[Q, time.Time](cols.TransactionDate),
 		CreatedAt:       psql.Where[Q, time.Time](cols.CreatedAt),
+		IsRefund:        psql.Where[Q, bool](cols.IsRefund),
+		LedgerID:        psql.Where[Q, uuid.UUID](cols.LedgerID),
 	}
 }
 
@@ -590,6 +723,18 @@ func (o *Transaction) Preload(name string, retrieved any) error {
 	}
 
 	switch name {
+	case "Account":
+		rel, ok := retrieved.(*Account)
+		if !ok {
+			return fmt.Errorf("transaction cannot load %T as %q", retrieved, name)
+		}
+
+		o.R.Account = rel
+
+		if rel != nil {
+			rel.R.Transactions = TransactionSlice{o}
+		}
+		return nil
 	case "Category":
 		rel, ok := retrieved.(*Category)
 		if !ok {
@@ -608,11 +753,25 @@ func (o *Transaction) Preload(name string, retrieved any) error {
 }
 
 type transactionPreloader struct {
+	Account  func(...psql.PreloadOption) psql.Preloader
 	Category func(...psql.PreloadOption) psql.Preloader
 }
 
 func buildTransactionPreloader() transactionPreloader {
 	return transactionPreloader{
+		Account: func(opts ...psql.PreloadOption) psql.Preloader {
+			return psql.Preload[*Account, AccountSlice](psql.PreloadRel{
+				Name: "Account",
+				Sides: []psql.PreloadSide{
+					{
+						From:        Transactions,
+						To:          Accounts,
+						FromColumns: []string{"ledger_id", "account_id"},
+						ToColumns:   []string{"ledger_id", "id"},
+					},
+				},
+			}, Accounts.Columns.Names(), opts...)
+		},
 		Category: func(opts ...psql.PreloadOption) psql.Preloader {
 			return psql.Preload[*Category, CategorySlice](psql.PreloadRel{
 				Name: "Category",
@@ -620,8 +779,8 @@ func buildTransactionPreloader() transactionPreloader {
 					{
 						From:        Transactions,
 						To:          Categories,
-						FromColumns: []string{"category_id"},
-						ToColumns:   []string{"id"},
+						FromColumns: []string{"ledger_id", "category_id"},
+						ToColumns:   []string{"ledger_id", "id"},
 					},
 				},
 			}, Categories.Columns.Names(), opts...)
@@ -630,15 +789,25 @@ func buildTransactionPreloader() transactionPreloader {
 }
 
 type transactionThenLoader[Q orm.Loadable] struct {
+	Account  func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
 	Category func(...bob.Mod[*dialect.SelectQuery]) orm.Loader[Q]
 }
 
 func buildTransactionThenLoader[Q orm.Loadable]() transactionThenLoader[Q] {
+	type AccountLoadInterface interface {
+		LoadAccount(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
+	}
 	type CategoryLoadInterface interface {
 		LoadCategory(context.Context, bob.Executor, ...bob.Mod[*dialect.SelectQuery]) error
 	}
 
 	return transactionThenLoader[Q]{
+		Account: thenLoadBuilder[Q](
+			"Account",
+			func(ctx context.Context, exec bob.Executor, retrieved AccountLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
+				return retrieved.LoadAccount(ctx, exec, mods...)
+			},
+		),
 		Category: thenLoadBuilder[Q](
 			"Category",
 			func(ctx context.Context, exec bob.Executor, retrieved CategoryLoadInterface, mods ...bob.Mod[*dialect.SelectQuery]) error {
@@ -648,6 +817,62 @@ func buildTransactionThenLoader[Q orm.Loadable]() transactionThenLoader[Q] {
 	}
 }
 
+// LoadAccount loads the transaction's Account into the .R struct
+func (o *Transaction) LoadAccount(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if o == nil {
+		return nil
+	}
+
+	// Reset the relationship
+	o.R.Account = nil
+
+	related, err := o.Account(mods...).One(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	related.R.Transactions = TransactionSlice{o}
+
+	o.R.Account = related
+	return nil
+}
+
+// LoadAccount loads the transaction's Account into the .R struct
+func (os TransactionSlice) LoadAccount(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
+	if len(os) == 0 {
+		return nil
+	}
+
+	accounts, err := os.Account(mods...).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range os {
+		if o == nil {
+			continue
+		}
+
+		for _, rel := range accounts {
+
+			if !(o.LedgerID == rel.LedgerID) {
+				continue
+			}
+
+			if !(o.AccountID == rel.ID) {
+				continue
+			}
+
+			rel.R.Transactions = append(rel.R.Transactions, o)
+
+			o.R.Account = rel
+			break
+		}
+	}
+
+	return nil
+}
+
 // LoadCategory loads the transaction's Category into the .R struct
 func (o *Transaction) LoadCategory(ctx context.Context, exec bob.Executor, mods ...bob.Mod[*dialect.SelectQuery]) error {
 	if o == nil {
@@ -686,6 +911,10 @@ func (os TransactionSlice) LoadCategory(ctx context.Context, exec bob.Executor,
 
 		for _, rel := range categories {
 
+			if !(o.LedgerID == rel.LedgerID) {
+				continue
+			}
+
 			if !(o.CategoryID == rel.ID) {
 				continue
 			}
@@ -702,6 +931,7 @@ func (os TransactionSlice) LoadCategory(ctx context.Context, exec bob.Executor,
 
 type transactionJoins[Q dialect.Joinable] struct {
 	typ      string
+	Account  modAs[Q, accountColumns]
 	Category modAs[Q, categoryColumns]
 }
 
@@ -712,6 +942,20 @@ func (j transactionJoins[Q]) aliasedAs(alias string) transactionJoins[Q] {
 func buildTransactionJoins[Q dialect.Joinable](cols transactionColumns, typ string) transactionJoins[Q] {
 	return transactionJoins[Q]{
 		typ: typ,
+		Account: modAs[Q, accountColumns]{
+			c: Accounts.Columns,
+			f: func(to accountColumns) bob.Mod[Q] {
+				mods := make(mods.QueryMods[Q], 0, 1)
+
+				{
+					mods = append(mods, dialect.Join[Q](typ, Accounts.Name().As(to.Alias())).On(
+						to.LedgerID.EQ(cols.LedgerID), to.ID.EQ(cols.AccountID),
+					))
+				}
+
+				return mods
+			},
+		},
 		Category: modAs[Q, categoryColumns]{
 			c: Categories.Columns,
 			f: func(to categoryColumns) bob.Mod[Q] {
@@ -719,7 +963,7 @@ func buildTransactionJoins[Q dialect.Joinable](cols transactionColumns, typ stri
 
 				{
 					mods = append(mods, dialect.Join[Q](typ, Categories.Name().As(to.Alias())).On(
-						to.ID.EQ(cols.CategoryID),
+						to.LedgerID.EQ(cols.LedgerID), to.ID.EQ(cols.CategoryID),
 					))
 				}
 
@@ -2,12 +2,15 @@ package storage
 
 import (
 	"context"
-	"log"
+	"database/sql"
+	"errors"
 
-	_ "github.com/lib/pq"
 	"github.com/stephenafamo/bob"
 
 	"github.com/carson-networks/budget-server/internal/config"
+	"github.com/carson-networks/budget-server/internal/storage/idempotency"
+	"github.com/carson-networks/budget-server/internal/storage/outbox"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
 )
 
 type Storage struct {
@@ -18,8 +21,10 @@ func (s *Storage) Read() *Reader {
 	return NewReader(s.sql)
 }
 
-func (s *Storage) Write(ctx context.Context) (*Writer, error) {
-	tx, err := s.sql.Begin(ctx)
+// Write begins a transaction at the given isolation level;
+// sql.LevelDefault uses the database default.
+func (s *Storage) Write(ctx context.Context, isolation sql.IsolationLevel) (*Writer, error) {
+	tx, err := s.sql.BeginTx(ctx, &sql.TxOptions{Isolation: isolation})
 	if err != nil {
 		return nil, err
 	}
@@ -28,15 +33,51 @@ func (s *Storage) Write(ctx context.Context) (*Writer, error) {
 	return &w, nil
 }
 
-func NewStorage(env *config.Config) *Storage {
-	connStr := "postgres://" + env.PostgresUsername + ":" +
-		env.PostgresPassword + "@" + env.PostgresAddress + ":" +
-		env.PostgresPort + "/" + env.PostgresDB + "?sslmode=disable"
+// Ping checks that the database accepts connections.
+func (s *Storage) Ping(ctx context.Context) error {
+	return s.sql.PingContext(ctx)
+}
 
-	db, err := bob.Open("postgres", connStr)
-	if err != nil {
-		log.Fatal(err)
+// MigrationVersion is the schema version recorded by the migration tool,
+// and whether a migration to it failed part way. An unmigrated database is
+// at version 0.
+func (s *Storage) MigrationVersion(ctx context.Context) (version uint, dirty bool, err error) {
+	err = s.sql.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
 	}
+	return version, dirty, err
+}
+
+// Close closes the connection pool. Call it only after everything using
+// the storage has stopped.
+func (s *Storage) Close() error {
+	return s.sql.Close()
+}
+
+// Outbox returns the store the event dispatcher uses to read the outbox and
+// track sink cursors outside of any action's transaction.
+func (s *Storage) Outbox() *outbox.Store {
+	return outbox.NewStore(s.sql)
+}
 
-	return &Storage{sql: db}
+// Webhooks returns the store webhook delivery uses to queue deliveries and
+// record attempts outside of any action's transaction.
+func (s *Storage) Webhooks() *webhook.Store {
+	return webhook.NewStore(s.sql)
+}
+
+// Idempotency returns the store the expiry sweep uses to drop old
+// idempotency records outside of any action's transaction.
+func (s *Storage) Idempotency() *idempotency.Store {
+	return idempotency.NewStore(s.sql)
+}
+
+// NewStorage connects to the configured database; see Open.
+func NewStorage(ctx context.Context, env *config.Config) (*Storage, error) {
+	db, err := Open(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+	return &Storage{sql: bob.NewDB(db)}, nil
 }
@@ -0,0 +1,64 @@
+// Package storagetest helps test how storage builds its queries without a
+// database.
+package storagetest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/scan"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/carson-networks/budget-server/internal/requestctx"
+)
+
+// ErrNotExecuted is returned for every query a Recorder receives.
+var ErrNotExecuted = errors.New("storagetest: query not executed")
+
+// Query is a query a Recorder received.
+type Query struct {
+	SQL  string
+	Args []any
+}
+
+// Recorder is a bob.Executor that records queries instead of running them.
+// Each query fails with ErrNotExecuted, so a storage method stops at its
+// first query.
+type Recorder struct {
+	Queries []Query
+}
+
+func (r *Recorder) QueryContext(_ context.Context, query string, args ...any) (scan.Rows, error) {
+	r.Queries = append(r.Queries, Query{SQL: query, Args: args})
+	return nil, ErrNotExecuted
+}
+
+func (r *Recorder) ExecContext(_ context.Context, query string, args ...any) (sql.Result, error) {
+	r.Queries = append(r.Queries, Query{SQL: query, Args: args})
+	return nil, ErrNotExecuted
+}
+
+// AssertLedgerScoped checks that call, run in a ledger, queries only that
+// ledger's rows, and that run without one it fails with
+// requestctx.ErrNoLedger before querying at all.
+func AssertLedgerScoped(t *testing.T, call func(ctx context.Context, exec bob.Executor) error) {
+	t.Helper()
+	ledgerID := uuid.Must(uuid.NewV4())
+
+	rec := &Recorder{}
+	err := call(requestctx.WithLedgerID(context.Background(), ledgerID), rec)
+	assert.ErrorIs(t, err, ErrNotExecuted)
+	if assert.NotEmpty(t, rec.Queries) {
+		assert.Contains(t, rec.Queries[0].SQL, "ledger_id")
+		assert.Contains(t, rec.Queries[0].Args, ledgerID)
+	}
+
+	rec = &Recorder{}
+	err = call(context.Background(), rec)
+	assert.ErrorIs(t, err, requestctx.ErrNoLedger)
+	assert.Empty(t, rec.Queries)
+}
@@ -0,0 +1,60 @@
+package transaction
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stephenafamo/bob"
+
+	"github.com/carson-networks/budget-server/internal/storage/storagetest"
+)
+
+func TestLedgerIsolation(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	other := uuid.Must(uuid.NewV4())
+	tests := map[string]func(ctx context.Context, exec bob.Executor) error{
+		"FindByID": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).FindByID(ctx, id)
+			return err
+		},
+		"List": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).List(ctx, &TransactionFilter{AccountID: &id})
+			return err
+		},
+		"SumByCategory": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).SumByCategory(ctx, time.Now().AddDate(0, -1, 0), time.Now())
+			return err
+		},
+		"ExistsForCategory": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).ExistsForCategory(ctx, id)
+			return err
+		},
+		"ListByCategory": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).ListByCategory(ctx, id)
+			return err
+		},
+		"Insert": func(ctx context.Context, exec bob.Executor) error {
+			_, err := (&Writer{Reader{exec: exec}}).Insert(ctx, &TransactionCreate{AccountID: id, CategoryID: other, Amount: decimal.NewFromInt(5)})
+			return err
+		},
+		"ReassignCategory": func(ctx context.Context, exec bob.Executor) error {
+			_, err := (&Writer{Reader{exec: exec}}).ReassignCategory(ctx, id, other)
+			return err
+		},
+		"MoveToCategory": func(ctx context.Context, exec bob.Executor) error {
+			_, err := (&Writer{Reader{exec: exec}}).MoveToCategory(ctx, []uuid.UUID{uuid.Must(uuid.NewV4())}, id, other)
+			return err
+		},
+		"Delete": func(ctx context.Context, exec bob.Executor) error {
+			return (&Writer{Reader{exec: exec}}).Delete(ctx, id)
+		},
+	}
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			storagetest.AssertLedgerScoped(t, call)
+		})
+	}
+}
@@ -4,13 +4,17 @@ import (
 	"context"
 	"time"
 
+	"github.com/carson-networks/budget-server/internal/pagination"
+	"github.com/carson-networks/budget-server/internal/requestctx"
 	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
 	"github.com/gofrs/uuid/v5"
+	"github.com/shopspring/decimal"
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/psql"
 	"github.com/stephenafamo/bob/dialect/psql/dialect"
 	"github.com/stephenafamo/bob/dialect/psql/sm"
 	"github.com/stephenafamo/bob/mods"
+	"github.com/stephenafamo/scan"
 )
 
 type Reader struct {
@@ -21,50 +25,59 @@ func NewReader(exec bob.Executor) *Reader {
 	return &Reader{exec: exec}
 }
 
+// FindByID returns the transaction, or sql.ErrNoRows when the request ledger
+// has none with that ID.
 func (r *Reader) FindByID(ctx context.Context, id uuid.UUID) (*Transaction, error) {
-	row, err := bobgen.FindTransaction(ctx, r.exec, id)
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := bobgen.Transactions.Query(
+		bobgen.SelectWhere.Transactions.LedgerID.EQ(ledgerID),
+		bobgen.SelectWhere.Transactions.ID.EQ(id),
+	).One(ctx, r.exec)
 	if err != nil {
 		return nil, err
 	}
 	return bobTransactionToTransaction(row), nil
 }
 
+// List returns a page of the request ledger's transactions, newest first.
 func (r *Reader) List(ctx context.Context, filter *TransactionFilter) (*TransactionListResult, error) {
-	limit := 20
-	offset := 0
-	var maxCreationTime *time.Time
-	if filter != nil {
-		if filter.Limit > 0 {
-			limit = filter.Limit
-		}
-		offset = filter.Offset
-		maxCreationTime = filter.MaxCreationTime
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	var queryMods []bob.Mod[*dialect.SelectQuery]
+	limit := pagination.DefaultLimit
+	whereMods := []mods.Where[*dialect.SelectQuery]{bobgen.SelectWhere.Transactions.LedgerID.EQ(ledgerID)}
 	if filter != nil {
-		var whereMods []mods.Where[*dialect.SelectQuery]
+		limit = pagination.Limit(filter.Limit)
 		if filter.AccountID != nil {
 			whereMods = append(whereMods, bobgen.SelectWhere.Transactions.AccountID.EQ(*filter.AccountID))
 		}
 		if filter.CategoryID != nil {
 			whereMods = append(whereMods, bobgen.SelectWhere.Transactions.CategoryID.EQ(*filter.CategoryID))
 		}
-		if filter.MaxCreationTime != nil {
-			whereMods = append(whereMods, bobgen.SelectWhere.Transactions.CreatedAt.LTE(*filter.MaxCreationTime))
+		if filter.FromDate != nil {
+			whereMods = append(whereMods, bobgen.SelectWhere.Transactions.TransactionDate.GTE(*filter.FromDate))
+		}
+		if filter.ToDate != nil {
+			whereMods = append(whereMods, bobgen.SelectWhere.Transactions.TransactionDate.LT(*filter.ToDate))
 		}
-		if len(whereMods) == 1 {
-			queryMods = append(queryMods, whereMods[0])
-		} else if len(whereMods) > 1 {
-			queryMods = append(queryMods, psql.WhereAnd(whereMods...))
+		if filter.After != nil {
+			whereMods = append(whereMods, sm.Where(
+				psql.Group(bobgen.Transactions.Columns.CreatedAt, bobgen.Transactions.Columns.ID).
+					LT(psql.ArgGroup(filter.After.CreatedAt, filter.After.ID)),
+			))
 		}
 	}
-	queryMods = append(queryMods,
-		sm.Limit(limit+1),
-		sm.Offset(offset),
+	queryMods := []bob.Mod[*dialect.SelectQuery]{
+		psql.WhereAnd(whereMods...),
+		sm.Limit(limit + 1),
 		sm.OrderBy(bobgen.Transactions.Columns.CreatedAt).Desc(),
 		sm.OrderBy(bobgen.Transactions.Columns.ID).Desc(),
-	)
+	}
 	rows, err := bobgen.Transactions.Query(queryMods...).All(ctx, r.exec)
 	if err != nil {
 		return nil, err
@@ -74,23 +87,79 @@ func (r *Reader) List(ctx context.Context, filter *TransactionFilter) (*Transact
 		return &TransactionListResult{Transactions: nil, NextCursor: nil}, nil
 	}
 
-	var nextCursor *TransactionCursor
-	if len(rows) > limit {
-		rows = rows[:limit]
-		cursorMaxCreationTime := rows[0].CreatedAt
-		if maxCreationTime != nil {
-			cursorMaxCreationTime = *maxCreationTime
-		}
-		nextCursor = &TransactionCursor{
-			Position:        offset + limit,
-			Limit:           limit,
-			MaxCreationTime: cursorMaxCreationTime,
-		}
-	}
-
 	result := make([]*Transaction, len(rows))
 	for i, row := range rows {
 		result[i] = bobTransactionToTransaction(row)
 	}
+	result, nextCursor := pagination.NextPage(result, limit, transactionTimeKey)
 	return &TransactionListResult{Transactions: result, NextCursor: nextCursor}, nil
 }
+
+// SumByCategory returns the net transaction amount per category for the
+// request ledger's transactions dated in [from, to). Categories without
+// transactions in the period are absent from the map.
+func (r *Reader) SumByCategory(ctx context.Context, from time.Time, to time.Time) (map[uuid.UUID]decimal.Decimal, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := psql.Select(
+		sm.Columns(
+			bobgen.Transactions.Columns.CategoryID,
+			psql.F("sum", bobgen.Transactions.Columns.Amount)().As("total"),
+		),
+		sm.From(bobgen.Transactions.Name()),
+		sm.Where(psql.And(
+			bobgen.Transactions.Columns.LedgerID.EQ(psql.Arg(ledgerID)),
+			bobgen.Transactions.Columns.TransactionDate.GTE(psql.Arg(from)),
+			bobgen.Transactions.Columns.TransactionDate.LT(psql.Arg(to)),
+		)),
+		sm.GroupBy(bobgen.Transactions.Columns.CategoryID),
+	)
+
+	rows, err := bob.All(ctx, r.exec, query, scan.StructMapper[CategoryTotal]())
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make(map[uuid.UUID]decimal.Decimal, len(rows))
+	for _, row := range rows {
+		totals[row.CategoryID] = row.Total
+	}
+	return totals, nil
+}
+
+// ExistsForCategory reports whether any transaction references the category.
+func (r *Reader) ExistsForCategory(ctx context.Context, categoryID uuid.UUID) (bool, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return false, err
+	}
+	return bobgen.Transactions.Query(
+		bobgen.SelectWhere.Transactions.LedgerID.EQ(ledgerID),
+		bobgen.SelectWhere.Transactions.CategoryID.EQ(categoryID),
+	).Exists(ctx, r.exec)
+}
+
+// ListByCategory returns every transaction in the category, oldest first.
+func (r *Reader) ListByCategory(ctx context.Context, categoryID uuid.UUID) ([]*Transaction, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := bobgen.Transactions.Query(
+		bobgen.SelectWhere.Transactions.LedgerID.EQ(ledgerID),
+		bobgen.SelectWhere.Transactions.CategoryID.EQ(categoryID),
+		sm.OrderBy(bobgen.Transactions.Columns.CreatedAt),
+		sm.OrderBy(bobgen.Transactions.Columns.ID),
+	).All(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*Transaction, len(rows))
+	for i, row := range rows {
+		transactions[i] = bobTransactionToTransaction(row)
+	}
+	return transactions, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/carson-networks/budget-server/internal/pagination"
 	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
 	"github.com/gofrs/uuid/v5"
 	"github.com/shopspring/decimal"
@@ -18,9 +19,14 @@ func bobTransactionToTransaction(row *bobgen.Transaction) *Transaction {
 		TransactionName: row.TransactionName,
 		TransactionDate: row.TransactionDate,
 		CreatedAt:       row.CreatedAt,
+		IsRefund:        row.IsRefund,
 	}
 }
 
+func transactionTimeKey(t *Transaction) pagination.TimeKey {
+	return pagination.TimeKey{CreatedAt: t.CreatedAt, ID: t.ID}
+}
+
 // Transaction represents a transaction record.
 type Transaction struct {
 	ID              uuid.UUID
@@ -30,6 +36,7 @@ type Transaction struct {
 	TransactionName string
 	TransactionDate time.Time
 	CreatedAt       time.Time
+	IsRefund        bool
 }
 
 // TransactionCreate is the input for creating a new transaction.
@@ -39,29 +46,32 @@ type TransactionCreate struct {
 	Amount          decimal.Decimal
 	TransactionName string
 	TransactionDate time.Time // defaults to now if zero
+	IsRefund        bool
 }
 
 // TransactionFilter specifies filters for listing transactions.
+// Transactions are ordered newest first by (created_at, id); After resumes
+// after that position, so rows inserted while paging never shift later pages.
+// FromDate and ToDate bound transaction_date to [FromDate, ToDate).
 type TransactionFilter struct {
-	AccountID       *uuid.UUID
-	CategoryID      *uuid.UUID
-	Limit           int
-	Offset          int
-	MaxCreationTime *time.Time
-}
-
-// TransactionCursor identifies a position in a paginated result set
-// and carries the limit and maxCreationTime so subsequent pages are consistent.
-type TransactionCursor struct {
-	Position        int
-	Limit           int
-	MaxCreationTime time.Time
+	AccountID  *uuid.UUID
+	CategoryID *uuid.UUID
+	FromDate   *time.Time
+	ToDate     *time.Time
+	Limit      int
+	After      *pagination.TimeKey
 }
 
 // TransactionListResult contains a page of transactions and an optional next cursor.
 type TransactionListResult struct {
 	Transactions []*Transaction
-	NextCursor   *TransactionCursor
+	NextCursor   *pagination.Cursor[pagination.TimeKey]
+}
+
+// CategoryTotal is the net amount of transactions in one category.
+type CategoryTotal struct {
+	CategoryID uuid.UUID       `db:"category_id"`
+	Total      decimal.Decimal `db:"total"`
 }
 
 // ITransactionTable defines the interface for transaction storage operations.
@@ -70,6 +80,6 @@ type TransactionListResult struct {
 //go:generate mockery --name ITransactionTable --output mock_ITransactionTable.go
 type ITransactionTable interface {
 	FindByID(ctx context.Context, id uuid.UUID) (*Transaction, error)
-	Insert(ctx context.Context, create *TransactionCreate) (uuid.UUID, error)
+	Insert(ctx context.Context, create *TransactionCreate) (*Transaction, error)
 	List(ctx context.Context, filter *TransactionFilter) ([]*Transaction, error)
 }
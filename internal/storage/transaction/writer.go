@@ -4,38 +4,108 @@ import (
 	"context"
 
 	"github.com/aarondl/opt/omit"
+	"github.com/carson-networks/budget-server/internal/requestctx"
 	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
 	"github.com/gofrs/uuid/v5"
 	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
 )
 
+// Writer writes the request ledger's transactions. Its queries go through
+// the embedded Reader's executor, which is the transaction.
 type Writer struct {
-	tx bob.Tx
 	Reader
 }
 
 func NewWriter(tx bob.Tx) *Writer {
 	return &Writer{
-		tx: tx,
 		Reader: Reader{
 			exec: tx,
 		},
 	}
 }
 
-func (w *Writer) Insert(ctx context.Context, create *TransactionCreate) (uuid.UUID, error) {
+func (w *Writer) Insert(ctx context.Context, create *TransactionCreate) (*Transaction, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	setter := &bobgen.TransactionSetter{
 		AccountID:       omit.From(create.AccountID),
 		CategoryID:      omit.From(create.CategoryID),
 		Amount:          omit.From(create.Amount),
 		TransactionName: omit.From(create.TransactionName),
+		IsRefund:        omit.From(create.IsRefund),
+		LedgerID:        omit.From(ledgerID),
 	}
 	if !create.TransactionDate.IsZero() {
 		setter.TransactionDate = omit.From(create.TransactionDate)
 	}
-	row, err := bobgen.Transactions.Insert(setter).One(ctx, w.tx)
+	row, err := bobgen.Transactions.Insert(setter).One(ctx, w.exec)
+	if err != nil {
+		return nil, err
+	}
+	return bobTransactionToTransaction(row), nil
+}
+
+// ReassignCategory moves every transaction in category from to category to
+// and returns the number of transactions moved.
+func (w *Writer) ReassignCategory(ctx context.Context, from uuid.UUID, to uuid.UUID) (int64, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	setter := &bobgen.TransactionSetter{
+		CategoryID: omit.From(to),
+	}
+	return bobgen.Transactions.Update(
+		setter.UpdateMod(),
+		um.Where(psql.And(
+			bobgen.Transactions.Columns.LedgerID.EQ(psql.Arg(ledgerID)),
+			bobgen.Transactions.Columns.CategoryID.EQ(psql.Arg(from)),
+		)),
+	).Exec(ctx, w.exec)
+}
+
+// MoveToCategory moves the given transactions that are still in category from
+// to category to and returns the number moved.
+func (w *Writer) MoveToCategory(ctx context.Context, ids []uuid.UUID, from uuid.UUID, to uuid.UUID) (int64, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	args := make([]bob.Expression, len(ids))
+	for i, id := range ids {
+		args[i] = psql.Arg(id)
+	}
+	setter := &bobgen.TransactionSetter{
+		CategoryID: omit.From(to),
+	}
+	return bobgen.Transactions.Update(
+		setter.UpdateMod(),
+		um.Where(psql.And(
+			bobgen.Transactions.Columns.LedgerID.EQ(psql.Arg(ledgerID)),
+			bobgen.Transactions.Columns.ID.In(args...),
+			bobgen.Transactions.Columns.CategoryID.EQ(psql.Arg(from)),
+		)),
+	).Exec(ctx, w.exec)
+}
+
+func (w *Writer) Delete(ctx context.Context, id uuid.UUID) error {
+	ledgerID, err := requestctx.LedgerID(ctx)
 	if err != nil {
-		return uuid.Nil, err
+		return err
 	}
-	return row.ID, nil
+	_, err = bobgen.Transactions.Delete(
+		dm.Where(psql.And(
+			bobgen.Transactions.Columns.LedgerID.EQ(psql.Arg(ledgerID)),
+			bobgen.Transactions.Columns.ID.EQ(psql.Arg(id)),
+		)),
+	).Exec(ctx, w.exec)
+	return err
 }
@@ -0,0 +1,88 @@
+package user
+
+import (
+	"context"
+
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+type Reader struct {
+	exec bob.Executor
+}
+
+func NewReader(exec bob.Executor) *Reader {
+	return &Reader{exec: exec}
+}
+
+// GetByID returns the user, or sql.ErrNoRows when there is none.
+func (r *Reader) GetByID(ctx context.Context, id uuid.UUID) (*User, error) {
+	row, err := bobgen.FindUser(ctx, r.exec, id)
+	if err != nil {
+		return nil, err
+	}
+	return bobUserToUser(row), nil
+}
+
+// GetByUsername returns the user, or sql.ErrNoRows when there is none.
+func (r *Reader) GetByUsername(ctx context.Context, username string) (*User, error) {
+	row, err := bobgen.Users.Query(
+		sm.Where(bobgen.Users.Columns.Username.EQ(psql.Arg(username))),
+	).One(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+	return bobUserToUser(row), nil
+}
+
+// HasUsers reports whether any user exists.
+func (r *Reader) HasUsers(ctx context.Context) (bool, error) {
+	return bobgen.Users.Query().Exists(ctx, r.exec)
+}
+
+// GetTokenByHash returns the token with the given hash, expired or not, or
+// sql.ErrNoRows when there is none.
+func (r *Reader) GetTokenByHash(ctx context.Context, hash []byte) (*Token, error) {
+	row, err := bobgen.AuthTokens.Query(
+		sm.Where(bobgen.AuthTokens.Columns.TokenHash.EQ(psql.Arg(hash))),
+	).One(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+	return bobTokenToToken(row), nil
+}
+
+// GetToken returns one of the user's tokens, or sql.ErrNoRows when the user
+// has no such token.
+func (r *Reader) GetToken(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*Token, error) {
+	row, err := bobgen.AuthTokens.Query(
+		sm.Where(bobgen.AuthTokens.Columns.ID.EQ(psql.Arg(id))),
+		sm.Where(bobgen.AuthTokens.Columns.UserID.EQ(psql.Arg(userID))),
+	).One(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+	return bobTokenToToken(row), nil
+}
+
+// ListTokens returns the user's tokens of the given kind, oldest first.
+func (r *Reader) ListTokens(ctx context.Context, userID uuid.UUID, kind TokenKind) ([]*Token, error) {
+	rows, err := bobgen.AuthTokens.Query(
+		sm.Where(bobgen.AuthTokens.Columns.UserID.EQ(psql.Arg(userID))),
+		sm.Where(bobgen.AuthTokens.Columns.Kind.EQ(psql.Arg(int16(kind)))),
+		sm.OrderBy(bobgen.AuthTokens.Columns.CreatedAt),
+		sm.OrderBy(bobgen.AuthTokens.Columns.ID),
+	).All(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*Token, len(rows))
+	for i, row := range rows {
+		tokens[i] = bobTokenToToken(row)
+	}
+	return tokens, nil
+}
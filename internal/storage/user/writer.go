@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen/dberrors"
+	"github.com/gofrs/uuid/v5"
+	"github.com/lib/pq"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+)
+
+type Writer struct {
+	tx bob.Tx
+	Reader
+}
+
+func NewWriter(tx bob.Tx) *Writer {
+	return &Writer{
+		tx: tx,
+		Reader: Reader{
+			exec: tx,
+		},
+	}
+}
+
+// Create inserts a user. It returns ErrUsernameTaken when the username is
+// already in use.
+func (w *Writer) Create(ctx context.Context, create *UserCreate) (*User, error) {
+	row, err := bobgen.Users.Insert(&bobgen.UserSetter{
+		Username:     omit.From(create.Username),
+		PasswordHash: omit.From(create.PasswordHash),
+	}).One(ctx, w.tx)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && dberrors.UserErrors.ErrUniqueUsersUsernameKey.Is(pqErr) {
+		return nil, ErrUsernameTaken
+	}
+	if err != nil {
+		return nil, err
+	}
+	return bobUserToUser(row), nil
+}
+
+func (w *Writer) CreateToken(ctx context.Context, create *TokenCreate) (*Token, error) {
+	row, err := bobgen.AuthTokens.Insert(&bobgen.AuthTokenSetter{
+		UserID:    omit.From(create.UserID),
+		Name:      omit.From(create.Name),
+		Kind:      omit.From(int16(create.Kind)),
+		Scope:     omit.From(string(create.Scope)),
+		TokenHash: omit.From(create.Hash),
+		ExpiresAt: omitnull.FromPtr(create.ExpiresAt),
+	}).One(ctx, w.tx)
+	if err != nil {
+		return nil, err
+	}
+	return bobTokenToToken(row), nil
+}
+
+// DeleteToken revokes one of the user's tokens and reports whether it
+// existed.
+func (w *Writer) DeleteToken(ctx context.Context, userID uuid.UUID, id uuid.UUID) (bool, error) {
+	deleted, err := bobgen.AuthTokens.Delete(
+		dm.Where(bobgen.AuthTokens.Columns.ID.EQ(psql.Arg(id))),
+		dm.Where(bobgen.AuthTokens.Columns.UserID.EQ(psql.Arg(userID))),
+	).Exec(ctx, w.tx)
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
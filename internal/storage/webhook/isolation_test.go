@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/carson-networks/budget-server/internal/storage/storagetest"
+)
+
+func TestLedgerIsolation(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	url := "https://example.com/hook"
+	tests := map[string]func(ctx context.Context, exec bob.Executor) error{
+		"GetByID": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).GetByID(ctx, id)
+			return err
+		},
+		"List": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).List(ctx)
+			return err
+		},
+		"ListDeliveries": func(ctx context.Context, exec bob.Executor) error {
+			_, err := NewReader(exec).ListDeliveries(ctx, id, 10)
+			return err
+		},
+		"Create": func(ctx context.Context, exec bob.Executor) error {
+			_, err := (&Writer{Reader{exec: exec}}).Create(ctx, &WebhookCreate{URL: url, Secret: "s3cret"})
+			return err
+		},
+		"Update": func(ctx context.Context, exec bob.Executor) error {
+			return (&Writer{Reader{exec: exec}}).Update(ctx, id, &WebhookUpdate{URL: &url})
+		},
+		"Delete": func(ctx context.Context, exec bob.Executor) error {
+			return (&Writer{Reader{exec: exec}}).Delete(ctx, id)
+		},
+	}
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			storagetest.AssertLedgerScoped(t, call)
+		})
+	}
+}
+
+func TestWebhook_Matches(t *testing.T) {
+	ledgerID := uuid.Must(uuid.NewV4())
+	hook := &Webhook{LedgerID: ledgerID, EventTypes: []string{"TransactionCreated"}}
+
+	assert.True(t, hook.Matches(ledgerID, "TransactionCreated"))
+	assert.False(t, hook.Matches(uuid.Must(uuid.NewV4()), "TransactionCreated"))
+	assert.False(t, hook.Matches(ledgerID, "CategoryDeleted"))
+}
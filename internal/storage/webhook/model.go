@@ -0,0 +1,145 @@
+package webhook
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/gofrs/uuid/v5"
+)
+
+// Webhook is a subscription that receives domain events by HTTP POST.
+type Webhook struct {
+	ID uuid.UUID
+	// LedgerID is the ledger whose events the webhook receives.
+	LedgerID uuid.UUID
+	URL      string
+	// Secret is the HMAC-SHA256 key deliveries are signed with.
+	Secret string
+	// EventTypes limits the events delivered; empty means every event.
+	EventTypes []string
+	IsDisabled bool
+	CreatedAt  time.Time
+}
+
+// Matches reports whether events of eventType recorded in ledgerID should be
+// delivered.
+func (w *Webhook) Matches(ledgerID uuid.UUID, eventType string) bool {
+	return !w.IsDisabled && w.LedgerID == ledgerID && (len(w.EventTypes) == 0 || slices.Contains(w.EventTypes, eventType))
+}
+
+// WebhookCreate is the input for creating a webhook.
+type WebhookCreate struct {
+	URL        string
+	Secret     string
+	EventTypes []string
+}
+
+// WebhookUpdate is the input for updating a webhook (mutable fields only).
+type WebhookUpdate struct {
+	URL        *string
+	EventTypes *[]string
+	IsDisabled *bool
+}
+
+type DeliveryStatus int16
+
+const (
+	// DeliveryStatusPending deliveries are attempted once NextAttemptAt passes.
+	DeliveryStatusPending DeliveryStatus = iota
+	DeliveryStatusSucceeded
+	// DeliveryStatusFailed deliveries ran out of attempts.
+	DeliveryStatusFailed
+)
+
+func (s DeliveryStatus) String() string {
+	switch s {
+	case DeliveryStatusPending:
+		return "pending"
+	case DeliveryStatusSucceeded:
+		return "succeeded"
+	case DeliveryStatusFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// Delivery is one event sent, or to be sent, to a webhook. It doubles as
+// the delivery log and the retry queue.
+type Delivery struct {
+	ID        uuid.UUID
+	WebhookID uuid.UUID
+	// EventID is the outbox event delivered; nil for test deliveries.
+	EventID        *int64
+	EventType      string
+	Payload        json.RawMessage
+	Status         DeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	ResponseStatus *int
+	LastError      *string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// DeliveryCreate is the input for queueing a delivery.
+type DeliveryCreate struct {
+	WebhookID uuid.UUID
+	EventID   *int64
+	EventType string
+	Payload   json.RawMessage
+}
+
+// DeliveryAttempt is the outcome of sending a delivery.
+type DeliveryAttempt struct {
+	Status         DeliveryStatus
+	ResponseStatus *int
+	Error          *string
+	// NextAttemptAt is when a still pending delivery is retried.
+	NextAttemptAt time.Time
+}
+
+func bobWebhookToWebhook(row *bobgen.Webhook) (*Webhook, error) {
+	var eventTypes []string
+	if err := json.Unmarshal(row.EventTypes.Val, &eventTypes); err != nil {
+		return nil, err
+	}
+	return &Webhook{
+		ID:         row.ID,
+		LedgerID:   row.LedgerID,
+		URL:        row.URL,
+		Secret:     row.Secret,
+		EventTypes: eventTypes,
+		IsDisabled: row.IsDisabled,
+		CreatedAt:  row.CreatedAt,
+	}, nil
+}
+
+func bobDeliveryToDelivery(row *bobgen.WebhookDelivery) *Delivery {
+	delivery := &Delivery{
+		ID:            row.ID,
+		WebhookID:     row.WebhookID,
+		EventType:     row.EventType,
+		Payload:       row.Payload.Val,
+		Status:        DeliveryStatus(row.Status),
+		Attempts:      int(row.Attempts),
+		NextAttemptAt: row.NextAttemptAt,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+	}
+	if row.EventID.IsValue() {
+		eventID := row.EventID.MustGet()
+		delivery.EventID = &eventID
+	}
+	if row.ResponseStatus.IsValue() {
+		status := int(row.ResponseStatus.MustGet())
+		delivery.ResponseStatus = &status
+	}
+	if row.LastError.IsValue() {
+		lastError := row.LastError.MustGet()
+		delivery.LastError = &lastError
+	}
+	return delivery
+}
@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dialect"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+// Reader reads the request ledger's webhooks.
+type Reader struct {
+	exec bob.Executor
+}
+
+func NewReader(exec bob.Executor) *Reader {
+	return &Reader{exec: exec}
+}
+
+// GetByID returns the webhook, or sql.ErrNoRows when the request ledger has
+// none with that ID.
+func (r *Reader) GetByID(ctx context.Context, id uuid.UUID) (*Webhook, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row, err := bobgen.Webhooks.Query(
+		bobgen.SelectWhere.Webhooks.LedgerID.EQ(ledgerID),
+		bobgen.SelectWhere.Webhooks.ID.EQ(id),
+	).One(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+	return bobWebhookToWebhook(row)
+}
+
+// List returns every webhook in the request ledger, oldest first.
+func (r *Reader) List(ctx context.Context) ([]*Webhook, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return listWebhooks(ctx, r.exec, bobgen.SelectWhere.Webhooks.LedgerID.EQ(ledgerID))
+}
+
+// ListDeliveries returns up to limit of the webhook's deliveries, newest
+// first. Webhooks outside the request ledger have none.
+func (r *Reader) ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]*Delivery, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := bobgen.WebhookDeliveries.Query(
+		sm.Where(bobgen.WebhookDeliveries.Columns.WebhookID.EQ(psql.Arg(webhookID))),
+		sm.Where(psql.Raw("EXISTS (SELECT 1 FROM webhooks WHERE webhooks.id = ? AND webhooks.ledger_id = ?)",
+			psql.Arg(webhookID), psql.Arg(ledgerID))),
+		sm.OrderBy(bobgen.WebhookDeliveries.Columns.CreatedAt).Desc(),
+		sm.OrderBy(bobgen.WebhookDeliveries.Columns.ID).Desc(),
+		sm.Limit(limit),
+	).All(ctx, r.exec)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*Delivery, len(rows))
+	for i, row := range rows {
+		deliveries[i] = bobDeliveryToDelivery(row)
+	}
+	return deliveries, nil
+}
+
+func listWebhooks(ctx context.Context, exec bob.Executor, where ...bob.Mod[*dialect.SelectQuery]) ([]*Webhook, error) {
+	mods := append(where,
+		sm.OrderBy(bobgen.Webhooks.Columns.CreatedAt),
+		sm.OrderBy(bobgen.Webhooks.Columns.ID),
+	)
+	rows, err := bobgen.Webhooks.Query(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]*Webhook, len(rows))
+	for i, row := range rows {
+		if webhooks[i], err = bobWebhookToWebhook(row); err != nil {
+			return nil, err
+		}
+	}
+	return webhooks, nil
+}
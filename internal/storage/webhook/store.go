@@ -0,0 +1,141 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/aarondl/opt/omitnull"
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/types"
+)
+
+// Store is used by webhook delivery, outside of any action's transaction,
+// to queue deliveries and record how each attempt went. Unlike Reader it
+// sees the webhooks of every ledger. It assumes a single process is
+// delivering.
+type Store struct {
+	exec bob.Executor
+}
+
+func NewStore(exec bob.Executor) *Store {
+	return &Store{exec: exec}
+}
+
+// GetByID returns the webhook, or sql.ErrNoRows when there is none.
+func (s *Store) GetByID(ctx context.Context, id uuid.UUID) (*Webhook, error) {
+	row, err := bobgen.FindWebhook(ctx, s.exec, id)
+	if err != nil {
+		return nil, err
+	}
+	return bobWebhookToWebhook(row)
+}
+
+// List returns every webhook in every ledger, oldest first.
+func (s *Store) List(ctx context.Context) ([]*Webhook, error) {
+	return listWebhooks(ctx, s.exec)
+}
+
+// Enqueue queues deliveries to be attempted immediately. A delivery of an
+// event that is already queued for the same webhook is ignored, so an event
+// dispatched twice is only delivered once.
+func (s *Store) Enqueue(ctx context.Context, deliveries ...*DeliveryCreate) error {
+	for _, delivery := range deliveries {
+		setter := &bobgen.WebhookDeliverySetter{
+			WebhookID: omit.From(delivery.WebhookID),
+			EventType: omit.From(delivery.EventType),
+			Payload:   omit.From(types.NewJSON(delivery.Payload)),
+		}
+		if delivery.EventID != nil {
+			setter.EventID = omitnull.From(*delivery.EventID)
+		}
+		_, err := bobgen.WebhookDeliveries.Insert(
+			setter,
+			im.OnConflict("webhook_id", "event_id").DoNothing(),
+		).Exec(ctx, s.exec)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Insert stores a delivery that has already been attempted, such as a
+// test delivery.
+func (s *Store) Insert(ctx context.Context, delivery *Delivery) error {
+	setter := &bobgen.WebhookDeliverySetter{
+		ID:            omit.From(delivery.ID),
+		WebhookID:     omit.From(delivery.WebhookID),
+		EventType:     omit.From(delivery.EventType),
+		Payload:       omit.From(types.NewJSON(delivery.Payload)),
+		Status:        omit.From(int16(delivery.Status)),
+		Attempts:      omit.From(int32(delivery.Attempts)),
+		NextAttemptAt: omit.From(delivery.NextAttemptAt),
+	}
+	if delivery.EventID != nil {
+		setter.EventID = omitnull.From(*delivery.EventID)
+	}
+	if delivery.ResponseStatus != nil {
+		setter.ResponseStatus = omitnull.From(int32(*delivery.ResponseStatus))
+	}
+	if delivery.LastError != nil {
+		setter.LastError = omitnull.From(*delivery.LastError)
+	}
+	_, err := bobgen.WebhookDeliveries.Insert(setter).Exec(ctx, s.exec)
+	return err
+}
+
+// ListDue returns up to limit pending deliveries whose next attempt is at or
+// before now, oldest first.
+func (s *Store) ListDue(ctx context.Context, now time.Time, limit int) ([]*Delivery, error) {
+	rows, err := bobgen.WebhookDeliveries.Query(
+		sm.Where(psql.And(
+			bobgen.WebhookDeliveries.Columns.Status.EQ(psql.Arg(int16(DeliveryStatusPending))),
+			bobgen.WebhookDeliveries.Columns.NextAttemptAt.LTE(psql.Arg(now)),
+		)),
+		sm.OrderBy(bobgen.WebhookDeliveries.Columns.NextAttemptAt),
+		sm.Limit(limit),
+	).All(ctx, s.exec)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*Delivery, len(rows))
+	for i, row := range rows {
+		deliveries[i] = bobDeliveryToDelivery(row)
+	}
+	return deliveries, nil
+}
+
+// RecordAttempt counts an attempt at the delivery and stores its outcome.
+func (s *Store) RecordAttempt(ctx context.Context, id uuid.UUID, attempt *DeliveryAttempt) error {
+	setter := bobgen.WebhookDeliverySetter{
+		Status:         omit.From(int16(attempt.Status)),
+		NextAttemptAt:  omit.From(attempt.NextAttemptAt),
+		UpdatedAt:      omit.From(time.Now()),
+		ResponseStatus: omitnull.FromPtr(int32Ptr(attempt.ResponseStatus)),
+		LastError:      omitnull.FromPtr(attempt.Error),
+	}
+	_, err := bobgen.WebhookDeliveries.Update(
+		setter.UpdateMod(),
+		um.SetCol("attempts").To(
+			bobgen.WebhookDeliveries.Columns.Attempts.Plus(psql.Arg(1)),
+		),
+		um.Where(bobgen.WebhookDeliveries.Columns.ID.EQ(psql.Arg(id))),
+	).Exec(ctx, s.exec)
+	return err
+}
+
+func int32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
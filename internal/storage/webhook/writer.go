@@ -0,0 +1,109 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aarondl/opt/omit"
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage/sqlconfig/bobgen"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/dm"
+	"github.com/stephenafamo/bob/dialect/psql/um"
+	"github.com/stephenafamo/bob/types"
+)
+
+// Writer writes the request ledger's webhooks. Its queries go through the
+// embedded Reader's executor, which is the transaction.
+type Writer struct {
+	Reader
+}
+
+func NewWriter(tx bob.Tx) *Writer {
+	return &Writer{
+		Reader: Reader{
+			exec: tx,
+		},
+	}
+}
+
+func (w *Writer) Create(ctx context.Context, create *WebhookCreate) (*Webhook, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	eventTypes, err := marshalEventTypes(create.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+	row, err := bobgen.Webhooks.Insert(&bobgen.WebhookSetter{
+		URL:        omit.From(create.URL),
+		Secret:     omit.From(create.Secret),
+		EventTypes: omit.From(eventTypes),
+		LedgerID:   omit.From(ledgerID),
+	}).One(ctx, w.exec)
+	if err != nil {
+		return nil, err
+	}
+	return bobWebhookToWebhook(row)
+}
+
+func (w *Writer) Update(ctx context.Context, id uuid.UUID, update *WebhookUpdate) error {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return err
+	}
+	setter := bobgen.WebhookSetter{}
+	if update.URL != nil {
+		setter.URL = omit.From(*update.URL)
+	}
+	if update.EventTypes != nil {
+		eventTypes, err := marshalEventTypes(*update.EventTypes)
+		if err != nil {
+			return err
+		}
+		setter.EventTypes = omit.From(eventTypes)
+	}
+	if update.IsDisabled != nil {
+		setter.IsDisabled = omit.From(*update.IsDisabled)
+	}
+	if len(setter.SetColumns()) == 0 {
+		return nil
+	}
+	_, err = bobgen.Webhooks.Update(
+		setter.UpdateMod(),
+		um.Where(psql.And(
+			bobgen.Webhooks.Columns.LedgerID.EQ(psql.Arg(ledgerID)),
+			bobgen.Webhooks.Columns.ID.EQ(psql.Arg(id)),
+		)),
+	).Exec(ctx, w.exec)
+	return err
+}
+
+// Delete removes the webhook along with its delivery log.
+func (w *Writer) Delete(ctx context.Context, id uuid.UUID) error {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = bobgen.Webhooks.Delete(
+		dm.Where(psql.And(
+			bobgen.Webhooks.Columns.LedgerID.EQ(psql.Arg(ledgerID)),
+			bobgen.Webhooks.Columns.ID.EQ(psql.Arg(id)),
+		)),
+	).Exec(ctx, w.exec)
+	return err
+}
+
+func marshalEventTypes(eventTypes []string) (types.JSON[json.RawMessage], error) {
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	data, err := json.Marshal(eventTypes)
+	if err != nil {
+		return types.JSON[json.RawMessage]{}, err
+	}
+	return types.NewJSON[json.RawMessage](data), nil
+}
@@ -4,8 +4,14 @@ import (
 	"context"
 
 	"github.com/carson-networks/budget-server/internal/storage/account"
+	"github.com/carson-networks/budget-server/internal/storage/audit"
 	"github.com/carson-networks/budget-server/internal/storage/category"
+	"github.com/carson-networks/budget-server/internal/storage/idempotency"
+	"github.com/carson-networks/budget-server/internal/storage/ledger"
+	"github.com/carson-networks/budget-server/internal/storage/outbox"
 	"github.com/carson-networks/budget-server/internal/storage/transaction"
+	"github.com/carson-networks/budget-server/internal/storage/user"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
 	"github.com/gofrs/uuid/v5"
 	"github.com/shopspring/decimal"
 	"github.com/stephenafamo/bob"
@@ -14,20 +20,77 @@ import (
 // IAccountWriter defines the account write operations used by actions.
 type IAccountWriter interface {
 	FindByIDForUpdate(ctx context.Context, id uuid.UUID) (*account.Account, error)
-	Create(ctx context.Context, name string, accountType account.AccountType, accountSubType string, startingBalance decimal.Decimal) error
+	Create(ctx context.Context, name string, accountType account.AccountType, accountSubType string, startingBalance decimal.Decimal) (*account.Account, error)
 	UpdateBalance(ctx context.Context, id uuid.UUID, balance decimal.Decimal) error
 }
 
 // ITransactionWriter defines the transaction write operations used by actions.
 type ITransactionWriter interface {
-	Insert(ctx context.Context, create *transaction.TransactionCreate) (uuid.UUID, error)
+	Insert(ctx context.Context, create *transaction.TransactionCreate) (*transaction.Transaction, error)
+	ExistsForCategory(ctx context.Context, categoryID uuid.UUID) (bool, error)
+	ReassignCategory(ctx context.Context, from uuid.UUID, to uuid.UUID) (int64, error)
+	ListByCategory(ctx context.Context, categoryID uuid.UUID) ([]*transaction.Transaction, error)
+	FindByID(ctx context.Context, id uuid.UUID) (*transaction.Transaction, error)
+	MoveToCategory(ctx context.Context, ids []uuid.UUID, from uuid.UUID, to uuid.UUID) (int64, error)
+	Delete(ctx context.Context, id uuid.UUID) error
 }
 
 // ICategoryWriter defines the category write operations used by actions.
 type ICategoryWriter interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*category.Category, error)
-	Create(ctx context.Context, create *category.CategoryCreate) error
+	Create(ctx context.Context, create *category.CategoryCreate) (*category.Category, error)
+	Restore(ctx context.Context, cat *category.Category) (*category.Category, error)
+	ListAll(ctx context.Context, filter *category.CategoryFilter) ([]*category.Category, error)
 	Update(ctx context.Context, id uuid.UUID, update *category.CategoryUpdate) error
+	HasChildren(ctx context.Context, id uuid.UUID) (bool, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// IIdempotencyWriter defines the idempotency key operations used by actions.
+type IIdempotencyWriter interface {
+	Get(ctx context.Context, scope, key string) (*idempotency.Record, error)
+	Insert(ctx context.Context, record *idempotency.Record) error
+}
+
+// IOutboxWriter defines the outbox operations used by actions to record
+// domain events.
+type IOutboxWriter interface {
+	Append(ctx context.Context, events ...*outbox.EventCreate) error
+}
+
+// IWebhookWriter defines the webhook subscription operations used by actions.
+type IWebhookWriter interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*webhook.Webhook, error)
+	Create(ctx context.Context, create *webhook.WebhookCreate) (*webhook.Webhook, error)
+	Update(ctx context.Context, id uuid.UUID, update *webhook.WebhookUpdate) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// IAuditWriter defines the audit log operations used by the operator and
+// by Undo.
+type IAuditWriter interface {
+	Insert(ctx context.Context, create *audit.RecordCreate) error
+	GetByID(ctx context.Context, id uuid.UUID) (*audit.Record, error)
+}
+
+// IUserWriter defines the user and token operations used by actions.
+type IUserWriter interface {
+	HasUsers(ctx context.Context) (bool, error)
+	Create(ctx context.Context, create *user.UserCreate) (*user.User, error)
+	CreateToken(ctx context.Context, create *user.TokenCreate) (*user.Token, error)
+	GetToken(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*user.Token, error)
+	DeleteToken(ctx context.Context, userID uuid.UUID, id uuid.UUID) (bool, error)
+}
+
+// ILedgerWriter defines the ledger and membership operations used by
+// actions.
+type ILedgerWriter interface {
+	Create(ctx context.Context, name string) (*ledger.Ledger, error)
+	GetMembership(ctx context.Context, ledgerID uuid.UUID, userID uuid.UUID) (*ledger.Membership, error)
+	SetMember(ctx context.Context, ledgerID uuid.UUID, userID uuid.UUID, role ledger.Role) (*ledger.Membership, error)
+	RemoveMember(ctx context.Context, ledgerID uuid.UUID, userID uuid.UUID) (bool, error)
+	CountOwners(ctx context.Context, ledgerID uuid.UUID) (int64, error)
+	ClaimUnowned(ctx context.Context, userID uuid.UUID) ([]*ledger.Membership, error)
 }
 
 // txRunner is the minimal interface for transaction commit/rollback.
@@ -42,6 +105,12 @@ type Writer struct {
 	Account     IAccountWriter
 	Transaction ITransactionWriter
 	Category    ICategoryWriter
+	Idempotency IIdempotencyWriter
+	Outbox      IOutboxWriter
+	Webhook     IWebhookWriter
+	Audit       IAuditWriter
+	User        IUserWriter
+	Ledger      ILedgerWriter
 }
 
 func NewWriter(tx bob.Tx) Writer {
@@ -50,6 +119,12 @@ func NewWriter(tx bob.Tx) Writer {
 		Account:     account.NewWriter(tx),
 		Transaction: transaction.NewWriter(tx),
 		Category:    category.NewWriter(tx),
+		Idempotency: idempotency.NewWriter(tx),
+		Outbox:      outbox.NewWriter(tx),
+		Webhook:     webhook.NewWriter(tx),
+		Audit:       audit.NewWriter(tx),
+		User:        user.NewWriter(tx),
+		Ledger:      ledger.NewWriter(tx),
 	}
 }
 
@@ -57,10 +132,22 @@ func NewWriterForTest() *Writer {
 	mockAccount := &MockIAccountWriter{}
 	mockTxn := &MockITransactionWriter{}
 	mockCat := &MockICategoryWriter{}
+	mockIdempotency := &MockIIdempotencyWriter{}
+	mockOutbox := &MockIOutboxWriter{}
+	mockWebhook := &MockIWebhookWriter{}
+	mockAudit := &MockIAuditWriter{}
+	mockUser := &MockIUserWriter{}
+	mockLedger := &MockILedgerWriter{}
 	return &Writer{
 		Account:     mockAccount,
 		Transaction: mockTxn,
 		Category:    mockCat,
+		Idempotency: mockIdempotency,
+		Outbox:      mockOutbox,
+		Webhook:     mockWebhook,
+		Audit:       mockAudit,
+		User:        mockUser,
+		Ledger:      mockLedger,
 	}
 }
 
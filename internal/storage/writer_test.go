@@ -16,6 +16,11 @@ func TestNewWriterForTest_ReturnsWriterWithMocks(t *testing.T) {
 	require.NotNil(t, wt.Account)
 	require.NotNil(t, wt.Transaction)
 	require.NotNil(t, wt.Category)
+	require.NotNil(t, wt.Outbox)
+	require.NotNil(t, wt.Webhook)
+	require.NotNil(t, wt.Audit)
+	require.NotNil(t, wt.User)
+	require.NotNil(t, wt.Ledger)
 }
 
 func TestMockICategoryWriter_Create_Update_StructParams(t *testing.T) {
@@ -25,7 +30,7 @@ func TestMockICategoryWriter_Create_Update_StructParams(t *testing.T) {
 		Create(mock.Anything, mock.MatchedBy(func(c *category.CategoryCreate) bool {
 			return c != nil && c.Name == "Food" && !c.IsParent && !c.IsDisabled && c.CategoryType == category.CatergoryType_Income
 		})).
-		Return(nil)
+		Return(&category.Category{ID: catID, Name: "Food"}, nil)
 	newName := "Food & Groceries"
 	mockCat.EXPECT().
 		Update(mock.Anything, catID, mock.MatchedBy(func(u *category.CategoryUpdate) bool {
@@ -33,13 +38,14 @@ func TestMockICategoryWriter_Create_Update_StructParams(t *testing.T) {
 		})).
 		Return(nil)
 
-	err := mockCat.Create(context.Background(), &category.CategoryCreate{
+	created, err := mockCat.Create(context.Background(), &category.CategoryCreate{
 		Name:         "Food",
 		IsParent:     false,
 		IsDisabled:   false,
 		CategoryType: category.CatergoryType_Income,
 	})
 	require.NoError(t, err)
+	require.Equal(t, catID, created.ID)
 	err = mockCat.Update(context.Background(), catID, &category.CategoryUpdate{Name: &newName})
 	require.NoError(t, err)
 	mockCat.AssertExpectations(t)
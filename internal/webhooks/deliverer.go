@@ -0,0 +1,265 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/sirupsen/logrus"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+const (
+	// EventHeader names the type of the delivered event.
+	EventHeader = "X-Budget-Event"
+	// DeliveryHeader identifies the delivery; it is the same on every retry.
+	DeliveryHeader = "X-Budget-Delivery"
+
+	// TestEventType is the type of the event sent by Fire.
+	TestEventType = "WebhookTest"
+
+	defaultPollInterval = time.Second
+	defaultBatchSize    = 50
+	defaultMaxAttempts  = 8
+	baseBackoff         = 30 * time.Second
+	maxBackoff          = time.Hour
+	requestTimeout      = 10 * time.Second
+	// defaultWebhookBudget bounds how long one webhook's deliveries are
+	// sent for in each round.
+	defaultWebhookBudget = 30 * time.Second
+)
+
+// Store is the webhook storage used for delivery.
+type Store interface {
+	List(ctx context.Context) ([]*webhook.Webhook, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*webhook.Webhook, error)
+	Enqueue(ctx context.Context, deliveries ...*webhook.DeliveryCreate) error
+	Insert(ctx context.Context, delivery *webhook.Delivery) error
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*webhook.Delivery, error)
+	RecordAttempt(ctx context.Context, id uuid.UUID, attempt *webhook.DeliveryAttempt) error
+}
+
+// Deliverer sends queued deliveries. A delivery that fails, by a network
+// error or a non-2xx response, is retried with exponential backoff until
+// maxAttempts, after which it is marked failed.
+type Deliverer struct {
+	store  Store
+	client *http.Client
+
+	pollInterval  time.Duration
+	batchSize     int
+	maxAttempts   int
+	webhookBudget time.Duration
+	backoff       func(attempt int) time.Duration
+	now           func() time.Time
+}
+
+// NewDeliverer returns a Deliverer that will only deliver to internal
+// addresses, such as loopback and private networks, that fall in
+// allowedNetworks.
+func NewDeliverer(store Store, allowedNetworks []netip.Prefix) *Deliverer {
+	return &Deliverer{
+		store:         store,
+		client:        newClient(allowedNetworks),
+		pollInterval:  defaultPollInterval,
+		batchSize:     defaultBatchSize,
+		maxAttempts:   defaultMaxAttempts,
+		webhookBudget: defaultWebhookBudget,
+		backoff:       exponentialBackoff,
+		now:           time.Now,
+	}
+}
+
+// Run sends due deliveries until ctx is done.
+func (d *Deliverer) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := d.deliverDue(ctx); err != nil && ctx.Err() == nil {
+			logrus.WithError(err).Warn("Deliverer.deliverDue")
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// deliverDue attempts each delivery whose next attempt is due. Each
+// webhook's deliveries are sent in order by their own goroutine, so a slow
+// receiver only holds back itself, and a webhook stops for this round once
+// it has used its webhookBudget; the rest stay due for the next round.
+func (d *Deliverer) deliverDue(ctx context.Context) error {
+	due, err := d.store.ListDue(ctx, d.now(), d.batchSize)
+	if err != nil {
+		return err
+	}
+
+	var order []uuid.UUID
+	byWebhook := make(map[uuid.UUID][]*webhook.Delivery)
+	for _, delivery := range due {
+		if _, ok := byWebhook[delivery.WebhookID]; !ok {
+			order = append(order, delivery.WebhookID)
+		}
+		byWebhook[delivery.WebhookID] = append(byWebhook[delivery.WebhookID], delivery)
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(order))
+	for i, webhookID := range order {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = d.deliverWebhook(ctx, webhookID, byWebhook[webhookID])
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// deliverWebhook attempts deliveries, all for the webhook with the given
+// ID, in order until they are done or webhookBudget has passed. The first is
+// always attempted, so every webhook makes progress.
+func (d *Deliverer) deliverWebhook(ctx context.Context, webhookID uuid.UUID, deliveries []*webhook.Delivery) error {
+	hook, err := d.store.GetByID(ctx, webhookID)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Deleted since the query; its deliveries went with it.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	for i, delivery := range deliveries {
+		if ctx.Err() != nil || (i > 0 && time.Since(start) >= d.webhookBudget) {
+			return nil
+		}
+		attempt := d.attempt(ctx, hook, delivery)
+		if err := d.store.RecordAttempt(ctx, delivery.ID, attempt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attempt sends delivery and decides what happens to it next.
+func (d *Deliverer) attempt(ctx context.Context, hook *webhook.Webhook, delivery *webhook.Delivery) *webhook.DeliveryAttempt {
+	if hook.IsDisabled {
+		reason := "webhook is disabled"
+		return &webhook.DeliveryAttempt{Status: webhook.DeliveryStatusFailed, Error: &reason, NextAttemptAt: d.now()}
+	}
+
+	attempt := d.send(ctx, hook, delivery.ID, delivery.EventType, delivery.Payload)
+	if attempt.Status == webhook.DeliveryStatusPending {
+		attempts := delivery.Attempts + 1
+		if attempts >= d.maxAttempts {
+			attempt.Status = webhook.DeliveryStatusFailed
+		} else {
+			attempt.NextAttemptAt = d.now().Add(d.backoff(attempts))
+		}
+	}
+	return attempt
+}
+
+// Fire sends a WebhookTest event to the webhook right away and records the
+// result in its delivery log. It is not retried. It returns sql.ErrNoRows
+// when the request's ledger has no such webhook.
+func (d *Deliverer) Fire(ctx context.Context, id uuid.UUID) (*webhook.Delivery, error) {
+	ledgerID, err := requestctx.LedgerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hook, err := d.store.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if hook.LedgerID != ledgerID {
+		return nil, sql.ErrNoRows
+	}
+
+	now := d.now()
+	payload, err := json.Marshal(events.Event{
+		Type:        TestEventType,
+		AggregateID: hook.ID.String(),
+		LedgerID:    ledgerID.String(),
+		Payload:     json.RawMessage(`{}`),
+		CreatedAt:   now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	delivery := &webhook.Delivery{
+		ID:            uuid.Must(uuid.NewV4()),
+		WebhookID:     hook.ID,
+		EventType:     TestEventType,
+		Payload:       payload,
+		Attempts:      1,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	attempt := d.send(ctx, hook, delivery.ID, delivery.EventType, payload)
+	delivery.Status = attempt.Status
+	if delivery.Status == webhook.DeliveryStatusPending {
+		delivery.Status = webhook.DeliveryStatusFailed
+	}
+	delivery.ResponseStatus = attempt.ResponseStatus
+	delivery.LastError = attempt.Error
+
+	if err := d.store.Insert(ctx, delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// send POSTs payload to the webhook. The returned attempt is succeeded on a
+// 2xx response and pending otherwise.
+func (d *Deliverer) send(ctx context.Context, hook *webhook.Webhook, deliveryID uuid.UUID, eventType string, payload []byte) *webhook.DeliveryAttempt {
+	attempt := &webhook.DeliveryAttempt{Status: webhook.DeliveryStatusPending, NextAttemptAt: d.now()}
+	fail := func(err error) *webhook.DeliveryAttempt {
+		message := err.Error()
+		attempt.Error = &message
+		return attempt
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return fail(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, eventType)
+	req.Header.Set(DeliveryHeader, deliveryID.String())
+	req.Header.Set(SignatureHeader, Sign(hook.Secret, d.now(), payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fail(err)
+	}
+	defer resp.Body.Close()
+
+	attempt.ResponseStatus = &resp.StatusCode
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fail(fmt.Errorf("webhook responded %s", resp.Status))
+	}
+	attempt.Status = webhook.DeliveryStatusSucceeded
+	return attempt
+}
+
+// exponentialBackoff doubles the delay after each failed attempt.
+func exponentialBackoff(attempt int) time.Duration {
+	return min(baseBackoff<<(attempt-1), maxBackoff)
+}
@@ -0,0 +1,315 @@
+package webhooks
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/requestctx"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+// memoryStore is an in-memory Store.
+type memoryStore struct {
+	mu         sync.Mutex
+	hooks      []*webhook.Webhook
+	deliveries []*webhook.Delivery
+}
+
+func (s *memoryStore) List(context.Context) ([]*webhook.Webhook, error) {
+	return s.hooks, nil
+}
+
+func (s *memoryStore) GetByID(_ context.Context, id uuid.UUID) (*webhook.Webhook, error) {
+	for _, hook := range s.hooks {
+		if hook.ID == id {
+			return hook, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (s *memoryStore) Enqueue(_ context.Context, deliveries ...*webhook.DeliveryCreate) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, create := range deliveries {
+		s.deliveries = append(s.deliveries, &webhook.Delivery{
+			ID:        uuid.Must(uuid.NewV4()),
+			WebhookID: create.WebhookID,
+			EventID:   create.EventID,
+			EventType: create.EventType,
+			Payload:   create.Payload,
+		})
+	}
+	return nil
+}
+
+func (s *memoryStore) Insert(_ context.Context, delivery *webhook.Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deliveries = append(s.deliveries, delivery)
+	return nil
+}
+
+func (s *memoryStore) ListDue(_ context.Context, now time.Time, limit int) ([]*webhook.Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var due []*webhook.Delivery
+	for _, delivery := range s.deliveries {
+		if delivery.Status == webhook.DeliveryStatusPending && !delivery.NextAttemptAt.After(now) && len(due) < limit {
+			due = append(due, delivery)
+		}
+	}
+	return due, nil
+}
+
+func (s *memoryStore) RecordAttempt(_ context.Context, id uuid.UUID, attempt *webhook.DeliveryAttempt) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, delivery := range s.deliveries {
+		if delivery.ID == id {
+			delivery.Attempts++
+			delivery.Status = attempt.Status
+			delivery.NextAttemptAt = attempt.NextAttemptAt
+			delivery.ResponseStatus = attempt.ResponseStatus
+			delivery.LastError = attempt.Error
+		}
+	}
+	return nil
+}
+
+// receiver is an httptest server that verifies signatures and answers with
+// the next queued status, 200 once the queue is empty.
+type receiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newReceiver(t *testing.T, secret string, statuses ...int) *receiver {
+	rcv := &receiver{statuses: statuses}
+	rcv.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, Verify(secret, r.Header.Get(SignatureHeader), body, time.Now(), time.Minute))
+
+		rcv.mu.Lock()
+		rcv.requests = append(rcv.requests, r)
+		rcv.bodies = append(rcv.bodies, body)
+		status := http.StatusOK
+		if len(rcv.statuses) > 0 {
+			status, rcv.statuses = rcv.statuses[0], rcv.statuses[1:]
+		}
+		rcv.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(rcv.Close)
+	return rcv
+}
+
+// loopback lets test deliverers reach httptest servers.
+var loopback = []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")}
+
+func newTestDeliverer(store Store, now time.Time) *Deliverer {
+	d := NewDeliverer(store, loopback)
+	d.now = func() time.Time { return now }
+	return d
+}
+
+func TestDeliverer_DeliverDue_Success(t *testing.T) {
+	rcv := newReceiver(t, "s3cret")
+	hook := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), URL: rcv.URL, Secret: "s3cret"}
+	store := &memoryStore{hooks: []*webhook.Webhook{hook}}
+	require.NoError(t, store.Enqueue(context.Background(), &webhook.DeliveryCreate{
+		WebhookID: hook.ID,
+		EventType: string(events.TransactionCreated),
+		Payload:   []byte(`{"id":1}`),
+	}))
+
+	require.NoError(t, newTestDeliverer(store, time.Now()).deliverDue(context.Background()))
+
+	delivery := store.deliveries[0]
+	assert.Equal(t, webhook.DeliveryStatusSucceeded, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusOK, *delivery.ResponseStatus)
+	require.Len(t, rcv.requests, 1)
+	assert.Equal(t, string(events.TransactionCreated), rcv.requests[0].Header.Get(EventHeader))
+	assert.Equal(t, delivery.ID.String(), rcv.requests[0].Header.Get(DeliveryHeader))
+	assert.JSONEq(t, `{"id":1}`, string(rcv.bodies[0]))
+}
+
+func TestDeliverer_DeliverDue_RetriesWithBackoff(t *testing.T) {
+	rcv := newReceiver(t, "s3cret", http.StatusInternalServerError)
+	hook := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), URL: rcv.URL, Secret: "s3cret"}
+	store := &memoryStore{hooks: []*webhook.Webhook{hook}}
+	require.NoError(t, store.Enqueue(context.Background(), &webhook.DeliveryCreate{WebhookID: hook.ID, Payload: []byte(`{}`)}))
+	now := time.Now()
+	d := newTestDeliverer(store, now)
+
+	require.NoError(t, d.deliverDue(context.Background()))
+
+	delivery := store.deliveries[0]
+	assert.Equal(t, webhook.DeliveryStatusPending, delivery.Status)
+	assert.Equal(t, now.Add(baseBackoff), delivery.NextAttemptAt)
+	assert.Equal(t, http.StatusInternalServerError, *delivery.ResponseStatus)
+	assert.Contains(t, *delivery.LastError, "500")
+
+	// Not due again until the backoff has passed.
+	require.NoError(t, d.deliverDue(context.Background()))
+	assert.Len(t, rcv.requests, 1)
+
+	d.now = func() time.Time { return now.Add(baseBackoff) }
+	require.NoError(t, d.deliverDue(context.Background()))
+	assert.Equal(t, webhook.DeliveryStatusSucceeded, delivery.Status)
+	assert.Equal(t, 2, delivery.Attempts)
+}
+
+func TestDeliverer_DeliverDue_FailsAfterMaxAttempts(t *testing.T) {
+	rcv := newReceiver(t, "s3cret", http.StatusBadGateway, http.StatusBadGateway)
+	hook := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), URL: rcv.URL, Secret: "s3cret"}
+	store := &memoryStore{hooks: []*webhook.Webhook{hook}}
+	require.NoError(t, store.Enqueue(context.Background(), &webhook.DeliveryCreate{WebhookID: hook.ID, Payload: []byte(`{}`)}))
+	d := newTestDeliverer(store, time.Now())
+	d.maxAttempts = 2
+	d.backoff = func(int) time.Duration { return 0 }
+
+	require.NoError(t, d.deliverDue(context.Background()))
+	require.NoError(t, d.deliverDue(context.Background()))
+	require.NoError(t, d.deliverDue(context.Background()))
+
+	assert.Equal(t, webhook.DeliveryStatusFailed, store.deliveries[0].Status)
+	assert.Equal(t, 2, store.deliveries[0].Attempts)
+	assert.Len(t, rcv.requests, 2)
+}
+
+func TestDeliverer_DeliverDue_WebhooksDoNotWaitForEachOther(t *testing.T) {
+	fastReached := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-fastReached:
+			w.WriteHeader(http.StatusOK)
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(slow.Close)
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(fastReached)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(fast.Close)
+	slowHook := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), URL: slow.URL, Secret: "s3cret"}
+	fastHook := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), URL: fast.URL, Secret: "s3cret"}
+	store := &memoryStore{hooks: []*webhook.Webhook{slowHook, fastHook}}
+	require.NoError(t, store.Enqueue(context.Background(),
+		&webhook.DeliveryCreate{WebhookID: slowHook.ID, Payload: []byte(`{}`)},
+		&webhook.DeliveryCreate{WebhookID: fastHook.ID, Payload: []byte(`{}`)},
+	))
+
+	require.NoError(t, newTestDeliverer(store, time.Now()).deliverDue(context.Background()))
+
+	assert.Equal(t, webhook.DeliveryStatusSucceeded, store.deliveries[0].Status)
+	assert.Equal(t, webhook.DeliveryStatusSucceeded, store.deliveries[1].Status)
+}
+
+func TestDeliverer_DeliverDue_StopsWebhookAfterBudget(t *testing.T) {
+	rcv := newReceiver(t, "s3cret")
+	hook := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), URL: rcv.URL, Secret: "s3cret"}
+	store := &memoryStore{hooks: []*webhook.Webhook{hook}}
+	require.NoError(t, store.Enqueue(context.Background(),
+		&webhook.DeliveryCreate{WebhookID: hook.ID, Payload: []byte(`{}`)},
+		&webhook.DeliveryCreate{WebhookID: hook.ID, Payload: []byte(`{}`)},
+	))
+	d := newTestDeliverer(store, time.Now())
+	d.webhookBudget = time.Nanosecond
+
+	require.NoError(t, d.deliverDue(context.Background()))
+	assert.Len(t, rcv.requests, 1)
+	assert.Equal(t, webhook.DeliveryStatusPending, store.deliveries[1].Status)
+
+	require.NoError(t, d.deliverDue(context.Background()))
+	assert.Len(t, rcv.requests, 2)
+}
+
+func TestDeliverer_DeliverDue_RefusesInternalAddress(t *testing.T) {
+	rcv := newReceiver(t, "s3cret")
+	hook := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), URL: rcv.URL, Secret: "s3cret"}
+	store := &memoryStore{hooks: []*webhook.Webhook{hook}}
+	require.NoError(t, store.Enqueue(context.Background(), &webhook.DeliveryCreate{WebhookID: hook.ID, Payload: []byte(`{}`)}))
+	d := NewDeliverer(store, nil)
+
+	require.NoError(t, d.deliverDue(context.Background()))
+
+	delivery := store.deliveries[0]
+	assert.Equal(t, webhook.DeliveryStatusPending, delivery.Status)
+	assert.Nil(t, delivery.ResponseStatus)
+	assert.Contains(t, *delivery.LastError, "internal address")
+	assert.Empty(t, rcv.requests)
+}
+
+func TestDeliverer_DeliverDue_DisabledWebhook(t *testing.T) {
+	rcv := newReceiver(t, "s3cret")
+	hook := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), URL: rcv.URL, Secret: "s3cret", IsDisabled: true}
+	store := &memoryStore{hooks: []*webhook.Webhook{hook}}
+	require.NoError(t, store.Enqueue(context.Background(), &webhook.DeliveryCreate{WebhookID: hook.ID, Payload: []byte(`{}`)}))
+
+	require.NoError(t, newTestDeliverer(store, time.Now()).deliverDue(context.Background()))
+
+	assert.Equal(t, webhook.DeliveryStatusFailed, store.deliveries[0].Status)
+	assert.Empty(t, rcv.requests)
+}
+
+func TestDeliverer_Fire(t *testing.T) {
+	rcv := newReceiver(t, "s3cret", http.StatusTeapot)
+	ledgerID := uuid.Must(uuid.NewV4())
+	hook := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), LedgerID: ledgerID, URL: rcv.URL, Secret: "s3cret"}
+	store := &memoryStore{hooks: []*webhook.Webhook{hook}}
+
+	ctx := requestctx.WithLedgerID(context.Background(), ledgerID)
+	delivery, err := newTestDeliverer(store, time.Now()).Fire(ctx, hook.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, TestEventType, delivery.EventType)
+	assert.Equal(t, webhook.DeliveryStatusFailed, delivery.Status)
+	assert.Equal(t, http.StatusTeapot, *delivery.ResponseStatus)
+	assert.Equal(t, []*webhook.Delivery{delivery}, store.deliveries)
+	require.Len(t, rcv.requests, 1)
+	assert.Equal(t, TestEventType, rcv.requests[0].Header.Get(EventHeader))
+}
+
+func TestDeliverer_Fire_NotFound(t *testing.T) {
+	ctx := requestctx.WithLedgerID(context.Background(), uuid.Must(uuid.NewV4()))
+	_, err := newTestDeliverer(&memoryStore{}, time.Now()).Fire(ctx, uuid.Must(uuid.NewV4()))
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestDeliverer_Fire_OtherLedger(t *testing.T) {
+	rcv := newReceiver(t, "s3cret")
+	hook := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), LedgerID: uuid.Must(uuid.NewV4()), URL: rcv.URL, Secret: "s3cret"}
+	store := &memoryStore{hooks: []*webhook.Webhook{hook}}
+
+	ctx := requestctx.WithLedgerID(context.Background(), uuid.Must(uuid.NewV4()))
+	_, err := newTestDeliverer(store, time.Now()).Fire(ctx, hook.ID)
+
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Empty(t, store.deliveries)
+	assert.Empty(t, rcv.requests)
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	assert.Equal(t, baseBackoff, exponentialBackoff(1))
+	assert.Equal(t, 4*baseBackoff, exponentialBackoff(3))
+	assert.Equal(t, maxBackoff, exponentialBackoff(20))
+}
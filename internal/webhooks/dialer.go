@@ -0,0 +1,64 @@
+package webhooks
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// blockedPrefixes are special-purpose ranges not covered by the netip.Addr
+// predicates checked in checkDestination.
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+}
+
+// newClient returns the HTTP client deliveries are sent with. It refuses to
+// connect to loopback, private, link-local and other internal addresses
+// unless they fall in allowed, so a ledger member cannot point a webhook at
+// the server's own network. The check is made on the address actually
+// dialed, after DNS resolution and on every redirect, so a hostname that
+// resolves to an internal address is caught too. Proxies from the
+// environment are ignored, as the check would only see the proxy.
+func newClient(allowed []netip.Prefix) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   requestTimeout,
+		KeepAlive: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			return checkDestination(address, allowed)
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: requestTimeout, Transport: transport}
+}
+
+// checkDestination returns an error when address, an IP and port, is an
+// internal address outside allowed.
+func checkDestination(address string, allowed []netip.Prefix) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("webhook destination %q: %w", address, err)
+	}
+	addr := addrPort.Addr().Unmap()
+	for _, prefix := range allowed {
+		if prefix.Contains(addr) {
+			return nil
+		}
+	}
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return fmt.Errorf("webhook destination %s is an internal address", addr)
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return fmt.Errorf("webhook destination %s is an internal address", addr)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package webhooks
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckDestination(t *testing.T) {
+	tests := []struct {
+		address string
+		allowed bool
+	}{
+		{"93.184.216.34:443", true},
+		{"[2606:2800:220:1:248:1893:25c8:1946]:443", true},
+		{"127.0.0.1:80", false},
+		{"[::1]:80", false},
+		{"10.1.2.3:80", false},
+		{"172.16.0.1:80", false},
+		{"192.168.1.10:80", false},
+		{"169.254.169.254:80", false},
+		{"[fe80::1]:80", false},
+		{"[fd00::1]:80", false},
+		{"[::ffff:127.0.0.1]:80", false},
+		{"0.0.0.0:80", false},
+		{"100.64.0.1:80", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			err := checkDestination(tt.address, nil)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, "internal address")
+			}
+		})
+	}
+}
+
+func TestCheckDestination_Allowlist(t *testing.T) {
+	allowed := []netip.Prefix{netip.MustParsePrefix("192.168.1.0/24")}
+
+	assert.NoError(t, checkDestination("192.168.1.10:8080", allowed))
+	assert.Error(t, checkDestination("192.168.2.10:8080", allowed))
+}
@@ -0,0 +1,57 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+// Fanout is the events.Sink that queues a delivery of each event for every
+// enabled webhook in its ledger subscribed to its type. The Deliverer sends
+// them.
+type Fanout struct {
+	store Store
+}
+
+func NewFanout(store Store) *Fanout {
+	return &Fanout{store: store}
+}
+
+func (f *Fanout) Name() string {
+	return "webhooks"
+}
+
+func (f *Fanout) Publish(ctx context.Context, batch []events.Event) error {
+	hooks, err := f.store.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var deliveries []*webhook.DeliveryCreate
+	for _, event := range batch {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		ledgerID := uuid.FromStringOrNil(event.LedgerID)
+		for _, hook := range hooks {
+			if !hook.Matches(ledgerID, string(event.Type)) {
+				continue
+			}
+			deliveries = append(deliveries, &webhook.DeliveryCreate{
+				WebhookID: hook.ID,
+				EventID:   &event.ID,
+				EventType: string(event.Type),
+				Payload:   payload,
+			})
+		}
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return f.store.Enqueue(ctx, deliveries...)
+}
@@ -0,0 +1,56 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/storage/webhook"
+)
+
+func TestFanout_Publish_FiltersByEventType(t *testing.T) {
+	all := &webhook.Webhook{ID: uuid.Must(uuid.NewV4())}
+	transactions := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), EventTypes: []string{string(events.TransactionCreated)}}
+	disabled := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), IsDisabled: true}
+	store := &memoryStore{hooks: []*webhook.Webhook{all, transactions, disabled}}
+
+	err := NewFanout(store).Publish(context.Background(), []events.Event{
+		{ID: 1, Type: events.TransactionCreated, Payload: []byte(`{}`)},
+		{ID: 2, Type: events.CategoryDeleted, Payload: []byte(`{}`)},
+	})
+	require.NoError(t, err)
+
+	type queued struct {
+		webhookID uuid.UUID
+		eventID   int64
+	}
+	var got []queued
+	for _, delivery := range store.deliveries {
+		got = append(got, queued{delivery.WebhookID, *delivery.EventID})
+	}
+	assert.ElementsMatch(t, []queued{{all.ID, 1}, {transactions.ID, 1}, {all.ID, 2}}, got)
+
+	var event events.Event
+	require.NoError(t, json.Unmarshal(store.deliveries[0].Payload, &event))
+	assert.Equal(t, int64(1), event.ID)
+}
+
+func TestFanout_Publish_FiltersByLedger(t *testing.T) {
+	ledgerA, ledgerB := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+	hookA := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), LedgerID: ledgerA}
+	hookB := &webhook.Webhook{ID: uuid.Must(uuid.NewV4()), LedgerID: ledgerB}
+	store := &memoryStore{hooks: []*webhook.Webhook{hookA, hookB}}
+
+	err := NewFanout(store).Publish(context.Background(), []events.Event{
+		{ID: 1, Type: events.TransactionCreated, LedgerID: ledgerA.String(), Payload: []byte(`{}`)},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, store.deliveries, 1)
+	assert.Equal(t, hookA.ID, store.deliveries[0].WebhookID)
+}
@@ -0,0 +1,41 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign_VerifyRoundTrip(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	body := []byte(`{"id":1}`)
+	header := Sign("s3cret", now, body)
+
+	assert.Regexp(t, `^t=1700000000,v1=[0-9a-f]{64}$`, header)
+	assert.NoError(t, Verify("s3cret", header, body, now.Add(time.Minute), 5*time.Minute))
+}
+
+func TestVerify_Rejects(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	body := []byte(`{"id":1}`)
+	header := Sign("s3cret", now, body)
+
+	tests := map[string]struct {
+		secret string
+		header string
+		body   []byte
+		now    time.Time
+	}{
+		"wrong secret":   {secret: "other", header: header, body: body, now: now},
+		"tampered body":  {secret: "s3cret", header: header, body: []byte(`{"id":2}`), now: now},
+		"too old":        {secret: "s3cret", header: header, body: body, now: now.Add(10 * time.Minute)},
+		"malformed":      {secret: "s3cret", header: "garbage", body: body, now: now},
+		"missing digest": {secret: "s3cret", header: "t=1700000000", body: body, now: now},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, Verify(tt.secret, tt.header, tt.body, tt.now, 5*time.Minute), ErrInvalidSignature)
+		})
+	}
+}
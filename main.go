@@ -1,45 +1,167 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"flag"
+	"os"
+	"os/signal"
 	"sync"
+	"syscall"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/carson-networks/budget-server/api"
 	"github.com/carson-networks/budget-server/internal/config"
+	"github.com/carson-networks/budget-server/internal/events"
+	"github.com/carson-networks/budget-server/internal/handlers/idempotent"
 	"github.com/carson-networks/budget-server/internal/logging"
+	"github.com/carson-networks/budget-server/internal/metrics"
 	"github.com/carson-networks/budget-server/internal/operator"
+	"github.com/carson-networks/budget-server/internal/pagination"
 	"github.com/carson-networks/budget-server/internal/storage"
+	"github.com/carson-networks/budget-server/internal/webhooks"
+	"github.com/carson-networks/budget-server/scripts/db_migrations/migrations"
 )
 
 func main() {
 	logger := logging.SetupLogging()
+
+	envConfig, printConfig, err := config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		logrus.WithError(err).Fatal("config.Load")
+		return
+	}
+	if printConfig {
+		if err := envConfig.WriteRedacted(os.Stdout); err != nil {
+			logrus.WithError(err).Fatal("config.WriteRedacted")
+		}
+		return
+	}
+	logger.SetLevel(envConfig.Level())
+	logrus.SetLevel(envConfig.Level())
 	logrus.Info("budget-server starting")
 
-	envConfig, err := config.ProcessEnvironmentVariables()
+	dbStorage, err := storage.NewStorage(context.Background(), envConfig)
 	if err != nil {
-		logrus.WithError(err).Fatal("config.ProcessEnvironmentVariables")
+		logrus.WithError(err).Fatal("storage.NewStorage")
 		return
 	}
 
-	dbStorage := storage.NewStorage(envConfig)
+	cursorKey := []byte(envConfig.CursorSigningKey)
+	if len(cursorKey) == 0 {
+		logrus.Warn("CURSOR_SIGNING_KEY not set, generating a random key; pagination cursors will not survive restarts")
+		cursorKey = make([]byte, 32)
+		if _, err := rand.Read(cursorKey); err != nil {
+			logrus.WithError(err).Fatal("rand.Read")
+			return
+		}
+	}
+
+	schemaVersion, err := migrations.Latest()
+	if err != nil {
+		logrus.WithError(err).Fatal("migrations.Latest")
+		return
+	}
 
-	op := operator.NewOperatorDelegator(dbStorage, 4)
+	op := operator.NewOperatorDelegator(dbStorage, envConfig.OperatorWorkers, envConfig.OperatorQueueSize)
 	op.Start()
-	defer op.Stop()
 
-	wg := sync.WaitGroup{}
-	wg.Add(1)
+	metrics.Registry.MustRegister(op.Collector())
+	metrics.Registry.MustRegister(dbStorage.Collectors()...)
 
-	go func() {
-		httpRest := api.Rest{
-			Logger:   logger,
-			Port:     "9446",
-			Storage:  dbStorage,
-			Operator: op,
+	broker := events.NewBroker()
+	sinks := []events.Sink{broker, webhooks.NewFanout(dbStorage.Webhooks())}
+	for _, url := range envConfig.OutboxWebhookURLs {
+		sinks = append(sinks, events.NewWebhookSink(url))
+	}
+	if envConfig.OutboxLogFile != "" {
+		logSink, err := events.NewLogSink(envConfig.OutboxLogFile)
+		if err != nil {
+			logrus.WithError(err).Fatal("events.NewLogSink")
+			return
 		}
-		httpRest.Serve()
+		defer logSink.Close()
+		sinks = append(sinks, logSink)
+	}
+
+	deliverer := webhooks.NewDeliverer(dbStorage.Webhooks(), envConfig.WebhookAllowedPrefixes())
+
+	dispatchCtx, stopDispatch := context.WithCancel(context.Background())
+	background := sync.WaitGroup{}
+	background.Add(3)
+	go func() {
+		defer background.Done()
+		events.NewDispatcher(dbStorage.Outbox(), sinks...).Run(dispatchCtx)
 	}()
+	go func() {
+		defer background.Done()
+		deliverer.Run(dispatchCtx)
+	}()
+	go func() {
+		defer background.Done()
+		idempotent.NewSweeper(dbStorage.Idempotency(), envConfig.IdempotencyKeyTTL).Run(dispatchCtx)
+	}()
+
+	httpRest := api.Rest{
+		Logger: logger,
+		Addr:   envConfig.ListenAddr,
+		CORS: api.CORSPolicy{
+			AllowedOrigins:   envConfig.CORSAllowedOrigins,
+			AllowCredentials: envConfig.CORSAllowCredentials,
+		},
+		TLSCertFile:     envConfig.TLSCertFile,
+		TLSKeyFile:      envConfig.TLSKeyFile,
+		ShutdownTimeout: envConfig.ShutdownTimeout,
+
+		ReadTimeout:       envConfig.HTTPReadTimeout,
+		ReadHeaderTimeout: envConfig.HTTPReadHeaderTimeout,
+		WriteTimeout:      envConfig.HTTPWriteTimeout,
+		IdleTimeout:       envConfig.HTTPIdleTimeout,
+
+		Storage:       dbStorage,
+		Operator:      op,
+		SchemaVersion: schemaVersion,
+		Cursors:       pagination.NewCodec(cursorKey),
 
-	wg.Wait()
+		BatchMaxItems: envConfig.BatchMaxItems,
+		Webhooks:      deliverer,
+		Events:        broker,
+	}
+
+	signalCtx, stopSignals := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stopSignals()
+	// A Serve error before any signal means the server failed on its own,
+	// such as its address being taken, so the process must not exit 0 once
+	// it has cleaned up.
+	serveFailed := false
+	if err := httpRest.Serve(signalCtx); err != nil {
+		logrus.WithError(err).Error("api.Rest.Serve")
+		serveFailed = signalCtx.Err() == nil
+	}
+
+	// Shut down in dependency order: HTTP has stopped taking requests, so
+	// drain the writes it queued, then stop the background workers, and
+	// only then close the pool they all share.
+	drainCtx, cancelDrain := context.WithTimeout(context.Background(), envConfig.OperatorDrainTimeout)
+	defer cancelDrain()
+	if err := op.Stop(drainCtx); err != nil {
+		logrus.WithError(err).Warn("operator.Stop: queued writes did not drain")
+	}
+
+	stopDispatch()
+	background.Wait()
+
+	if err := dbStorage.Close(); err != nil {
+		logrus.WithError(err).Warn("storage.Close")
+	}
+	if serveFailed {
+		logrus.Error("budget-server stopped after the API server failed")
+		os.Exit(1)
+	}
+	logrus.Info("budget-server stopped")
 }
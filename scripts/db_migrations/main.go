@@ -1,31 +1,35 @@
 package main
 
 import (
-	"database/sql"
+	"context"
 	"errors"
+	"flag"
+	"os"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
-	_ "github.com/lib/pq"
 	"github.com/sirupsen/logrus"
 
 	server_config "github.com/carson-networks/budget-server/internal/config"
+	"github.com/carson-networks/budget-server/internal/storage"
 )
 
 func main() {
-	env, err := server_config.ProcessEnvironmentVariables()
+	env, _, err := server_config.Load(os.Args[1:])
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
 	if err != nil {
-		logrus.WithError(err).Fatal("ProcessEnvironmentVariables")
+		logrus.WithError(err).Fatal("config.Load")
 		return
 	}
 
-	connectionDetails := "postgres://" + env.PostgresUsername + ":" + env.PostgresPassword + "@" + env.PostgresAddress + ":" + env.PostgresPort + "/" + env.PostgresDB + "?sslmode=disable"
-	println(connectionDetails)
-
-	db, err := sql.Open("postgres", connectionDetails)
+	// Migrations may legitimately run longer than any API statement.
+	env.PostgresStatementTimeout = 0
+	db, err := storage.Open(context.Background(), env)
 	if err != nil {
-		logrus.WithError(err).Fatal("sql.Open")
+		logrus.WithError(err).Fatal("storage.Open")
 		return
 	}
 
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_categories_name_id;
+DROP INDEX IF EXISTS idx_accounts_name_id;
+DROP INDEX IF EXISTS idx_transactions_created_at_id;
@@ -0,0 +1,3 @@
+CREATE INDEX idx_transactions_created_at_id ON transactions (created_at, id);
+CREATE INDEX idx_accounts_name_id ON accounts (name, id);
+CREATE INDEX idx_categories_name_id ON categories (name, id);
@@ -0,0 +1,2 @@
+ALTER TABLE transactions
+    ADD COLUMN is_refund BOOLEAN NOT NULL DEFAULT FALSE;
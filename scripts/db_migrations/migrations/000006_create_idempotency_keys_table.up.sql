@@ -0,0 +1,6 @@
+CREATE TABLE idempotency_keys (
+    key            TEXT PRIMARY KEY,
+    request_hash   BYTEA NOT NULL,
+    response       JSONB NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
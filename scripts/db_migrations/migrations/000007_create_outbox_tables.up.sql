@@ -0,0 +1,13 @@
+CREATE TABLE outbox_events (
+    id             BIGSERIAL PRIMARY KEY,
+    event_type     TEXT NOT NULL,
+    aggregate_id   UUID NOT NULL,
+    payload        JSONB NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE outbox_cursors (
+    sink           TEXT PRIMARY KEY,
+    position       BIGINT NOT NULL,
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
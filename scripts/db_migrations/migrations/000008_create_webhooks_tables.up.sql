@@ -0,0 +1,28 @@
+CREATE TABLE webhooks (
+    id             UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    url            TEXT NOT NULL,
+    secret         TEXT NOT NULL,
+    event_types    JSONB NOT NULL DEFAULT '[]'::jsonb,
+    is_disabled    BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE webhook_deliveries (
+    id               UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    webhook_id       UUID NOT NULL,
+    event_id         BIGINT,
+    event_type       TEXT NOT NULL,
+    payload          JSONB NOT NULL,
+    status           SMALLINT NOT NULL DEFAULT 0,
+    attempts         INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    response_status  INTEGER,
+    last_error       TEXT,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_webhook_deliveries_webhook_id FOREIGN KEY (webhook_id) REFERENCES webhooks (id) ON DELETE CASCADE,
+    CONSTRAINT webhook_deliveries_webhook_id_event_id_key UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (status, next_attempt_at);
+CREATE INDEX idx_webhook_deliveries_webhook_id_created_at ON webhook_deliveries (webhook_id, created_at);
@@ -0,0 +1,12 @@
+CREATE TABLE audit_records (
+    id           UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    action_type  TEXT NOT NULL,
+    actor        TEXT NOT NULL,
+    request_id   TEXT NOT NULL,
+    changes      JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_audit_records_created_at_id ON audit_records (created_at, id);
+-- Supports the changes @> '[{"entity": ..., "entityID": ...}]' lookups.
+CREATE INDEX idx_audit_records_changes ON audit_records USING GIN (changes jsonb_path_ops);
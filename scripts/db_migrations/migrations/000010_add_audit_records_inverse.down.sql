@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_audit_records_request_id;
+
+ALTER TABLE audit_records DROP COLUMN IF EXISTS inverse;
@@ -0,0 +1,4 @@
+-- The action that reverses the audited one, for POST /v1/actions/{id}/undo.
+ALTER TABLE audit_records ADD COLUMN inverse JSONB;
+
+CREATE INDEX idx_audit_records_request_id ON audit_records (request_id);
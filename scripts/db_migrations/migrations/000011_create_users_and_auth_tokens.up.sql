@@ -0,0 +1,24 @@
+CREATE TABLE users (
+    id             UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    username       TEXT NOT NULL,
+    password_hash  TEXT NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT users_username_key UNIQUE (username)
+);
+
+-- token_hash is the SHA-256 of the bearer token; the token itself is only
+-- shown to the user when it is issued.
+CREATE TABLE auth_tokens (
+    id             UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id        UUID NOT NULL,
+    name           TEXT NOT NULL,
+    kind           SMALLINT NOT NULL,
+    scope          TEXT NOT NULL,
+    token_hash     BYTEA NOT NULL,
+    expires_at     TIMESTAMPTZ,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_auth_tokens_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT auth_tokens_token_hash_key UNIQUE (token_hash)
+);
+
+CREATE INDEX idx_auth_tokens_user_id ON auth_tokens (user_id);
@@ -0,0 +1,27 @@
+DROP INDEX IF EXISTS idx_webhooks_ledger_id;
+DROP INDEX IF EXISTS idx_audit_records_ledger_id_created_at_id;
+DROP INDEX IF EXISTS idx_transactions_ledger_id_created_at_id;
+DROP INDEX IF EXISTS idx_categories_ledger_id_name_id;
+DROP INDEX IF EXISTS idx_accounts_ledger_id_name_id;
+CREATE INDEX IF NOT EXISTS idx_accounts_name_id ON accounts (name, id);
+CREATE INDEX IF NOT EXISTS idx_categories_name_id ON categories (name, id);
+CREATE INDEX IF NOT EXISTS idx_transactions_created_at_id ON transactions (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_audit_records_created_at_id ON audit_records (created_at, id);
+
+ALTER TABLE transactions
+    DROP CONSTRAINT IF EXISTS fk_transactions_account_id,
+    DROP CONSTRAINT IF EXISTS fk_transactions_category_id,
+    ADD CONSTRAINT fk_transactions_category_id FOREIGN KEY (category_id) REFERENCES categories (id);
+ALTER TABLE categories
+    DROP CONSTRAINT IF EXISTS fk_categories_parent,
+    ADD CONSTRAINT fk_categories_parent FOREIGN KEY (parent_id) REFERENCES categories (id);
+
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS ledger_id;
+ALTER TABLE audit_records DROP COLUMN IF EXISTS ledger_id;
+ALTER TABLE webhooks DROP COLUMN IF EXISTS ledger_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS ledger_id;
+ALTER TABLE categories DROP COLUMN IF EXISTS ledger_id;
+ALTER TABLE accounts DROP COLUMN IF EXISTS ledger_id;
+
+DROP TABLE IF EXISTS ledger_members;
+DROP TABLE IF EXISTS ledgers;
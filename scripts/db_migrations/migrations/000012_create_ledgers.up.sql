@@ -0,0 +1,81 @@
+CREATE TABLE ledgers (
+    id             UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name           TEXT NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- role is 0 viewer, 1 editor, 2 owner.
+CREATE TABLE ledger_members (
+    ledger_id      UUID NOT NULL,
+    user_id        UUID NOT NULL,
+    role           SMALLINT NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (ledger_id, user_id),
+    CONSTRAINT fk_ledger_members_ledger_id FOREIGN KEY (ledger_id) REFERENCES ledgers (id) ON DELETE CASCADE,
+    CONSTRAINT fk_ledger_members_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_ledger_members_user_id ON ledger_members (user_id);
+
+-- Everything recorded before ledgers existed goes into one ledger owned by
+-- every existing user.
+INSERT INTO ledgers (name)
+SELECT 'Default'
+WHERE EXISTS (SELECT 1 FROM users)
+   OR EXISTS (SELECT 1 FROM accounts)
+   OR EXISTS (SELECT 1 FROM categories)
+   OR EXISTS (SELECT 1 FROM webhooks);
+
+INSERT INTO ledger_members (ledger_id, user_id, role)
+SELECT ledgers.id, users.id, 2 FROM ledgers CROSS JOIN users;
+
+ALTER TABLE accounts ADD COLUMN ledger_id UUID;
+ALTER TABLE categories ADD COLUMN ledger_id UUID;
+ALTER TABLE transactions ADD COLUMN ledger_id UUID;
+ALTER TABLE webhooks ADD COLUMN ledger_id UUID;
+-- Audit records and events of actions outside any ledger, such as creating
+-- a user, have no ledger.
+ALTER TABLE audit_records ADD COLUMN ledger_id UUID;
+ALTER TABLE outbox_events ADD COLUMN ledger_id UUID;
+
+UPDATE accounts SET ledger_id = (SELECT id FROM ledgers);
+UPDATE categories SET ledger_id = (SELECT id FROM ledgers);
+UPDATE transactions SET ledger_id = (SELECT id FROM ledgers);
+UPDATE webhooks SET ledger_id = (SELECT id FROM ledgers);
+UPDATE audit_records SET ledger_id = (SELECT id FROM ledgers);
+UPDATE outbox_events SET ledger_id = (SELECT id FROM ledgers);
+
+ALTER TABLE accounts ALTER COLUMN ledger_id SET NOT NULL;
+ALTER TABLE categories ALTER COLUMN ledger_id SET NOT NULL;
+ALTER TABLE transactions ALTER COLUMN ledger_id SET NOT NULL;
+ALTER TABLE webhooks ALTER COLUMN ledger_id SET NOT NULL;
+
+ALTER TABLE accounts
+    ADD CONSTRAINT fk_accounts_ledger_id FOREIGN KEY (ledger_id) REFERENCES ledgers (id) ON DELETE CASCADE,
+    ADD CONSTRAINT accounts_ledger_id_id_key UNIQUE (ledger_id, id);
+ALTER TABLE categories
+    ADD CONSTRAINT fk_categories_ledger_id FOREIGN KEY (ledger_id) REFERENCES ledgers (id) ON DELETE CASCADE,
+    ADD CONSTRAINT categories_ledger_id_id_key UNIQUE (ledger_id, id);
+ALTER TABLE webhooks
+    ADD CONSTRAINT fk_webhooks_ledger_id FOREIGN KEY (ledger_id) REFERENCES ledgers (id) ON DELETE CASCADE;
+ALTER TABLE audit_records
+    ADD CONSTRAINT fk_audit_records_ledger_id FOREIGN KEY (ledger_id) REFERENCES ledgers (id) ON DELETE CASCADE;
+
+-- Rows can only reference rows in their own ledger.
+ALTER TABLE categories
+    DROP CONSTRAINT fk_categories_parent,
+    ADD CONSTRAINT fk_categories_parent FOREIGN KEY (ledger_id, parent_id) REFERENCES categories (ledger_id, id);
+ALTER TABLE transactions
+    DROP CONSTRAINT fk_transactions_category_id,
+    ADD CONSTRAINT fk_transactions_category_id FOREIGN KEY (ledger_id, category_id) REFERENCES categories (ledger_id, id),
+    ADD CONSTRAINT fk_transactions_account_id FOREIGN KEY (ledger_id, account_id) REFERENCES accounts (ledger_id, id);
+
+DROP INDEX idx_accounts_name_id;
+DROP INDEX idx_categories_name_id;
+DROP INDEX idx_transactions_created_at_id;
+DROP INDEX idx_audit_records_created_at_id;
+CREATE INDEX idx_accounts_ledger_id_name_id ON accounts (ledger_id, name, id);
+CREATE INDEX idx_categories_ledger_id_name_id ON categories (ledger_id, name, id);
+CREATE INDEX idx_transactions_ledger_id_created_at_id ON transactions (ledger_id, created_at, id);
+CREATE INDEX idx_audit_records_ledger_id_created_at_id ON audit_records (ledger_id, created_at, id);
+CREATE INDEX idx_webhooks_ledger_id ON webhooks (ledger_id);
@@ -0,0 +1,2 @@
+ALTER TABLE categories
+    DROP COLUMN IF EXISTS monthly_budget;
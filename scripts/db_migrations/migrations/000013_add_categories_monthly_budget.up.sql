@@ -0,0 +1,2 @@
+ALTER TABLE categories
+    ADD COLUMN monthly_budget DECIMAL(100, 4) NULL;
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_idempotency_keys_created_at;
+
+DELETE FROM idempotency_keys
+WHERE scope <> '';
+
+ALTER TABLE idempotency_keys
+    DROP CONSTRAINT idempotency_keys_pkey,
+    ADD CONSTRAINT idempotency_keys_pkey PRIMARY KEY (key);
+
+ALTER TABLE idempotency_keys
+    DROP COLUMN IF EXISTS scope;
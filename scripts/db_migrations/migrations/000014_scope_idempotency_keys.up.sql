@@ -0,0 +1,8 @@
+ALTER TABLE idempotency_keys
+    ADD COLUMN scope TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE idempotency_keys
+    DROP CONSTRAINT idempotency_keys_pkey,
+    ADD CONSTRAINT idempotency_keys_pkey PRIMARY KEY (scope, key);
+
+CREATE INDEX idx_idempotency_keys_created_at ON idempotency_keys (created_at);
@@ -0,0 +1 @@
+CREATE INDEX idx_outbox_events_ledger_id_id ON outbox_events (ledger_id, id);
@@ -0,0 +1,32 @@
+// Package migrations embeds the schema migrations so the server can tell
+// which schema version it was built against.
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// Latest is the version of the newest up migration.
+func Latest() (uint, error) {
+	names, err := fs.Glob(files, "*.up.sql")
+	if err != nil {
+		return 0, err
+	}
+	var latest uint
+	for _, name := range names {
+		prefix, _, _ := strings.Cut(name, "_")
+		version, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("migration %s: version prefix: %w", name, err)
+		}
+		latest = max(latest, uint(version))
+	}
+	return latest, nil
+}
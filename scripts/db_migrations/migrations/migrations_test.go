@@ -0,0 +1,19 @@
+package migrations
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatest_IsNewestOfSequentialMigrations(t *testing.T) {
+	ups, err := fs.Glob(files, "*.up.sql")
+	require.NoError(t, err)
+
+	latest, err := Latest()
+
+	require.NoError(t, err)
+	assert.Equal(t, uint(len(ups)), latest)
+}